@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // Supported ABCI Query prefixes and paths
@@ -212,7 +213,8 @@ func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci
 	return resp, nil
 }
 
-// ListSnapshots implements the ABCI interface. It delegates to app.snapshotManager if set.
+// ListSnapshots implements the ABCI interface. It delegates to app.snapshotManager if set, which
+// doesn't list the delta snapshots as they can't be restored through state sync.
 func (app *BaseApp) ListSnapshots(req *abci.ListSnapshotsRequest) (*abci.ListSnapshotsResponse, error) {
 	resp := &abci.ListSnapshotsResponse{Snapshots: []*abci.Snapshot{}}
 	if app.snapshotManager == nil {
@@ -979,6 +981,13 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 		app.prepareCheckStater(app.checkState.Context())
 	}
 
+	// Evict the txs made stale by the committed block from the app-side mempool.
+	if mp, ok := app.mempool.(mempool.RecheckMempool); ok {
+		if err := mp.Recheck(app.checkState.Context()); err != nil {
+			app.logger.Error("failed to recheck mempool", "height", header.Height, "err", err)
+		}
+	}
+
 	// The SnapshotIfApplicable method will create the snapshot by starting the goroutine
 	app.snapshotManager.SnapshotIfApplicable(header.Height)
 
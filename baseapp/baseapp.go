@@ -177,6 +177,9 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// startIndexer starts the built-in indexer once the app state is loaded, if it is enabled
+	startIndexer func() error
+
 	chainID string
 
 	cdc codec.Codec
@@ -436,6 +439,13 @@ func (app *BaseApp) Init() error {
 
 	// needed for the export command which inits from store but never calls initchain
 	app.setState(execModeCheck, emptyHeader)
+
+	if app.startIndexer != nil {
+		if err := app.startIndexer(); err != nil {
+			return fmt.Errorf("failed to start indexer: %w", err)
+		}
+	}
+
 	app.Seal()
 
 	return app.cms.GetPruning().Validate()
@@ -31,28 +31,61 @@ const (
 // EnableIndexer enables the built-in indexer with the provided options (usually from the app.toml indexer key),
 // kv-store keys, and app modules. Using the built-in indexer framework is mutually exclusive from using other
 // types of streaming listeners.
+//
+// The indexer manager is started when the app state is loaded, so that indexers without any data can be caught
+// up with the state of the last committed block before they receive the data of the next blocks.
 func (app *BaseApp) EnableIndexer(indexerOpts interface{}, keys map[string]*storetypes.KVStoreKey, appModules map[string]any) error {
-	listener, err := indexer.StartManager(indexer.ManagerOptions{
-		Config:     indexerOpts,
-		Resolver:   decoding.ModuleSetDecoderResolver(appModules),
-		SyncSource: nil,
-		Logger:     app.logger.With("module", "indexer"),
-	})
-	if err != nil {
-		return err
+	// only the stores of the app modules can be decoded
+	moduleKeys := make(map[string]*storetypes.KVStoreKey, len(keys))
+	for name, key := range keys {
+		if _, ok := appModules[name]; ok {
+			moduleKeys[name] = key
+		}
+	}
+
+	wrapper := &listenerWrapper{}
+	app.startIndexer = func() error {
+		lastBlockHeight := uint64(app.LastBlockHeight())
+		listener, err := indexer.StartManager(indexer.ManagerOptions{
+			Config:     indexerOpts,
+			Resolver:   decoding.ModuleSetDecoderResolver(appModules),
+			SyncSource: kvStoreSyncSource{cms: app.cms, keys: moduleKeys},
+			SyncHeight: lastBlockHeight,
+			Logger:     app.logger.With("module", "indexer"),
+			// indexers which indexed blocks which are no longer committed, e.g. after a rollback of the
+			// app state, revert them
+			CommittedHeight: lastBlockHeight,
+		})
+		if err != nil {
+			return err
+		}
+
+		wrapper.listener = listener
+		return nil
 	}
 
-	exposedKeys := exposeStoreKeysSorted([]string{"*"}, keys)
+	exposedKeys := exposeStoreKeysSorted([]string{"*"}, moduleKeys)
 	app.cms.AddListeners(exposedKeys)
 
 	app.streamingManager = storetypes.StreamingManager{
-		ABCIListeners: []storetypes.ABCIListener{listenerWrapper{listener}},
+		ABCIListeners: []storetypes.ABCIListener{wrapper},
 		StopNodeOnErr: true,
 	}
 
 	return nil
 }
 
+// RollbackIndexer rolls back the indexers enabled with EnableIndexer to the last block committed by the app.
+// It should be called after rolling back the app state, e.g. by the rollback command, and does nothing if the
+// indexer is not enabled.
+func (app *BaseApp) RollbackIndexer() error {
+	if app.startIndexer == nil {
+		return nil
+	}
+
+	return app.startIndexer()
+}
+
 // RegisterStreamingServices registers streaming services with the BaseApp.
 func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
 	// register streaming services
@@ -146,7 +179,7 @@ type listenerWrapper struct {
 	listener appdata.Listener
 }
 
-func (p listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+func (p *listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
 	if p.listener.StartBlock != nil {
 		err := p.listener.StartBlock(appdata.StartBlockData{
 			Height: uint64(req.Height),
@@ -161,18 +194,21 @@ func (p listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.Finaliz
 	return nil
 }
 
-func (p listenerWrapper) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+func (p *listenerWrapper) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
 	if cb := p.listener.OnKVPair; cb != nil {
-		updates := make([]appdata.ModuleKVPairUpdate, len(changeSet))
-		for i, pair := range changeSet {
-			updates[i] = appdata.ModuleKVPairUpdate{
-				ModuleName: pair.StoreKey,
-				Update: schema.KVPairUpdate{
-					Key:    pair.Key,
-					Value:  pair.Value,
-					Delete: pair.Delete,
-				},
+		// group the consecutive changes of each store, the store key being the module name
+		var updates []appdata.ActorKVPairUpdate
+		for _, pair := range changeSet {
+			if len(updates) == 0 || string(updates[len(updates)-1].Actor) != pair.StoreKey {
+				updates = append(updates, appdata.ActorKVPairUpdate{Actor: []byte(pair.StoreKey)})
 			}
+
+			last := &updates[len(updates)-1]
+			last.StateChanges = append(last.StateChanges, schema.KVPairUpdate{
+				Key:    pair.Key,
+				Value:  pair.Value,
+				Remove: pair.Delete,
+			})
 		}
 		err := cb(appdata.KVPairData{Updates: updates})
 		if err != nil {
@@ -181,11 +217,40 @@ func (p listenerWrapper) ListenCommit(ctx context.Context, res abci.CommitRespon
 	}
 
 	if p.listener.Commit != nil {
-		err := p.listener.Commit(appdata.CommitData{})
+		cb, err := p.listener.Commit(appdata.CommitData{})
 		if err != nil {
 			return err
 		}
+		if cb != nil {
+			return cb()
+		}
 	}
 
 	return nil
 }
+
+// kvStoreSyncSource is a decoding.SyncSource reading the state of the last committed block from the module
+// stores. As for the live data sent to the indexers, the store key of a module is expected to be its name.
+type kvStoreSyncSource struct {
+	cms  storetypes.CommitMultiStore
+	keys map[string]*storetypes.KVStoreKey
+}
+
+func (s kvStoreSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	key, ok := s.keys[moduleName]
+	if !ok {
+		// the module has no kv-store
+		return nil
+	}
+
+	it := s.cms.GetKVStore(key).Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
@@ -7,8 +7,14 @@ import (
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	tmproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/view"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -146,3 +152,135 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+// indexedModule is a module whose state maps string keys to string values.
+type indexedModule struct{}
+
+var indexedModuleSchema = func() schema.ModuleSchema {
+	s, err := schema.NewModuleSchema([]schema.ObjectType{{
+		Name:        "item",
+		KeyFields:   []schema.Field{{Name: "key", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+	}})
+	if err != nil {
+		panic(err)
+	}
+	return s
+}()
+
+func (indexedModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: indexedModuleSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			return []schema.ObjectUpdate{{TypeName: "item", Key: string(update.Key), Value: string(update.Value)}}, nil
+		},
+	}, nil
+}
+
+// emptyIndexerView is the view of an indexer without any data.
+type emptyIndexerView struct{}
+
+func (emptyIndexerView) BlockNum() (uint64, error) { return 0, nil }
+
+func (emptyIndexerView) AppState() view.AppState { return nil }
+
+func TestEnableIndexer_CatchUp(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := storetypes.NewKVStoreKey("indexed")
+
+	// commit some state before the indexer is enabled
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), db, nil)
+	app.MountStores(key)
+	require.NoError(t, app.LoadLatestVersion())
+	cms := app.CommitMultiStore()
+	cms.GetKVStore(key).Set([]byte("a"), []byte("1"))
+	cms.Commit()
+	cms.GetKVStore(key).Set([]byte("b"), []byte("2"))
+	cms.Commit()
+
+	var packets []appdata.Packet
+	indexer.Register("baseapp_test_catch_up", func(indexer.InitParams) (indexer.InitResult, error) {
+		return indexer.InitResult{
+			Listener: appdata.PacketForwarder(func(packet appdata.Packet) error {
+				packets = append(packets, packet)
+				return nil
+			}),
+			View: emptyIndexerView{},
+		}, nil
+	})
+
+	// the empty indexer is caught up with the state of the last committed block when the app is loaded
+	app = baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), db, nil)
+	app.MountStores(key)
+	require.NoError(t, app.EnableIndexer(
+		indexer.ManagerConfig{Target: map[string]indexer.Config{"test": {Type: "baseapp_test_catch_up"}}},
+		map[string]*storetypes.KVStoreKey{"indexed": key},
+		map[string]any{"indexed": indexedModule{}},
+	))
+	require.Empty(t, packets)
+	require.NoError(t, app.LoadLatestVersion())
+
+	require.Equal(t, []appdata.Packet{
+		appdata.StartBlockData{Height: 2},
+		appdata.ModuleInitializationData{ModuleName: "indexed", Schema: indexedModuleSchema},
+		appdata.ObjectUpdateData{ModuleName: "indexed", Updates: []schema.ObjectUpdate{
+			{TypeName: "item", Key: "a", Value: "1"},
+			{TypeName: "item", Key: "b", Value: "2"},
+		}},
+		appdata.CommitData{},
+	}, packets)
+}
+
+func TestRollbackIndexer(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := storetypes.NewKVStoreKey("indexed")
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), db, nil)
+	app.MountStores(key)
+	require.NoError(t, app.LoadLatestVersion())
+	cms := app.CommitMultiStore()
+	for i := 0; i < 3; i++ {
+		cms.GetKVStore(key).Set([]byte{byte(i)}, []byte{byte(i)})
+		cms.Commit()
+	}
+
+	// the indexer has indexed all the committed blocks
+	view := &rollbackIndexerView{blockNum: 3}
+	indexer.Register("baseapp_test_rollback", func(indexer.InitParams) (indexer.InitResult, error) {
+		return indexer.InitResult{
+			Listener: appdata.Listener{},
+			View:     view,
+			Rollback: func(height uint64) error {
+				view.rollbacks = append(view.rollbacks, height)
+				view.blockNum = height
+				return nil
+			},
+		}, nil
+	})
+
+	app = baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), db, nil)
+	app.MountStores(key)
+	require.NoError(t, app.EnableIndexer(
+		indexer.ManagerConfig{Target: map[string]indexer.Config{"test": {Type: "baseapp_test_rollback"}}},
+		map[string]*storetypes.KVStoreKey{"indexed": key},
+		map[string]any{"indexed": indexedModule{}},
+	))
+	require.NoError(t, app.LoadLatestVersion())
+	require.Equal(t, []uint64{3}, view.rollbacks)
+
+	// the indexer is rolled back to the last committed block after the app state is rolled back
+	require.NoError(t, app.CommitMultiStore().RollbackToVersion(2))
+	require.NoError(t, app.RollbackIndexer())
+	require.Equal(t, []uint64{3, 2}, view.rollbacks)
+	require.Equal(t, uint64(2), view.blockNum)
+}
+
+// rollbackIndexerView is the view of an indexer supporting rollbacks.
+type rollbackIndexerView struct {
+	blockNum  uint64
+	rollbacks []uint64
+}
+
+func (v *rollbackIndexerView) BlockNum() (uint64, error) { return v.blockNum, nil }
+
+func (v *rollbackIndexerView) AppState() view.AppState { return nil }
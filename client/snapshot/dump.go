@@ -11,6 +11,9 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
@@ -20,6 +23,7 @@ func DumpArchiveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump <height> <format>",
 		Short: "Dump the snapshot as portable archive format",
+		Long:  "Dump the snapshot as portable archive format. A delta snapshot is dumped along with the snapshots it is based on.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viper := client.GetViperFromCmd(cmd)
@@ -46,20 +50,16 @@ func DumpArchiveCmd() *cobra.Command {
 				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
 			}
 
-			snapshot, err := snapshotStore.Get(height, uint32(format))
+			// a delta snapshot is dumped along with its chain of base snapshots
+			chain, err := snapshotStore.GetChain(height, uint32(format))
 			if err != nil {
 				return err
 			}
 
-			if snapshot == nil {
+			if chain == nil {
 				return errors.New("snapshot doesn't exist")
 			}
 
-			bz, err := snapshot.Marshal()
-			if err != nil {
-				return err
-			}
-
 			fp, err := os.Create(output)
 			if err != nil {
 				return err
@@ -72,21 +72,8 @@ func DumpArchiveCmd() *cobra.Command {
 				return err
 			}
 			tarWriter := tar.NewWriter(gzipWriter)
-			if err := tarWriter.WriteHeader(&tar.Header{
-				Name: SnapshotFileName,
-				Mode: 0o644,
-				Size: int64(len(bz)),
-			}); err != nil {
-				return fmt.Errorf("failed to write snapshot header to tar: %w", err)
-			}
-			if _, err := tarWriter.Write(bz); err != nil {
-				return fmt.Errorf("failed to write snapshot to tar: %w", err)
-			}
-
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				path := snapshotStore.PathChunk(height, uint32(format), i)
-				tarName := strconv.FormatUint(uint64(i), 10)
-				if err := processChunk(tarWriter, path, tarName); err != nil {
+			for _, snapshot := range chain {
+				if err := processSnapshot(tarWriter, snapshotStore, snapshot); err != nil {
 					return err
 				}
 			}
@@ -108,6 +95,35 @@ func DumpArchiveCmd() *cobra.Command {
 	return cmd
 }
 
+// processSnapshot writes the snapshot metadata followed by its chunks to the archive.
+func processSnapshot(tarWriter *tar.Writer, snapshotStore *snapshots.Store, snapshot *snapshottypes.Snapshot) error {
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: SnapshotFileName,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	}); err != nil {
+		return fmt.Errorf("failed to write snapshot header to tar: %w", err)
+	}
+	if _, err := tarWriter.Write(bz); err != nil {
+		return fmt.Errorf("failed to write snapshot to tar: %w", err)
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		path := snapshotStore.PathChunk(snapshot.Height, snapshot.Format, i)
+		tarName := strconv.FormatUint(uint64(i), 10)
+		if err := processChunk(tarWriter, path, tarName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func processChunk(tarWriter *tar.Writer, path, tarName string) error {
 	file, err := os.Open(path)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -24,6 +25,10 @@ func ExportSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCrea
 			if err != nil {
 				return err
 			}
+			delta, err := cmd.Flags().GetBool("delta")
+			if err != nil {
+				return err
+			}
 
 			home := cfg.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(viper))
@@ -40,7 +45,12 @@ func ExportSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCrea
 			cmd.Printf("Exporting snapshot for height %d\n", height)
 
 			sm := app.SnapshotManager()
-			snapshot, err := sm.Create(uint64(height))
+			var snapshot *snapshottypes.Snapshot
+			if delta {
+				snapshot, err = sm.CreateDelta(uint64(height))
+			} else {
+				snapshot, err = sm.Create(uint64(height))
+			}
 			if err != nil {
 				return err
 			}
@@ -51,6 +61,7 @@ func ExportSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCrea
 	}
 
 	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().Bool("delta", false, "Export an incremental snapshot containing the changes since the latest snapshot")
 
 	return cmd
 }
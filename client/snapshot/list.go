@@ -5,6 +5,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
@@ -24,6 +26,14 @@ var ListSnapshotsCmd = &cobra.Command{
 			return fmt.Errorf("failed to list snapshots: %w", err)
 		}
 		for _, snapshot := range snapshots {
+			if snapshot.Format == snapshottypes.DeltaFormat {
+				baseHeight, baseFormat, err := snapshotStore.GetDeltaBase(snapshot.Height, snapshot.Format)
+				if err != nil {
+					return fmt.Errorf("failed to get the base of snapshot %d: %w", snapshot.Height, err)
+				}
+				cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks, "base height:", baseHeight, "base format:", baseFormat)
+				continue
+			}
 			cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
 		}
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -26,6 +27,7 @@ func LoadArchiveCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "load <archive-file>",
 		Short: "Load a snapshot archive file (.tar.gz) into snapshot store",
+		Long:  "Load a snapshot archive file (.tar.gz) into snapshot store. The archive of a delta snapshot also contains the snapshots it is based on, which are skipped if they are already in the snapshot store.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viper := client.GetViperFromCmd(cmd)
@@ -39,78 +41,102 @@ func LoadArchiveCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to open archive file: %w", err)
 			}
+			defer fp.Close()
 			reader, err := gzip.NewReader(fp)
 			if err != nil {
 				return fmt.Errorf("failed to create gzip reader: %w", err)
 			}
 
-			var snapshot snapshottypes.Snapshot
 			tr := tar.NewReader(reader)
-			if err != nil {
-				return fmt.Errorf("failed to create tar reader: %w", err)
-			}
-
 			hdr, err := tr.Next()
 			if err != nil {
 				return fmt.Errorf("failed to read snapshot file header: %w", err)
 			}
-			if hdr.Name != SnapshotFileName {
-				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
-			}
-			bz, err := io.ReadAll(tr)
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file: %w", err)
-			}
-			if err := snapshot.Unmarshal(bz); err != nil {
-				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
-			}
-
-			// make sure the channel is unbuffered, because the tar reader can't do concurrency
-			chunks := make(chan io.ReadCloser)
-			quitChan := make(chan *snapshottypes.Snapshot)
-			go func() {
-				defer close(quitChan)
-
-				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
-				if err != nil {
-					cmd.Println("failed to save snapshot", err)
-					return
+			// the snapshots of the archive are loaded in order, the bases of a delta first
+			for {
+				if hdr.Name != SnapshotFileName {
+					return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
 				}
-				quitChan <- savedSnapshot
-			}()
-
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
-				if err != nil {
-					if errors.Is(err, io.EOF) {
-						break
-					}
-					return err
-				}
-
-				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+				hdr, err = loadSnapshot(cmd, snapshotStore, tr)
+				if errors.Is(err, io.EOF) {
+					return nil
 				}
-
-				bz, err := io.ReadAll(tr)
 				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
+					return err
 				}
-				chunks <- io.NopCloser(bytes.NewReader(bz))
 			}
-			close(chunks)
+		},
+	}
+}
 
-			savedSnapshot := <-quitChan
-			if savedSnapshot == nil {
-				return errors.New("failed to save snapshot")
-			}
+// loadSnapshot loads the snapshot whose metadata is the current file of the archive into the
+// snapshot store, and returns the header of the next file of the archive.
+func loadSnapshot(cmd *cobra.Command, snapshotStore *snapshots.Store, tr *tar.Reader) (*tar.Header, error) {
+	var snapshot snapshottypes.Snapshot
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
 
-			if !reflect.DeepEqual(&snapshot, savedSnapshot) {
-				_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
-				return errors.New("invalid archive, the saved snapshot is not equal to the original one")
+	existing, err := snapshotStore.Get(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return nil, err
+	}
+	skip := existing != nil && bytes.Equal(existing.Hash, snapshot.Hash)
+
+	// make sure the channel is unbuffered, because the tar reader can't do concurrency
+	chunks := make(chan io.ReadCloser)
+	quitChan := make(chan *snapshottypes.Snapshot)
+	go func() {
+		defer close(quitChan)
+
+		// the snapshot is already in the store, e.g. the base of a delta
+		if skip {
+			snapshots.DrainChunks(chunks)
+			quitChan <- existing
+			return
+		}
+		savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+		if err != nil {
+			cmd.Println("failed to save snapshot", err)
+			return
+		}
+		quitChan <- savedSnapshot
+	}()
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
 			}
+			return nil, err
+		}
+
+		if hdr.Name != strconv.FormatInt(int64(i), 10) {
+			return nil, fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+		}
+
+		bz, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chunk file: %w", err)
+		}
+		chunks <- io.NopCloser(bytes.NewReader(bz))
+	}
+	close(chunks)
 
-			return nil
-		},
+	savedSnapshot := <-quitChan
+	if savedSnapshot == nil {
+		return nil, errors.New("failed to save snapshot")
 	}
+
+	if !skip && !reflect.DeepEqual(&snapshot, savedSnapshot) {
+		_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+		return nil, errors.New("invalid archive, the saved snapshot is not equal to the original one")
+	}
+
+	return tr.Next()
 }
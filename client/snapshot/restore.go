@@ -19,7 +19,7 @@ func RestoreSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCre
 	cmd := &cobra.Command{
 		Use:   "restore <height> <format>",
 		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
+		Long:  "Restore app state from local snapshot. A delta snapshot is restored along with the snapshots it is based on, starting from a full snapshot.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg := client.GetConfigFromCmd(cmd)
@@ -182,6 +182,7 @@ replace (
 	cosmossdk.io/api => ./../../api
 	cosmossdk.io/core => ./../../core
 	cosmossdk.io/core/testing => ../../core/testing
+	cosmossdk.io/schema => ./../../schema
 	cosmossdk.io/store => ./../../store
 	cosmossdk.io/x/accounts => ./../../x/accounts
 	cosmossdk.io/x/auth => ./../../x/auth
@@ -2,8 +2,28 @@ package store
 
 // StoreUpgrades defines a series of transformations to apply the multistore db upon load
 type StoreUpgrades struct {
-	Added   []string `json:"added"`
-	Deleted []string `json:"deleted"`
+	Added   []string      `json:"added"`
+	Renamed []StoreRename `json:"renamed"`
+	Deleted []string      `json:"deleted"`
+	Moved   []StoreMove   `json:"moved"`
+}
+
+// StoreRename defines a name change of a store.
+// All data previously under OldKey will be copied to NewKey, then OldKey is
+// deleted.
+type StoreRename struct {
+	OldKey string `json:"old_key"`
+	NewKey string `json:"new_key"`
+}
+
+// StoreMove defines a move of a prefix of a store into another store.
+// All data under Prefix in the FromKey store will be copied under NewPrefix in
+// the ToKey store, then deleted from the FromKey store.
+type StoreMove struct {
+	FromKey   string `json:"from_key"`
+	ToKey     string `json:"to_key"`
+	Prefix    []byte `json:"prefix"`
+	NewPrefix []byte `json:"new_prefix"`
 }
 
 // IsAdded returns true if the given key should be added
@@ -31,3 +51,30 @@ func (s *StoreUpgrades) IsDeleted(key string) bool {
 	}
 	return false
 }
+
+// RenamedFrom returns the oldKey if it was renamed
+// Returns "" if it was not renamed
+func (s *StoreUpgrades) RenamedFrom(key string) string {
+	if s == nil {
+		return ""
+	}
+	for _, re := range s.Renamed {
+		if re.NewKey == key {
+			return re.OldKey
+		}
+	}
+	return ""
+}
+
+// IsRenamed returns true if the given key is the old key of a rename
+func (s *StoreUpgrades) IsRenamed(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, re := range s.Renamed {
+		if re.OldKey == key {
+			return true
+		}
+	}
+	return false
+}
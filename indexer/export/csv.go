@@ -0,0 +1,67 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+// csvWriter writes rows to a CSV file with a header row of column names. Null values are written as
+// empty strings.
+type csvWriter struct {
+	file         io.WriteCloser
+	w            *csv.Writer
+	columns      []schema.Field
+	addressCodec addressutil.AddressCodec
+	record       []string
+}
+
+func newCSVWriter(file io.WriteCloser, columns []schema.Field, addressCodec addressutil.AddressCodec) (TableWriter, error) {
+	w := &csvWriter{
+		file:         file,
+		w:            csv.NewWriter(file),
+		columns:      columns,
+		addressCodec: addressCodec,
+		record:       make([]string, len(columns)),
+	}
+
+	for i, col := range columns {
+		w.record[i] = col.Name
+	}
+
+	if err := w.w.Write(w.record); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *csvWriter) WriteRow(values []interface{}) error {
+	for i, col := range w.columns {
+		if values[i] == nil {
+			w.record[i] = ""
+			continue
+		}
+
+		text, err := textValue(col.Kind, values[i], w.addressCodec)
+		if err != nil {
+			return err
+		}
+		w.record[i] = text
+	}
+
+	return w.w.Write(w.record)
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	if err := w.w.Error(); err != nil {
+		_ = w.file.Close()
+		return err
+	}
+
+	return w.file.Close()
+}
@@ -0,0 +1,180 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+)
+
+// Format is the file format objects are exported to.
+type Format string
+
+const (
+	// FormatCSV exports each object type to a CSV file with a header row.
+	FormatCSV Format = "csv"
+
+	// FormatParquet exports each object type to a Parquet file. It is only available once the
+	// cosmossdk.io/indexer/export/parquet package is imported.
+	FormatParquet Format = "parquet"
+)
+
+// Options are the options for an Exporter.
+type Options struct {
+	// OutputDir is the directory files are written to. Each module gets its own sub-directory
+	// with one file per object type named after the object type.
+	OutputDir string
+
+	// Format is the file format to export to. It defaults to FormatCSV.
+	Format Format
+
+	// AddressCodec is the codec used to encode address fields as strings. It defaults to
+	// addressutil.HexAddressCodec.
+	AddressCodec addressutil.AddressCodec
+
+	// ModuleFilter optionally restricts which modules are exported.
+	ModuleFilter func(moduleName string) bool
+}
+
+// Export decodes all the state of the sync source with the module codecs of the resolver and writes
+// the objects of every module to files in the output directory.
+func Export(source decoding.SyncSource, resolver decoding.DecoderResolver, opts Options) error {
+	exp, err := NewExporter(opts)
+	if err != nil {
+		return err
+	}
+
+	err = decoding.Sync(exp.Listener(), source, resolver, decoding.SyncOptions{
+		ModuleFilter: opts.ModuleFilter,
+	})
+	if err != nil {
+		_ = exp.Close()
+		return err
+	}
+
+	return exp.Close()
+}
+
+// Exporter writes the objects it receives as an appdata.Listener to files. It is meant to be used
+// to export the state of an app at a given height, so object deletions are rejected.
+type Exporter struct {
+	opts    Options
+	modules map[string]map[string]*objectExporter
+}
+
+// NewExporter creates a new Exporter with the provided options. Close must be called once all the
+// objects have been received to flush the files.
+func NewExporter(opts Options) (*Exporter, error) {
+	if opts.OutputDir == "" {
+		return nil, errors.New("missing output directory")
+	}
+
+	if opts.Format == "" {
+		opts.Format = FormatCSV
+	}
+	if _, ok := formatRegistry[opts.Format]; !ok {
+		return nil, fmt.Errorf("unsupported export format %q, make sure the package implementing it is imported", opts.Format)
+	}
+
+	if opts.AddressCodec == nil {
+		opts.AddressCodec = addressutil.HexAddressCodec{}
+	}
+
+	return &Exporter{
+		opts:    opts,
+		modules: map[string]map[string]*objectExporter{},
+	}, nil
+}
+
+// Listener returns the appdata.Listener which writes objects to files.
+func (e *Exporter) Listener() appdata.Listener {
+	return appdata.Listener{
+		InitializeModuleData: e.initializeModule,
+		OnObjectUpdate:       e.onObjectUpdate,
+	}
+}
+
+// Close flushes and closes all the files, returning the first error encountered.
+func (e *Exporter) Close() error {
+	var firstErr error
+	for _, objects := range e.modules {
+		for _, obj := range objects {
+			if err := obj.writer.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	e.modules = map[string]map[string]*objectExporter{}
+	return firstErr
+}
+
+func (e *Exporter) initializeModule(data appdata.ModuleInitializationData) error {
+	if _, ok := e.modules[data.ModuleName]; ok {
+		return fmt.Errorf("module %s already initialized", data.ModuleName)
+	}
+
+	dir := filepath.Join(e.opts.OutputDir, data.ModuleName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	objects := map[string]*objectExporter{}
+	e.modules[data.ModuleName] = objects
+
+	var err error
+	data.Schema.ObjectTypes(func(typ schema.ObjectType) bool {
+		columns := tableColumns(typ)
+		var w TableWriter
+		w, err = e.createWriter(filepath.Join(dir, fmt.Sprintf("%s.%s", typ.Name, e.opts.Format)), columns)
+		if err != nil {
+			return false
+		}
+
+		objects[typ.Name] = &objectExporter{typ: typ, columns: columns, writer: w}
+		return true
+	})
+	return err
+}
+
+func (e *Exporter) createWriter(path string, columns []schema.Field) (TableWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatRegistry[e.opts.Format](f, columns, e.opts.AddressCodec)
+}
+
+func (e *Exporter) onObjectUpdate(data appdata.ObjectUpdateData) error {
+	objects, ok := e.modules[data.ModuleName]
+	if !ok {
+		return fmt.Errorf("module %s not initialized", data.ModuleName)
+	}
+
+	for _, update := range data.Updates {
+		obj, ok := objects[update.TypeName]
+		if !ok {
+			return fmt.Errorf("object type %s not found in schema for module %s", update.TypeName, data.ModuleName)
+		}
+
+		if update.Delete {
+			return fmt.Errorf("cannot export deletion of object type %s in module %s", update.TypeName, data.ModuleName)
+		}
+
+		row, err := obj.row(update)
+		if err != nil {
+			return err
+		}
+
+		if err := obj.writer.WriteRow(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package export
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+)
+
+var testModuleSchema = func() schema.ModuleSchema {
+	modSchema, err := schema.NewModuleSchema([]schema.ObjectType{
+		{
+			Name: "balance",
+			KeyFields: []schema.Field{
+				{Name: "address", Kind: schema.AddressKind},
+				{Name: "denom", Kind: schema.StringKind},
+			},
+			ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerStringKind}},
+		},
+		{
+			Name: "params",
+			ValueFields: []schema.Field{
+				{Name: "send_enabled", Kind: schema.BoolKind},
+				{Name: "max_memo", Kind: schema.Uint32Kind, Nullable: true},
+				{Name: "upgrade_time", Kind: schema.TimeKind, Nullable: true},
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return modSchema
+}()
+
+// testModule decodes keys of the form "balance/<address>/<denom>" with the amount as value and
+// the "params" key with send_enabled as value.
+type testModule struct{}
+
+func (testModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: testModuleSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			parts := strings.Split(string(update.Key), "/")
+			switch parts[0] {
+			case "balance":
+				return []schema.ObjectUpdate{{
+					TypeName: "balance",
+					Key:      []interface{}{[]byte(parts[1]), parts[2]},
+					Value:    string(update.Value),
+				}}, nil
+			case "params":
+				return []schema.ObjectUpdate{{
+					TypeName: "params",
+					Value: schema.MapValueUpdates{
+						"send_enabled": string(update.Value) == "true",
+						"upgrade_time": time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+					},
+				}}, nil
+			default:
+				return nil, nil
+			}
+		},
+	}, nil
+}
+
+type testSource map[string]map[string]string
+
+func (s testSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	kvs := s[moduleName]
+	keys := make([]string, 0, len(kvs))
+	for k := range kvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := fn([]byte(k), []byte(kvs[k])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestExport_CSV(t *testing.T) {
+	dir := exportTestState(t, FormatCSV)
+
+	expectFile(t, filepath.Join(dir, "bank", "balance.csv"), `address,denom,amount
+0x01,atom,5
+0x01,stake,100
+0x0203,stake,1000000000000000000000
+`)
+	expectFile(t, filepath.Join(dir, "bank", "params.csv"), `send_enabled,max_memo,upgrade_time
+true,,2024-01-02T03:04:05.000000006Z
+`)
+
+	// modules without a codec and filtered out modules are not exported
+	for _, module := range []string{"auth", "gov"} {
+		if _, err := os.Stat(filepath.Join(dir, module)); !os.IsNotExist(err) {
+			t.Fatalf("expected module %s not to be exported, got %v", module, err)
+		}
+	}
+}
+
+func TestExport_Errors(t *testing.T) {
+	if _, err := NewExporter(Options{}); err == nil {
+		t.Fatal("expected an error without an output directory")
+	}
+
+	if _, err := NewExporter(Options{OutputDir: t.TempDir(), Format: "xlsx"}); err == nil {
+		t.Fatal("expected an error for an unsupported format")
+	}
+
+	exp, err := NewExporter(Options{OutputDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = exp.Close() }()
+
+	listener := exp.Listener()
+	if err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "bank", Schema: testModuleSchema}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, update := range []schema.ObjectUpdate{
+		{TypeName: "balance", Key: []interface{}{[]byte{1}, "atom"}, Delete: true},
+		{TypeName: "balance", Key: []byte{1}, Value: "5"},
+		{TypeName: "params", Value: []interface{}{nil, nil, nil}},
+		{TypeName: "unknown"},
+	} {
+		if err := listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "bank", Updates: []schema.ObjectUpdate{update}}); err == nil {
+			t.Fatalf("expected an error for update %v", update)
+		}
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic when registering a format twice")
+		}
+	}()
+
+	RegisterFormat(FormatCSV, newCSVWriter)
+}
+
+func exportTestState(t *testing.T, format Format) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	resolver := decoding.ModuleSetDecoderResolver(map[string]interface{}{
+		"auth": struct{}{},
+		"bank": testModule{},
+		"gov":  testModule{},
+	})
+	source := testSource{
+		"bank": {
+			"balance/\x01/atom":      "5",
+			"balance/\x01/stake":     "100",
+			"balance/\x02\x03/stake": "1000000000000000000000",
+			"params":                 "true",
+			"other":                  "ignored",
+		},
+	}
+
+	err := Export(source, resolver, Options{
+		OutputDir:    dir,
+		Format:       format,
+		ModuleFilter: func(moduleName string) bool { return moduleName != "gov" },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func expectFile(t *testing.T, path, expected string) {
+	t.Helper()
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bz, []byte(expected)) {
+		t.Fatalf("unexpected content of %s, expected:\n%s\ngot:\n%s", path, expected, bz)
+	}
+}
@@ -0,0 +1,224 @@
+// Package parquet implements the Parquet format of cosmossdk.io/indexer/export with parquet-go.
+// Importing it registers export.FormatParquet.
+package parquet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+	"github.com/parquet-go/parquet-go/encoding"
+
+	"cosmossdk.io/indexer/export"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+// RowGroupSize is the number of rows buffered in memory before a row group is written.
+const RowGroupSize = 1 << 16
+
+func init() {
+	export.RegisterFormat(export.FormatParquet, NewWriter)
+}
+
+// writer writes rows to a Snappy compressed Parquet file. Nullable fields are optional columns, all other
+// fields are required columns.
+type writer struct {
+	file         io.WriteCloser
+	w            *parquet.Writer
+	columns      []schema.Field
+	addressCodec addressutil.AddressCodec
+	row          parquet.Row
+}
+
+// NewWriter creates an export.TableWriter writing rows to a Parquet file.
+func NewWriter(file io.WriteCloser, columns []schema.Field, addressCodec addressutil.AddressCodec) (export.TableWriter, error) {
+	group := make(columnGroup, len(columns))
+	for i, col := range columns {
+		node, err := columnNode(col)
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		group[i] = &columnField{Node: node, name: col.Name}
+	}
+
+	return &writer{
+		file: file,
+		w: parquet.NewWriter(file,
+			parquet.NewSchema("schema", group),
+			parquet.Compression(&parquet.Snappy),
+			parquet.MaxRowsPerRowGroup(RowGroupSize),
+			parquet.CreatedBy("cosmossdk.io/indexer/export", "", ""),
+		),
+		columns:      columns,
+		addressCodec: addressCodec,
+		row:          make(parquet.Row, len(columns)),
+	}, nil
+}
+
+// WriteRow implements export.TableWriter.
+func (w *writer) WriteRow(values []interface{}) error {
+	for i, col := range w.columns {
+		definitionLevel := 0
+		if col.Nullable && values[i] != nil {
+			definitionLevel = 1
+		}
+
+		v, err := w.value(col.Kind, values[i])
+		if err != nil {
+			return err
+		}
+		w.row[i] = v.Level(0, definitionLevel, i)
+	}
+
+	_, err := w.w.WriteRows([]parquet.Row{w.row})
+	return err
+}
+
+// Close implements export.TableWriter.
+func (w *writer) Close() error {
+	if err := w.w.Close(); err != nil {
+		_ = w.file.Close()
+		return err
+	}
+
+	return w.file.Close()
+}
+
+// value converts a value of the kind to a Parquet value of the physical type of its column.
+func (w *writer) value(kind schema.Kind, value interface{}) (parquet.Value, error) {
+	if value == nil {
+		return parquet.NullValue(), nil
+	}
+
+	switch kind {
+	case schema.BoolKind:
+		return parquet.BooleanValue(value.(bool)), nil
+	case schema.StringKind, schema.EnumKind, schema.IntegerStringKind, schema.DecimalStringKind:
+		return parquet.ByteArrayValue([]byte(value.(string))), nil
+	case schema.BytesKind:
+		return parquet.ByteArrayValue(value.([]byte)), nil
+	case schema.JSONKind:
+		return parquet.ByteArrayValue(value.(json.RawMessage)), nil
+	case schema.AddressKind:
+		addr, err := w.addressCodec.BytesToString(value.([]byte))
+		if err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.ByteArrayValue([]byte(addr)), nil
+	case schema.Int8Kind:
+		return parquet.Int32Value(int32(value.(int8))), nil
+	case schema.Int16Kind:
+		return parquet.Int32Value(int32(value.(int16))), nil
+	case schema.Int32Kind:
+		return parquet.Int32Value(value.(int32)), nil
+	case schema.Uint8Kind:
+		return parquet.Int32Value(int32(value.(uint8))), nil
+	case schema.Uint16Kind:
+		return parquet.Int32Value(int32(value.(uint16))), nil
+	case schema.Uint32Kind:
+		return parquet.Int32Value(int32(value.(uint32))), nil
+	case schema.Int64Kind:
+		return parquet.Int64Value(value.(int64)), nil
+	case schema.Uint64Kind:
+		return parquet.Int64Value(int64(value.(uint64))), nil
+	case schema.Float32Kind:
+		return parquet.FloatValue(value.(float32)), nil
+	case schema.Float64Kind:
+		return parquet.DoubleValue(value.(float64)), nil
+	case schema.TimeKind:
+		return parquet.Int64Value(value.(time.Time).UnixNano()), nil
+	case schema.DurationKind:
+		return parquet.Int64Value(int64(value.(time.Duration))), nil
+	default:
+		return parquet.Value{}, fmt.Errorf("unsupported kind %s", kind)
+	}
+}
+
+// columnNode returns the Parquet node of the column of a field. String columns are dictionary encoded as
+// they usually hold few distinct values, such as denoms or addresses.
+func columnNode(field schema.Field) (parquet.Node, error) {
+	var node parquet.Node
+	switch field.Kind {
+	case schema.StringKind, schema.EnumKind, schema.AddressKind:
+		node = parquet.Encoded(parquet.String(), &parquet.RLEDictionary)
+	case schema.IntegerStringKind, schema.DecimalStringKind:
+		node = parquet.String()
+	case schema.JSONKind:
+		node = parquet.JSON()
+	case schema.BytesKind:
+		node = parquet.Leaf(parquet.ByteArrayType)
+	case schema.BoolKind:
+		node = parquet.Leaf(parquet.BooleanType)
+	case schema.Int8Kind:
+		node = parquet.Int(8)
+	case schema.Int16Kind:
+		node = parquet.Int(16)
+	case schema.Int32Kind:
+		node = parquet.Int(32)
+	case schema.Int64Kind, schema.DurationKind:
+		node = parquet.Int(64)
+	case schema.Uint8Kind:
+		node = parquet.Uint(8)
+	case schema.Uint16Kind:
+		node = parquet.Uint(16)
+	case schema.Uint32Kind:
+		node = parquet.Uint(32)
+	case schema.Uint64Kind:
+		node = parquet.Uint(64)
+	case schema.Float32Kind:
+		node = parquet.Leaf(parquet.FloatType)
+	case schema.Float64Kind:
+		node = parquet.Leaf(parquet.DoubleType)
+	case schema.TimeKind:
+		node = parquet.Timestamp(parquet.Nanosecond)
+	default:
+		return nil, fmt.Errorf("unsupported kind %s for field %s", field.Kind, field.Name)
+	}
+
+	if field.Nullable {
+		node = parquet.Optional(node)
+	}
+	return node, nil
+}
+
+// columnGroup is the root node of the schema of a file. Unlike parquet.Group, which sorts its fields by
+// name, it keeps the columns in the order of the fields of the object type.
+type columnGroup []parquet.Field
+
+func (g columnGroup) ID() int                     { return 0 }
+func (g columnGroup) String() string              { return g.group().String() }
+func (g columnGroup) Type() parquet.Type          { return g.group().Type() }
+func (g columnGroup) Optional() bool              { return false }
+func (g columnGroup) Repeated() bool              { return false }
+func (g columnGroup) Required() bool              { return true }
+func (g columnGroup) Leaf() bool                  { return false }
+func (g columnGroup) Fields() []parquet.Field     { return g }
+func (g columnGroup) Encoding() encoding.Encoding { return nil }
+func (g columnGroup) Compression() compress.Codec { return nil }
+func (g columnGroup) GoType() reflect.Type        { return g.group().GoType() }
+
+func (g columnGroup) group() parquet.Group {
+	group := make(parquet.Group, len(g))
+	for _, f := range g {
+		group[f.Name()] = f
+	}
+	return group
+}
+
+// columnField is a named column of a columnGroup.
+type columnField struct {
+	parquet.Node
+	name string
+}
+
+func (f *columnField) Name() string { return f.name }
+
+func (f *columnField) Value(base reflect.Value) reflect.Value {
+	return base.MapIndex(reflect.ValueOf(f.name))
+}
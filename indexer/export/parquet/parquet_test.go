@@ -0,0 +1,184 @@
+package parquet_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/export"
+	exportparquet "cosmossdk.io/indexer/export/parquet"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+var testObjectType = schema.ObjectType{
+	Name:      "row",
+	KeyFields: []schema.Field{{Name: "id", Kind: schema.Uint64Kind}},
+	ValueFields: []schema.Field{
+		{Name: "flag", Kind: schema.BoolKind},
+		{Name: "small", Kind: schema.Int8Kind, Nullable: true},
+		{Name: "count", Kind: schema.Uint32Kind},
+		{Name: "ratio", Kind: schema.Float64Kind, Nullable: true},
+		{Name: "ts", Kind: schema.TimeKind},
+		{Name: "dur", Kind: schema.DurationKind},
+		{Name: "data", Kind: schema.BytesKind, Nullable: true},
+		{Name: "meta", Kind: schema.JSONKind},
+		{Name: "owner", Kind: schema.AddressKind},
+		{Name: "name", Kind: schema.StringKind},
+	},
+}
+
+// TestParquet_RoundTrip exports rows spanning several row groups and reads them back to check the
+// schema and values of the files written by the exporter.
+func TestParquet_RoundTrip(t *testing.T) {
+	modSchema, err := schema.NewModuleSchema([]schema.ObjectType{testObjectType})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	exp, err := export.NewExporter(export.Options{OutputDir: dir, Format: export.FormatParquet})
+	require.NoError(t, err)
+
+	listener := exp.Listener()
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "test", Schema: modSchema}))
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	numRows := exportparquet.RowGroupSize + 10
+	updates := make([]schema.ObjectUpdate, 0, numRows)
+	for i := 0; i < numRows; i++ {
+		var small, ratio, data interface{}
+		if i%3 == 0 {
+			small = int8(-i % 100)
+			ratio = float64(i) / 2
+			data = []byte{byte(i)}
+		}
+
+		updates = append(updates, schema.ObjectUpdate{
+			TypeName: "row",
+			Key:      uint64(math.MaxUint64) - uint64(i),
+			Value: []interface{}{
+				i%2 == 0,
+				small,
+				uint32(i),
+				ratio,
+				ts.Add(time.Duration(i)),
+				time.Duration(i) * time.Second,
+				data,
+				json.RawMessage(`{"a":1}`),
+				[]byte{0xab, byte(i)},
+				"name",
+			},
+		})
+	}
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "test", Updates: updates}))
+	require.NoError(t, exp.Close())
+
+	f, err := os.Open(filepath.Join(dir, "test", "row.parquet"))
+	require.NoError(t, err)
+	defer f.Close()
+	stat, err := f.Stat()
+	require.NoError(t, err)
+
+	file, err := parquet.OpenFile(f, stat.Size())
+	require.NoError(t, err)
+	require.Equal(t, int64(numRows), file.NumRows())
+	require.Len(t, file.RowGroups(), 2)
+
+	// columns are in the order of the fields, key fields first
+	var columns []string
+	for _, elem := range file.Metadata().Schema[1:] {
+		columns = append(columns, elem.Name)
+	}
+	require.Equal(t, []string{"id", "flag", "small", "count", "ratio", "ts", "dur", "data", "meta", "owner", "name"}, columns)
+
+	for _, chunk := range file.Metadata().RowGroups[0].Columns {
+		require.Equal(t, format.Snappy, chunk.MetaData.Codec)
+	}
+
+	fileSchema := file.Schema()
+	expectColumn(t, fileSchema, "id", parquet.Int64Type.Kind(), false, parquet.Uint(64))
+	expectColumn(t, fileSchema, "flag", parquet.Boolean, false, nil)
+	expectColumn(t, fileSchema, "small", parquet.Int32Type.Kind(), true, parquet.Int(8))
+	expectColumn(t, fileSchema, "count", parquet.Int32Type.Kind(), false, parquet.Uint(32))
+	expectColumn(t, fileSchema, "ratio", parquet.Double, true, nil)
+	expectColumn(t, fileSchema, "ts", parquet.Int64Type.Kind(), false, parquet.Timestamp(parquet.Nanosecond))
+	expectColumn(t, fileSchema, "dur", parquet.Int64Type.Kind(), false, parquet.Int(64))
+	expectColumn(t, fileSchema, "data", parquet.ByteArray, true, nil)
+	expectColumn(t, fileSchema, "meta", parquet.ByteArray, false, parquet.JSON())
+	expectColumn(t, fileSchema, "owner", parquet.ByteArray, false, parquet.String())
+	expectColumn(t, fileSchema, "name", parquet.ByteArray, false, parquet.String())
+
+	reader := parquet.NewReader(file)
+	defer reader.Close()
+
+	rows := make([]parquet.Row, 1000)
+	i := 0
+	for {
+		n, err := reader.ReadRows(rows)
+		for _, row := range rows[:n] {
+			expectRow(t, i, row, ts)
+			i++
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+	}
+	require.Equal(t, numRows, i)
+}
+
+func expectColumn(t *testing.T, fileSchema *parquet.Schema, name string, kind parquet.Kind, optional bool, logicalType parquet.Node) {
+	t.Helper()
+	var field parquet.Field
+	for _, f := range fileSchema.Fields() {
+		if f.Name() == name {
+			field = f
+		}
+	}
+	require.NotNil(t, field, "column %s not found", name)
+	require.Equal(t, kind, field.Type().Kind(), "column %s", name)
+	require.Equal(t, optional, field.Optional(), "column %s", name)
+	if logicalType != nil {
+		require.Equal(t, logicalType.Type().LogicalType(), field.Type().LogicalType(), "column %s", name)
+	}
+}
+
+func expectRow(t *testing.T, i int, row parquet.Row, ts time.Time) {
+	t.Helper()
+	values := make(map[int]parquet.Value, len(row))
+	for _, v := range row {
+		values[v.Column()] = v
+	}
+
+	require.Equal(t, uint64(math.MaxUint64)-uint64(i), values[0].Uint64(), "row %d", i)
+	require.Equal(t, i%2 == 0, values[1].Boolean(), "row %d", i)
+	require.Equal(t, uint32(i), values[3].Uint32(), "row %d", i)
+	require.Equal(t, ts.Add(time.Duration(i)).UnixNano(), values[5].Int64(), "row %d", i)
+	require.Equal(t, int64(time.Duration(i)*time.Second), values[6].Int64(), "row %d", i)
+	require.Equal(t, `{"a":1}`, string(values[8].ByteArray()), "row %d", i)
+	require.Equal(t, "0xab"+hexByte(byte(i)), string(values[9].ByteArray()), "row %d", i)
+	require.Equal(t, "name", string(values[10].ByteArray()), "row %d", i)
+
+	if i%3 != 0 {
+		for _, col := range []int{2, 4, 7} {
+			require.True(t, values[col].IsNull(), "row %d column %d", i, col)
+		}
+		return
+	}
+	require.Equal(t, int32(-i%100), values[2].Int32(), "row %d", i)
+	require.Equal(t, float64(i)/2, values[4].Double(), "row %d", i)
+	require.Equal(t, []byte{byte(i)}, values[7].ByteArray(), "row %d", i)
+}
+
+func hexByte(b byte) string {
+	const digits = "0123456789abcdef"
+	return string([]byte{digits[b>>4], digits[b&0xf]})
+}
@@ -0,0 +1,175 @@
+package export
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+// TableWriter writes the rows of an object type to a file.
+type TableWriter interface {
+	// WriteRow writes a row with one value per column, nil values representing nulls.
+	WriteRow(values []interface{}) error
+
+	// Close flushes the pending rows and closes the file.
+	Close() error
+}
+
+// NewTableWriterFunc creates a TableWriter writing rows of the columns to the file, address values
+// being rendered with the address codec. The TableWriter owns the file, which must be closed if an error
+// is returned.
+type NewTableWriterFunc func(file io.WriteCloser, columns []schema.Field, addressCodec addressutil.AddressCodec) (TableWriter, error)
+
+// RegisterFormat registers a file format objects can be exported to. Formats which depend on packages
+// other than the golang standard library are implemented in their own modules which register them
+// when imported, like cosmossdk.io/indexer/export/parquet.
+func RegisterFormat(format Format, newWriter NewTableWriterFunc) {
+	if _, ok := formatRegistry[format]; ok {
+		panic(fmt.Sprintf("export format %s already registered", format))
+	}
+
+	formatRegistry[format] = newWriter
+}
+
+var formatRegistry = map[Format]NewTableWriterFunc{
+	FormatCSV: newCSVWriter,
+}
+
+// tableColumns returns the columns of an object type, key fields first followed by value fields.
+func tableColumns(typ schema.ObjectType) []schema.Field {
+	columns := make([]schema.Field, 0, len(typ.KeyFields)+len(typ.ValueFields))
+	columns = append(columns, typ.KeyFields...)
+	columns = append(columns, typ.ValueFields...)
+	return columns
+}
+
+// objectExporter exports the objects of a single object type.
+type objectExporter struct {
+	typ     schema.ObjectType
+	columns []schema.Field
+	writer  TableWriter
+}
+
+// row flattens the key and value of an object update into a row with one value per column.
+func (o *objectExporter) row(update schema.ObjectUpdate) ([]interface{}, error) {
+	row := make([]interface{}, 0, len(o.columns))
+
+	keys, err := fieldValues(o.typ.KeyFields, update.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key for object type %s: %v", o.typ.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+	row = append(row, keys...)
+
+	var values []interface{}
+	if valueUpdates, ok := update.Value.(schema.ValueUpdates); ok {
+		values, err = valueUpdateValues(o.typ.ValueFields, valueUpdates)
+	} else {
+		values, err = fieldValues(o.typ.ValueFields, update.Value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for object type %s: %v", o.typ.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+	row = append(row, values...)
+
+	for i, field := range o.columns {
+		if err := field.ValidateValue(row[i]); err != nil {
+			return nil, fmt.Errorf("invalid object of type %s: %v", o.typ.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	return row, nil
+}
+
+// fieldValues splits a key or value of an object update into one value per field.
+func fieldValues(fields []schema.Field, value interface{}) ([]interface{}, error) {
+	switch len(fields) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []interface{}{value}, nil
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a slice of %d values, got %T", len(fields), value)
+	}
+
+	if len(values) != len(fields) {
+		return nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+	}
+
+	return values, nil
+}
+
+// valueUpdateValues orders the values of schema.ValueUpdates by field, omitted fields being null.
+func valueUpdateValues(fields []schema.Field, valueUpdates schema.ValueUpdates) ([]interface{}, error) {
+	byName := make(map[string]interface{}, len(fields))
+	err := valueUpdates.Iterate(func(name string, value interface{}) bool {
+		byName[name] = value
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(fields))
+	for i, field := range fields {
+		values[i] = byName[field.Name]
+		delete(byName, field.Name)
+	}
+
+	for name := range byName {
+		return nil, fmt.Errorf("unknown value field %s", name)
+	}
+
+	return values, nil
+}
+
+// textValue encodes a non-nil value of the kind as text. Durations are encoded as a number of nanoseconds
+// and times as RFC 3339 timestamps in UTC so that they are easy to parse by other tools.
+func textValue(kind schema.Kind, value interface{}, addressCodec addressutil.AddressCodec) (string, error) {
+	switch kind {
+	case schema.StringKind, schema.EnumKind, schema.IntegerStringKind, schema.DecimalStringKind:
+		return value.(string), nil
+	case schema.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.([]byte)), nil
+	case schema.AddressKind:
+		return addressCodec.BytesToString(value.([]byte))
+	case schema.Int8Kind:
+		return strconv.FormatInt(int64(value.(int8)), 10), nil
+	case schema.Int16Kind:
+		return strconv.FormatInt(int64(value.(int16)), 10), nil
+	case schema.Int32Kind:
+		return strconv.FormatInt(int64(value.(int32)), 10), nil
+	case schema.Int64Kind:
+		return strconv.FormatInt(value.(int64), 10), nil
+	case schema.Uint8Kind:
+		return strconv.FormatUint(uint64(value.(uint8)), 10), nil
+	case schema.Uint16Kind:
+		return strconv.FormatUint(uint64(value.(uint16)), 10), nil
+	case schema.Uint32Kind:
+		return strconv.FormatUint(uint64(value.(uint32)), 10), nil
+	case schema.Uint64Kind:
+		return strconv.FormatUint(value.(uint64), 10), nil
+	case schema.Float32Kind:
+		return strconv.FormatFloat(float64(value.(float32)), 'g', -1, 32), nil
+	case schema.Float64Kind:
+		return strconv.FormatFloat(value.(float64), 'g', -1, 64), nil
+	case schema.BoolKind:
+		return strconv.FormatBool(value.(bool)), nil
+	case schema.TimeKind:
+		return value.(time.Time).UTC().Format(time.RFC3339Nano), nil
+	case schema.DurationKind:
+		return strconv.FormatInt(int64(value.(time.Duration)), 10), nil
+	case schema.JSONKind:
+		return string(value.(json.RawMessage)), nil
+	default:
+		return "", fmt.Errorf("unsupported kind %s", kind)
+	}
+}
@@ -6,6 +6,12 @@ CREATE OR REPLACE FUNCTION nanos_to_timestamptz(nanos bigint) RETURNS timestampt
     SELECT to_timestamp(nanos / 1000000000) + (nanos / 1000000000) * INTERVAL '1 microsecond'
 $$ LANGUAGE SQL IMMUTABLE;
 
+CREATE TABLE IF NOT EXISTS module_schema
+(
+    module_name TEXT  NOT NULL PRIMARY KEY,
+    schema      JSONB NOT NULL
+);
+
 CREATE TABLE IF NOT EXISTS block
 (
     number BIGINT NOT NULL PRIMARY KEY,
@@ -30,4 +36,15 @@ CREATE TABLE IF NOT EXISTS event
     type         TEXT   NOT NULL,
     data         JSONB  NOT NULL
 );
+
+CREATE TABLE IF NOT EXISTS change_log
+(
+    id           BIGSERIAL PRIMARY KEY,
+    block_number BIGINT  NOT NULL,
+    table_name   TEXT    NOT NULL,
+    data         JSONB   NOT NULL,
+    created      BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS change_log_block_number_idx ON change_log (block_number);
 `
@@ -15,6 +15,18 @@ func (tm *objectIndexer) createTable(ctx context.Context, conn dbConn) error {
 		return err
 	}
 
+	if tm.options.retainHistory {
+		_, err = fmt.Fprintf(buf, "\n")
+		if err != nil {
+			return err
+		}
+
+		err = tm.createHistoryTableSql(buf)
+		if err != nil {
+			return err
+		}
+	}
+
 	sqlStr := buf.String()
 	if tm.options.logger != nil {
 		tm.options.logger.Debug("Creating table %s", "table", tm.tableName(), "sql", sqlStr)
@@ -29,9 +41,7 @@ func (tm *objectIndexer) createTableSql(writer io.Writer) error {
 	if err != nil {
 		return err
 	}
-	isSingleton := false
 	if len(tm.typ.KeyFields) == 0 {
-		isSingleton = true
 		_, err = fmt.Fprintf(writer, "_id INTEGER NOT NULL CHECK (_id = 1),\n\t")
 		if err != nil {
 			return err
@@ -60,18 +70,9 @@ func (tm *objectIndexer) createTableSql(writer io.Writer) error {
 		}
 	}
 
-	var pKeys []string
-	if !isSingleton {
-		for _, field := range tm.typ.KeyFields {
-			name, err := tm.updatableColumnName(field)
-			if err != nil {
-				return err
-			}
-
-			pKeys = append(pKeys, name)
-		}
-	} else {
-		pKeys = []string{"_id"}
+	pKeys, err := tm.primaryKeyColumnNames()
+	if err != nil {
+		return err
 	}
 
 	_, err = fmt.Fprintf(writer, "PRIMARY KEY (%s)", strings.Join(pKeys, ", "))
@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// historyTableName returns the name of the history table for the object type.
+func (tm *objectIndexer) historyTableName() string {
+	return fmt.Sprintf("%s_history", tm.tableName())
+}
+
+// atHeightFunctionName returns the name of the SQL function which returns the state of the
+// object type at a given block height.
+func (tm *objectIndexer) atHeightFunctionName() string {
+	return fmt.Sprintf("%s_at_height", tm.tableName())
+}
+
+// createHistoryTableSql generates a CREATE TABLE statement for the history table of the object type
+// together with a function returning the state of the object type at a given block height.
+//
+// Every row of the history table is a version of an object which was valid from block height _valid_from
+// (inclusive) until block height _valid_to (exclusive). The current version of an object has a NULL _valid_to.
+func (tm *objectIndexer) createHistoryTableSql(writer io.Writer) error {
+	_, err := fmt.Fprintf(writer, "CREATE TABLE IF NOT EXISTS %q (\n\t", tm.historyTableName())
+	if err != nil {
+		return err
+	}
+
+	if len(tm.typ.KeyFields) == 0 {
+		_, err = fmt.Fprintf(writer, "_id INTEGER NOT NULL CHECK (_id = 1),\n\t")
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, field := range tm.typ.KeyFields {
+			err = tm.createColumnDefinition(writer, field)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, field := range tm.typ.ValueFields {
+		err = tm.createColumnDefinition(writer, field)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(writer, "_valid_from BIGINT NOT NULL,\n\t_valid_to BIGINT NULL,\n\t")
+	if err != nil {
+		return err
+	}
+
+	pKeys, err := tm.primaryKeyColumnNames()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "PRIMARY KEY (%s, _valid_from)\n);\n", strings.Join(pKeys, ", "))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "GRANT SELECT ON TABLE %q TO PUBLIC;\n", tm.historyTableName())
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer,
+		"CREATE OR REPLACE FUNCTION %q(height BIGINT) RETURNS SETOF %q AS $$\n\tSELECT * FROM %q WHERE _valid_from <= height AND (_valid_to IS NULL OR _valid_to > height)\n$$ LANGUAGE SQL STABLE;\n",
+		tm.atHeightFunctionName(), tm.historyTableName(), tm.historyTableName(),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "GRANT EXECUTE ON FUNCTION %q(BIGINT) TO PUBLIC;", tm.atHeightFunctionName())
+	return err
+}
+
+// recordHistory closes the current version of the object in the history table at height and
+// copies the current state of the object from the object table as its new version.
+func (tm *objectIndexer) recordHistory(ctx context.Context, conn dbConn, height uint64, key interface{}) error {
+	err := tm.execHistorySql(ctx, conn, height, key, tm.closeHistorySql)
+	if err != nil {
+		return err
+	}
+
+	return tm.execHistorySql(ctx, conn, height, key, tm.insertHistorySql)
+}
+
+// deleteHistory closes the current version of the object in the history table at height, dropping
+// any version which was created at height.
+func (tm *objectIndexer) deleteHistory(ctx context.Context, conn dbConn, height uint64, key interface{}) error {
+	err := tm.execHistorySql(ctx, conn, height, key, tm.closeHistorySql)
+	if err != nil {
+		return err
+	}
+
+	return tm.execHistorySql(ctx, conn, height, key, tm.dropHistorySql)
+}
+
+// execHistorySql executes the history statement generated by gen.
+func (tm *objectIndexer) execHistorySql(
+	ctx context.Context,
+	conn dbConn,
+	height uint64,
+	key interface{},
+	gen func(io.Writer, uint64, interface{}) ([]interface{}, error),
+) error {
+	buf := new(strings.Builder)
+	params, err := gen(buf, height, key)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.logger != nil {
+		tm.options.logger.Debug("Updating history", "table", tm.historyTableName(), "sql", sqlStr, "params", params)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// closeHistorySql generates an UPDATE statement which sets _valid_to to height on the current version
+// of the object if it was created before height.
+func (tm *objectIndexer) closeHistorySql(writer io.Writer, height uint64, key interface{}) ([]interface{}, error) {
+	_, err := fmt.Fprintf(writer, "UPDATE %q SET _valid_to = $%d WHERE ", tm.historyTableName(), tm.heightParamIndex())
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSqlAndParams(writer, key, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, " AND _valid_to IS NULL AND _valid_from < $%d;", tm.heightParamIndex())
+	return append(params, int64(height)), err
+}
+
+// insertHistorySql generates an INSERT statement which copies the current state of the object from
+// the object table into the history table as a version valid from height.
+func (tm *objectIndexer) insertHistorySql(writer io.Writer, height uint64, key interface{}) ([]interface{}, error) {
+	cols, err := tm.columnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, "INSERT INTO %q (%s, _valid_from) SELECT %s, $%d FROM %q WHERE ",
+		tm.historyTableName(), strings.Join(cols, ", "), strings.Join(cols, ", "), tm.heightParamIndex(), tm.tableName())
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSqlAndParams(writer, key, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	pKeys, err := tm.primaryKeyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, " ON CONFLICT (%s, _valid_from) DO UPDATE SET ", strings.Join(pKeys, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range tm.typ.ValueFields {
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = fmt.Fprintf(writer, "%s = EXCLUDED.%s, ", name, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = fmt.Fprintf(writer, "_valid_to = NULL;")
+	return append(params, int64(height)), err
+}
+
+// dropHistorySql generates a DELETE statement which drops the version of the object created at height.
+func (tm *objectIndexer) dropHistorySql(writer io.Writer, height uint64, key interface{}) ([]interface{}, error) {
+	_, err := fmt.Fprintf(writer, "DELETE FROM %q WHERE ", tm.historyTableName())
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSqlAndParams(writer, key, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, " AND _valid_from = $%d;", tm.heightParamIndex())
+	return append(params, int64(height)), err
+}
+
+// heightParamIndex returns the index of the block height parameter in history statements,
+// which follows the key parameters.
+func (tm *objectIndexer) heightParamIndex() int {
+	return len(tm.typ.KeyFields) + 1
+}
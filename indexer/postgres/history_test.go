@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_createHistoryTableSql_singleton() {
+	exampleCreateHistoryTable(testdata.SingletonObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_singleton_history" (
+	// 	_id INTEGER NOT NULL CHECK (_id = 1),
+	// 	"foo" TEXT NOT NULL,
+	// 	"bar" INTEGER NULL,
+	// 	"an_enum" "test_my_enum" NOT NULL,
+	// 	_valid_from BIGINT NOT NULL,
+	// 	_valid_to BIGINT NULL,
+	// 	PRIMARY KEY (_id, _valid_from)
+	// );
+	// GRANT SELECT ON TABLE "test_singleton_history" TO PUBLIC;
+	// CREATE OR REPLACE FUNCTION "test_singleton_at_height"(height BIGINT) RETURNS SETOF "test_singleton_history" AS $$
+	// 	SELECT * FROM "test_singleton_history" WHERE _valid_from <= height AND (_valid_to IS NULL OR _valid_to > height)
+	// $$ LANGUAGE SQL STABLE;
+	// GRANT EXECUTE ON FUNCTION "test_singleton_at_height"(BIGINT) TO PUBLIC;
+}
+
+func Example_objectIndexer_createHistoryTableSql_vote() {
+	exampleCreateHistoryTable(testdata.VoteObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote_history" (
+	// 	"proposal" BIGINT NOT NULL,
+	// 	"address" TEXT NOT NULL,
+	// 	"vote" "test_vote_type" NOT NULL,
+	// 	_valid_from BIGINT NOT NULL,
+	// 	_valid_to BIGINT NULL,
+	// 	PRIMARY KEY ("proposal", "address", _valid_from)
+	// );
+	// GRANT SELECT ON TABLE "test_vote_history" TO PUBLIC;
+	// CREATE OR REPLACE FUNCTION "test_vote_at_height"(height BIGINT) RETURNS SETOF "test_vote_history" AS $$
+	// 	SELECT * FROM "test_vote_history" WHERE _valid_from <= height AND (_valid_to IS NULL OR _valid_to > height)
+	// $$ LANGUAGE SQL STABLE;
+	// GRANT EXECUTE ON FUNCTION "test_vote_at_height"(BIGINT) TO PUBLIC;
+}
+
+func Example_objectIndexer_recordHistorySql_vote() {
+	exampleHistorySql(testdata.VoteObject, func(tm *objectIndexer) []func() ([]interface{}, error) {
+		key := []interface{}{int64(1), []byte{0xde, 0xad}}
+		return []func() ([]interface{}, error){
+			func() ([]interface{}, error) { return tm.closeHistorySql(os.Stdout, 10, key) },
+			func() ([]interface{}, error) { return tm.insertHistorySql(os.Stdout, 10, key) },
+			func() ([]interface{}, error) { return tm.dropHistorySql(os.Stdout, 10, key) },
+		}
+	})
+	// Output:
+	// UPDATE "test_vote_history" SET _valid_to = $3 WHERE "proposal" = $1 AND "address" = $2 AND _valid_to IS NULL AND _valid_from < $3;
+	// [1 0xdead 10]
+	// INSERT INTO "test_vote_history" ("proposal", "address", "vote", _valid_from) SELECT "proposal", "address", "vote", $3 FROM "test_vote" WHERE "proposal" = $1 AND "address" = $2 ON CONFLICT ("proposal", "address", _valid_from) DO UPDATE SET "vote" = EXCLUDED."vote", _valid_to = NULL;
+	// [1 0xdead 10]
+	// DELETE FROM "test_vote_history" WHERE "proposal" = $1 AND "address" = $2 AND _valid_from = $3;
+	// [1 0xdead 10]
+}
+
+func Example_objectIndexer_recordHistorySql_singleton() {
+	exampleHistorySql(testdata.SingletonObject, func(tm *objectIndexer) []func() ([]interface{}, error) {
+		return []func() ([]interface{}, error){
+			func() ([]interface{}, error) { return tm.closeHistorySql(os.Stdout, 10, nil) },
+			func() ([]interface{}, error) { return tm.insertHistorySql(os.Stdout, 10, nil) },
+		}
+	})
+	// Output:
+	// UPDATE "test_singleton_history" SET _valid_to = $1 WHERE _id = 1 AND _valid_to IS NULL AND _valid_from < $1;
+	// [10]
+	// INSERT INTO "test_singleton_history" (_id, "foo", "bar", "an_enum", _valid_from) SELECT _id, "foo", "bar", "an_enum", $1 FROM "test_singleton" WHERE _id = 1 ON CONFLICT (_id, _valid_from) DO UPDATE SET "foo" = EXCLUDED."foo", "bar" = EXCLUDED."bar", "an_enum" = EXCLUDED."an_enum", _valid_to = NULL;
+	// [10]
+}
+
+func exampleCreateHistoryTable(objectType schema.ObjectType) {
+	tm := newObjectIndexer("test", objectType, options{
+		logger:        logutil.NoopLogger{},
+		retainHistory: true,
+	})
+	err := tm.createHistoryTableSql(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func exampleHistorySql(objectType schema.ObjectType, gens func(tm *objectIndexer) []func() ([]interface{}, error)) {
+	tm := newObjectIndexer("test", objectType, options{
+		logger:        logutil.NoopLogger{},
+		retainHistory: true,
+		addressCodec:  addressutil.HexAddressCodec{},
+	})
+	for _, gen := range gens(tm) {
+		params, err := gen()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println()
+		fmt.Println(params)
+	}
+}
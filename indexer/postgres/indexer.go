@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	"cosmossdk.io/schema/addressutil"
 	"cosmossdk.io/schema/indexer"
 	"cosmossdk.io/schema/logutil"
 )
@@ -19,6 +20,14 @@ type Config struct {
 
 	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
 	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+
+	// RetainHistory enables a history table for every object type which keeps each version of an object
+	// together with the block heights from which and until which it was valid.
+	RetainHistory bool `json:"retain_history"`
+
+	// RollbackBlocks is the number of most recent blocks for which the changes made to object tables are kept
+	// in the change log so that they can be rolled back. This defaults to 100.
+	RollbackBlocks uint64 `json:"rollback_blocks"`
 }
 
 type SqlLogger = func(msg, sql string, params ...interface{})
@@ -30,6 +39,12 @@ type indexerImpl struct {
 	opts    options
 	modules map[string]*moduleIndexer
 	logger  logutil.Logger
+
+	// height is the height of the block currently being indexed.
+	height uint64
+
+	// rollbackBlocks is the number of most recent blocks which can be rolled back.
+	rollbackBlocks uint64
 }
 
 func StartIndexer(params indexer.InitParams) (indexer.InitResult, error) {
@@ -68,23 +83,38 @@ func StartIndexer(params indexer.InitParams) (indexer.InitResult, error) {
 		return indexer.InitResult{}, err
 	}
 
+	addressCodec := params.AddressCodec
+	if addressCodec == nil {
+		addressCodec = addressutil.HexAddressCodec{}
+	}
+
 	moduleIndexers := map[string]*moduleIndexer{}
 	opts := options{
 		disableRetainDeletions: config.DisableRetainDeletions,
+		retainHistory:          config.RetainHistory,
+		addressCodec:           addressCodec,
 		logger:                 params.Logger,
 	}
 
+	rollbackBlocks := config.RollbackBlocks
+	if rollbackBlocks == 0 {
+		rollbackBlocks = defaultRollbackBlocks
+	}
+
 	idx := &indexerImpl{
-		ctx:     ctx,
-		db:      db,
-		tx:      tx,
-		opts:    opts,
-		modules: moduleIndexers,
-		logger:  params.Logger,
+		ctx:            ctx,
+		db:             db,
+		tx:             tx,
+		opts:           opts,
+		modules:        moduleIndexers,
+		logger:         params.Logger,
+		rollbackBlocks: rollbackBlocks,
 	}
 
 	return indexer.InitResult{
 		Listener: idx.listener(),
+		View:     idx,
+		Rollback: idx.rollback,
 	}, nil
 }
 
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// insertUpdate inserts or updates the object with the given key and value and, when history is
+// retained, records its new version as valid from height.
+func (tm *objectIndexer) insertUpdate(ctx context.Context, conn dbConn, height uint64, key, value interface{}) error {
+	buf := new(strings.Builder)
+	params, err := tm.insertUpdateSql(buf, key, value)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if sqlStr == "" {
+		// nothing to update
+		return nil
+	}
+
+	if tm.options.logger != nil {
+		tm.options.logger.Debug("Insert or update", "table", tm.tableName(), "sql", sqlStr, "params", params)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	if err != nil {
+		return err
+	}
+
+	if !tm.options.retainHistory {
+		return nil
+	}
+
+	return tm.recordHistory(ctx, conn, height, key)
+}
+
+// insertUpdateSql generates an INSERT ... ON CONFLICT DO UPDATE statement for the object and returns its parameters.
+// When value is a schema.ValueUpdates, an UPDATE statement of the fields it contains is generated instead.
+func (tm *objectIndexer) insertUpdateSql(writer io.Writer, key, value interface{}) ([]interface{}, error) {
+	if _, ok := value.(schema.ValueUpdates); ok {
+		// an INSERT would fail on the NOT NULL columns omitted from partial updates, which only
+		// apply to existing objects
+		return tm.updateSql(writer, key, value)
+	}
+
+	pKeys, err := tm.primaryKeyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	keyCols, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	valueCols, valueParams, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cols, placeholders []string
+	if len(tm.typ.KeyFields) == 0 {
+		cols = append(cols, "_id")
+		placeholders = append(placeholders, "1")
+	}
+	cols = append(cols, keyCols...)
+	cols = append(cols, valueCols...)
+	params := append(keyParams, valueParams...)
+	for i := range params {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+	}
+
+	_, err = fmt.Fprintf(writer, "INSERT INTO %q (%s) VALUES (%s) ON CONFLICT (%s) ",
+		tm.tableName(), strings.Join(cols, ", "), strings.Join(placeholders, ", "), strings.Join(pKeys, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []string
+	for _, col := range valueCols {
+		sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", col, col))
+	}
+	if !tm.options.disableRetainDeletions && tm.typ.RetainDeletions {
+		sets = append(sets, "_deleted = FALSE")
+	}
+
+	if len(sets) == 0 {
+		_, err = fmt.Fprintf(writer, "DO NOTHING;")
+	} else {
+		_, err = fmt.Fprintf(writer, "DO UPDATE SET %s;", strings.Join(sets, ", "))
+	}
+	return params, err
+}
+
+// updateSql generates an UPDATE statement of the fields present in value for an existing object and returns
+// its parameters. Nothing is written if there is nothing to update.
+func (tm *objectIndexer) updateSql(writer io.Writer, key, value interface{}) ([]interface{}, error) {
+	valueCols, params, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []string
+	for i, col := range valueCols {
+		sets = append(sets, fmt.Sprintf("%s = $%d", col, i+1))
+	}
+	if !tm.options.disableRetainDeletions && tm.typ.RetainDeletions {
+		sets = append(sets, "_deleted = FALSE")
+	}
+	if len(sets) == 0 {
+		return nil, nil
+	}
+
+	_, err = fmt.Fprintf(writer, "UPDATE %q SET %s WHERE ", tm.tableName(), strings.Join(sets, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	keyParams, err := tm.whereSqlAndParams(writer, key, len(params)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, ";")
+	return append(params, keyParams...), err
+}
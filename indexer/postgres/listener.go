@@ -3,6 +3,7 @@ package postgres
 import (
 	"fmt"
 
+	"cosmossdk.io/schema"
 	"cosmossdk.io/schema/appdata"
 )
 
@@ -19,13 +20,60 @@ func (i *indexerImpl) listener() appdata.Listener {
 			mm := newModuleIndexer(moduleName, modSchema, i.opts)
 			i.modules[moduleName] = mm
 
-			return mm.initializeSchema(i.ctx, i.tx)
+			return mm.initializeSchema(i.ctx, i.tx, i.db)
 		},
 		StartBlock: func(data appdata.StartBlockData) error {
+			i.height = data.Height
 			_, err := i.tx.Exec("INSERT INTO block (number) VALUES ($1)", data.Height)
 			return err
 		},
+		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+			mod, ok := i.modules[data.ModuleName]
+			if !ok {
+				return fmt.Errorf("module %s not initialized", data.ModuleName)
+			}
+
+			for _, update := range data.Updates {
+				tm, ok := mod.tables[update.TypeName]
+				if !ok {
+					return fmt.Errorf("object type %s not found in schema for module %s", update.TypeName, data.ModuleName)
+				}
+
+				// record the prior state of the object so that the update can be rolled back
+				logged, err := tm.logChange(i.ctx, i.tx, i.height, update.Key, false)
+				if err != nil {
+					return err
+				}
+
+				if update.Delete {
+					err = tm.delete(i.ctx, i.tx, i.height, update.Key)
+				} else {
+					err = tm.insertUpdate(i.ctx, i.tx, i.height, update.Key, update.Value)
+				}
+				if err != nil {
+					return err
+				}
+
+				if _, partial := update.Value.(schema.ValueUpdates); !logged && !update.Delete && !partial {
+					// the object did not exist before, record its creation so that a rollback deletes it
+					_, err = tm.logChange(i.ctx, i.tx, i.height, update.Key, true)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
 		Commit: func(data appdata.CommitData) (func() error, error) {
+			if i.height > i.rollbackBlocks {
+				// prune the change logs of the blocks which can no longer be rolled back
+				_, err := i.tx.ExecContext(i.ctx, "DELETE FROM change_log WHERE block_number <= $1", int64(i.height-i.rollbackBlocks))
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			err := i.tx.Commit()
 			if err != nil {
 				return nil, err
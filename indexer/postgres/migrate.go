@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/diff"
+)
+
+// loadSchema loads the module schema stored when the module was previously initialized.
+func (m *moduleIndexer) loadSchema(ctx context.Context, conn dbConn) (schema.ModuleSchema, bool, error) {
+	var bz []byte
+	row := conn.QueryRowContext(ctx, "SELECT schema FROM module_schema WHERE module_name = $1", m.moduleName)
+	if err := row.Scan(&bz); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return schema.ModuleSchema{}, false, nil
+		}
+		return schema.ModuleSchema{}, false, fmt.Errorf("failed to load schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	var objectTypes []schema.ObjectType
+	err := json.Unmarshal(bz, &objectTypes)
+	if err != nil {
+		return schema.ModuleSchema{}, false, fmt.Errorf("failed to decode schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	modSchema, err := schema.NewModuleSchema(objectTypes)
+	if err != nil {
+		return schema.ModuleSchema{}, false, fmt.Errorf("invalid stored schema of module %s: %v", m.moduleName, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	return modSchema, true, nil
+}
+
+// storeSchema stores the module schema so that it can be compared with the schema the module is initialized
+// with the next time.
+func (m *moduleIndexer) storeSchema(ctx context.Context, conn dbConn) error {
+	var objectTypes []schema.ObjectType
+	m.schema.ObjectTypes(func(typ schema.ObjectType) bool {
+		objectTypes = append(objectTypes, typ)
+		return true
+	})
+
+	bz, err := json.Marshal(objectTypes)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx,
+		"INSERT INTO module_schema (module_name, schema) VALUES ($1, $2) ON CONFLICT (module_name) DO UPDATE SET schema = EXCLUDED.schema",
+		m.moduleName, string(bz),
+	)
+	return err
+}
+
+// addEnumValues adds the values added to existing enum types by the schema diff.
+func (m *moduleIndexer) addEnumValues(ctx context.Context, conn dbConn, schemaDiff diff.ModuleSchemaDiff) error {
+	for _, enumDiff := range schemaDiff.ChangedEnumTypes {
+		if len(enumDiff.AddedValues) == 0 {
+			continue
+		}
+
+		buf := new(strings.Builder)
+		err := addEnumValuesSql(buf, m.moduleName, enumDiff)
+		if err != nil {
+			return err
+		}
+
+		sqlStr := buf.String()
+		if m.options.logger != nil {
+			m.options.logger.Debug("Adding enum values", "sql", sqlStr)
+		}
+		_, err = conn.ExecContext(ctx, sqlStr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addEnumValuesSql generates ALTER TYPE statements adding the values added to an enum type.
+func addEnumValuesSql(writer io.Writer, moduleName string, enumDiff diff.EnumTypeDiff) error {
+	typeName := enumTypeName(moduleName, schema.EnumType{Name: enumDiff.Name})
+	for i, value := range enumDiff.AddedValues {
+		if i > 0 {
+			_, err := fmt.Fprintf(writer, "\n")
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := fmt.Fprintf(writer, "ALTER TYPE %q ADD VALUE IF NOT EXISTS '%s';", typeName, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addColumns adds the columns of the value fields added to existing object types by the schema diff.
+func (m *moduleIndexer) addColumns(ctx context.Context, conn dbConn, schemaDiff diff.ModuleSchemaDiff) error {
+	for _, objDiff := range schemaDiff.ChangedObjectTypes {
+		if len(objDiff.ValueFieldsDiff.Added) == 0 {
+			continue
+		}
+
+		tm, ok := m.tables[objDiff.Name]
+		if !ok {
+			return fmt.Errorf("object type %s not found in schema for module %s", objDiff.Name, m.moduleName)
+		}
+
+		buf := new(strings.Builder)
+		err := tm.addColumnsSql(buf, objDiff.ValueFieldsDiff.Added)
+		if err != nil {
+			return err
+		}
+
+		sqlStr := buf.String()
+		if m.options.logger != nil {
+			m.options.logger.Debug("Adding columns", "table", tm.tableName(), "sql", sqlStr)
+		}
+		_, err = conn.ExecContext(ctx, sqlStr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addColumnsSql generates ALTER TABLE statements adding the columns of fields to the table of the object type,
+// and to its history table if history is retained.
+func (tm *objectIndexer) addColumnsSql(writer io.Writer, fields []schema.Field) error {
+	var columns []string
+	for _, field := range fields {
+		buf := new(strings.Builder)
+		err := tm.createColumnDefinition(buf, field)
+		if err != nil {
+			return err
+		}
+
+		// generated columns must be added after the columns they are computed from
+		defs := strings.Split(strings.TrimSuffix(buf.String(), ",\n\t"), ",\n\t")
+		for i := len(defs) - 1; i >= 0; i-- {
+			columns = append(columns, fmt.Sprintf("ADD COLUMN IF NOT EXISTS %s", defs[i]))
+		}
+	}
+
+	_, err := fmt.Fprintf(writer, "ALTER TABLE %q %s;", tm.tableName(), strings.Join(columns, ", "))
+	if err != nil {
+		return err
+	}
+
+	if !tm.options.retainHistory {
+		return nil
+	}
+
+	_, err = fmt.Fprintf(writer, "\nALTER TABLE %q %s;", tm.historyTableName(), strings.Join(columns, ", "))
+	return err
+}
+
+// incompatibleChangesError returns an error reporting all the changes of the schema diff which cannot
+// be migrated automatically.
+func incompatibleChangesError(moduleName string, schemaDiff diff.ModuleSchemaDiff) error {
+	var problems []string
+	for _, typ := range schemaDiff.RemovedObjectTypes {
+		problems = append(problems, fmt.Sprintf("object type %s was removed", typ.Name))
+	}
+
+	for _, objDiff := range schemaDiff.ChangedObjectTypes {
+		if !objDiff.KeyFieldsDiff.Empty() {
+			problems = append(problems, fmt.Sprintf("key fields of object type %s were changed", objDiff.Name))
+		}
+
+		valueDiff := objDiff.ValueFieldsDiff
+		for _, field := range valueDiff.Added {
+			if !field.Nullable {
+				problems = append(problems, fmt.Sprintf("non-nullable value field %s was added to object type %s", field.Name, objDiff.Name))
+			}
+		}
+		for _, field := range valueDiff.Removed {
+			problems = append(problems, fmt.Sprintf("value field %s was removed from object type %s", field.Name, objDiff.Name))
+		}
+		for _, field := range valueDiff.Changed {
+			problems = append(problems, fmt.Sprintf("value field %s of object type %s was changed", field.Name, objDiff.Name))
+		}
+		if valueDiff.OrderChanged() {
+			problems = append(problems, fmt.Sprintf("value fields of object type %s were reordered", objDiff.Name))
+		}
+	}
+
+	for _, typ := range schemaDiff.RemovedEnumTypes {
+		problems = append(problems, fmt.Sprintf("enum type %s was removed", typ.Name))
+	}
+
+	for _, enumDiff := range schemaDiff.ChangedEnumTypes {
+		for _, value := range enumDiff.RemovedValues {
+			problems = append(problems, fmt.Sprintf("value %s was removed from enum type %s", value, enumDiff.Name))
+		}
+	}
+
+	return fmt.Errorf("incompatible schema changes for module %s, a re-index is required:\n  - %s",
+		moduleName, strings.Join(problems, "\n  - "))
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/diff"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_addEnumValuesSql() {
+	err := addEnumValuesSql(os.Stdout, "test", diff.EnumTypeDiff{
+		Name:        "my_enum",
+		AddedValues: []string{"d", "e"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// ALTER TYPE "test_my_enum" ADD VALUE IF NOT EXISTS 'd';
+	// ALTER TYPE "test_my_enum" ADD VALUE IF NOT EXISTS 'e';
+}
+
+func Example_objectIndexer_addColumnsSql() {
+	exampleAddColumns(false)
+	// Output:
+	// ALTER TABLE "test_singleton" ADD COLUMN IF NOT EXISTS "baz" TEXT NULL, ADD COLUMN IF NOT EXISTS "ts_nanos" BIGINT NULL, ADD COLUMN IF NOT EXISTS "ts" TIMESTAMPTZ GENERATED ALWAYS AS (nanos_to_timestamptz("ts_nanos")) STORED;
+}
+
+func Example_objectIndexer_addColumnsSql_retainHistory() {
+	exampleAddColumns(true)
+	// Output:
+	// ALTER TABLE "test_singleton" ADD COLUMN IF NOT EXISTS "baz" TEXT NULL, ADD COLUMN IF NOT EXISTS "ts_nanos" BIGINT NULL, ADD COLUMN IF NOT EXISTS "ts" TIMESTAMPTZ GENERATED ALWAYS AS (nanos_to_timestamptz("ts_nanos")) STORED;
+	// ALTER TABLE "test_singleton_history" ADD COLUMN IF NOT EXISTS "baz" TEXT NULL, ADD COLUMN IF NOT EXISTS "ts_nanos" BIGINT NULL, ADD COLUMN IF NOT EXISTS "ts" TIMESTAMPTZ GENERATED ALWAYS AS (nanos_to_timestamptz("ts_nanos")) STORED;
+}
+
+func Example_incompatibleChangesError() {
+	oldSchema := testdata.ExampleSchema
+	newSchema, err := schema.NewModuleSchema([]schema.ObjectType{
+		{
+			Name:      "singleton",
+			KeyFields: testdata.SingletonObject.KeyFields,
+			ValueFields: []schema.Field{
+				{Name: "foo", Kind: schema.Int32Kind},
+				{Name: "an_enum", Kind: schema.EnumKind, EnumType: schema.EnumType{Name: "my_enum", Values: []string{"a", "b"}}},
+				{Name: "baz", Kind: schema.StringKind},
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(incompatibleChangesError("test", diff.CompareModuleSchemas(oldSchema, newSchema)))
+	// Output:
+	// incompatible schema changes for module test, a re-index is required:
+	//   - object type all_kinds was removed
+	//   - object type vote was removed
+	//   - non-nullable value field baz was added to object type singleton
+	//   - value field bar was removed from object type singleton
+	//   - value field foo of object type singleton was changed
+	//   - value fields of object type singleton were reordered
+	//   - enum type vote_type was removed
+	//   - value c was removed from enum type my_enum
+}
+
+func exampleAddColumns(retainHistory bool) {
+	tm := newObjectIndexer("test", testdata.SingletonObject, options{
+		logger:        logutil.NoopLogger{},
+		retainHistory: retainHistory,
+	})
+	err := tm.addColumnsSql(os.Stdout, []schema.Field{
+		{Name: "baz", Kind: schema.StringKind, Nullable: true},
+		{Name: "ts", Kind: schema.TimeKind, Nullable: true},
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/diff"
 )
 
 // moduleIndexer manages the tables for a module.
@@ -28,9 +29,33 @@ func newModuleIndexer(moduleName string, modSchema schema.ModuleSchema, options
 }
 
 // initializeSchema creates tables for all object types in the module schema and creates enum types.
-func (m *moduleIndexer) initializeSchema(ctx context.Context, conn dbConn) error {
+// If a schema was stored for the module when it was previously initialized, compatible changes from
+// that schema are migrated and incompatible changes are refused. Enum values are added with db
+// rather than conn because they cannot be used in the transaction which added them.
+func (m *moduleIndexer) initializeSchema(ctx context.Context, conn, db dbConn) error {
+	oldSchema, found, err := m.loadSchema(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	var schemaDiff diff.ModuleSchemaDiff
+	if found {
+		schemaDiff = diff.CompareModuleSchemas(oldSchema, m.schema)
+		if !schemaDiff.HasCompatibleChanges() {
+			return incompatibleChangesError(m.moduleName, schemaDiff)
+		}
+
+		if !schemaDiff.Empty() && m.options.logger != nil {
+			m.options.logger.Info("Migrating module schema", "module", m.moduleName)
+		}
+
+		err = m.addEnumValues(ctx, db, schemaDiff)
+		if err != nil {
+			return err
+		}
+	}
+
 	// create enum types
-	var err error
 	m.schema.EnumTypes(func(enumType schema.EnumType) bool {
 		err = m.createEnumType(ctx, conn, enumType)
 		return err == nil
@@ -49,6 +74,16 @@ func (m *moduleIndexer) initializeSchema(ctx context.Context, conn dbConn) error
 		}
 		return err == nil
 	})
+	if err != nil {
+		return err
+	}
+
+	if found {
+		err = m.addColumns(ctx, conn, schemaDiff)
+		if err != nil {
+			return err
+		}
+	}
 
-	return err
+	return m.storeSchema(ctx, conn)
 }
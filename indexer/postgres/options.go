@@ -1,12 +1,22 @@
 package postgres
 
-import "cosmossdk.io/schema/logutil"
+import (
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
 
 // options are the options for module and object indexers.
 type options struct {
 	// disableRetainDeletions disables retain deletions functionality even on object types that have it set.
 	disableRetainDeletions bool
 
+	// retainHistory enables history tables which keep every version of each object together with the
+	// range of block heights in which it was valid.
+	retainHistory bool
+
+	// addressCodec is the codec used to encode address fields as text.
+	addressCodec addressutil.AddressCodec
+
 	// logger is the logger for the indexer to use. It may be nil.
 	logger logutil.Logger
 }
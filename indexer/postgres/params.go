@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// bindKeyParams binds the key of an object update to the names of its columns and its SQL parameters.
+func (tm *objectIndexer) bindKeyParams(key interface{}) ([]string, []interface{}, error) {
+	n := len(tm.typ.KeyFields)
+	if n == 0 {
+		// singleton, the key is ignored
+		return nil, nil, nil
+	} else if n == 1 {
+		return tm.bindParams(tm.typ.KeyFields, []interface{}{key})
+	}
+
+	keys, ok := key.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("expected key to be a slice for object type %s", tm.typ.Name)
+	}
+
+	return tm.bindParams(tm.typ.KeyFields, keys)
+}
+
+// bindValueParams binds the value of an object update to the names of its columns and its SQL parameters.
+// When the value is a schema.ValueUpdates, only the updated fields are bound.
+func (tm *objectIndexer) bindValueParams(value interface{}) ([]string, []interface{}, error) {
+	if valueUpdates, ok := value.(schema.ValueUpdates); ok {
+		var fields []schema.Field
+		var values []interface{}
+		var fieldErr error
+		err := valueUpdates.Iterate(func(name string, value interface{}) bool {
+			field, ok := tm.valueFields[name]
+			if !ok {
+				fieldErr = fmt.Errorf("unknown value field %s for object type %s", name, tm.typ.Name)
+				return false
+			}
+
+			fields = append(fields, field)
+			values = append(values, value)
+			return true
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if fieldErr != nil {
+			return nil, nil, fieldErr
+		}
+
+		return tm.bindParams(fields, values)
+	}
+
+	n := len(tm.typ.ValueFields)
+	if n == 0 {
+		return nil, nil, nil
+	} else if n == 1 {
+		return tm.bindParams(tm.typ.ValueFields, []interface{}{value})
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("expected value to be a slice for object type %s", tm.typ.Name)
+	}
+
+	return tm.bindParams(tm.typ.ValueFields, values)
+}
+
+// bindParams binds the values of fields to the names of their columns and their SQL parameters.
+func (tm *objectIndexer) bindParams(fields []schema.Field, values []interface{}) ([]string, []interface{}, error) {
+	if len(fields) != len(values) {
+		return nil, nil, fmt.Errorf("expected %d values for object type %s, got %d", len(fields), tm.typ.Name, len(values))
+	}
+
+	names := make([]string, 0, len(fields))
+	params := make([]interface{}, 0, len(fields))
+	for i, field := range fields {
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		param, err := tm.bindParam(field, values[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		names = append(names, name)
+		params = append(params, param)
+	}
+
+	return names, params, nil
+}
+
+// bindParam converts the value of a field to a SQL parameter compatible with its column type.
+func (tm *objectIndexer) bindParam(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		if !field.Nullable {
+			return nil, fmt.Errorf("expected non-null value for field %s", field.Name)
+		}
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.TimeKind:
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Time value for field %s, got %T", field.Name, value)
+		}
+		return t.UnixNano(), nil
+	case schema.DurationKind:
+		d, ok := value.(time.Duration)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Duration value for field %s, got %T", field.Name, value)
+		}
+		return int64(d), nil
+	case schema.Uint64Kind:
+		// database/sql does not accept uint64 values with the high bit set, the NUMERIC column parses the text
+		u, ok := value.(uint64)
+		if !ok {
+			return nil, fmt.Errorf("expected uint64 value for field %s, got %T", field.Name, value)
+		}
+		return strconv.FormatUint(u, 10), nil
+	case schema.AddressKind:
+		bz, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected []byte value for field %s, got %T", field.Name, value)
+		}
+		if tm.options.addressCodec == nil {
+			return nil, fmt.Errorf("missing address codec to encode field %s", field.Name)
+		}
+		return tm.options.addressCodec.BytesToString(bz)
+	case schema.JSONKind:
+		bz, ok := value.(json.RawMessage)
+		if !ok {
+			return nil, fmt.Errorf("expected json.RawMessage value for field %s, got %T", field.Name, value)
+		}
+		return string(bz), nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// defaultRollbackBlocks is the default number of most recent blocks which can be rolled back.
+const defaultRollbackBlocks = 100
+
+// logChange records in the change log the current state of the object with the given key, if it exists,
+// before it is changed at height. When created is true, the object is instead recorded as created at height,
+// which must be done after it was inserted. It returns whether a row was recorded.
+func (tm *objectIndexer) logChange(ctx context.Context, conn dbConn, height uint64, key interface{}, created bool) (bool, error) {
+	buf := new(strings.Builder)
+	params, err := tm.changeLogSql(buf, height, key, created)
+	if err != nil {
+		return false, err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.logger != nil {
+		tm.options.logger.Debug("Logging change", "table", tm.tableName(), "sql", sqlStr, "params", params)
+	}
+	res, err := conn.ExecContext(ctx, sqlStr, params...)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	return n != 0, err
+}
+
+// changeLogSql generates an INSERT statement which copies the current row of the object into the change log
+// as a change of height.
+func (tm *objectIndexer) changeLogSql(writer io.Writer, height uint64, key interface{}, created bool) ([]interface{}, error) {
+	_, err := fmt.Fprintf(writer,
+		"INSERT INTO change_log (block_number, table_name, data, created) SELECT $%d, '%s', to_jsonb(_change_row), %t FROM %q _change_row WHERE ",
+		tm.heightParamIndex(), tm.tableName(), created, tm.tableName())
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSqlAndParams(writer, key, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, ";")
+	return append(params, int64(height)), err
+}
+
+// rollbackSql generates the statements which revert the changes made to the table of the object type, and
+// to its history table when history is retained, after the block height which is their only parameter.
+// Objects changed after that height are deleted and the ones which existed before are restored from their
+// oldest recorded state.
+func (tm *objectIndexer) rollbackSql() ([]string, error) {
+	pKeys, err := tm.primaryKeyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := tm.columnNames()
+	if err != nil {
+		return nil, err
+	}
+	if !tm.options.disableRetainDeletions && tm.typ.RetainDeletions {
+		cols = append(cols, "_deleted")
+	}
+
+	changedRows := fmt.Sprintf(
+		"FROM change_log, jsonb_populate_record(NULL::%q, change_log.data) _change_row WHERE change_log.table_name = '%s' AND change_log.block_number > $1",
+		tm.tableName(), tm.tableName())
+
+	stmts := []string{
+		fmt.Sprintf("DELETE FROM %q WHERE (%s) IN (SELECT %s %s);",
+			tm.tableName(), strings.Join(pKeys, ", "), qualifiedColumns(pKeys), changedRows),
+		fmt.Sprintf("INSERT INTO %q (%s) SELECT %s FROM (SELECT DISTINCT ON (%s) _change_row.*, change_log.created AS _created %s ORDER BY %s, change_log.id) _prior WHERE NOT _prior._created;",
+			tm.tableName(), strings.Join(cols, ", "), strings.Join(cols, ", "),
+			qualifiedColumns(pKeys), changedRows, qualifiedColumns(pKeys)),
+	}
+
+	if tm.options.retainHistory {
+		stmts = append(stmts,
+			fmt.Sprintf("DELETE FROM %q WHERE _valid_from > $1;", tm.historyTableName()),
+			fmt.Sprintf("UPDATE %q SET _valid_to = NULL WHERE _valid_to > $1;", tm.historyTableName()),
+		)
+	}
+
+	return stmts, nil
+}
+
+// qualifiedColumns returns the columns qualified with the alias of the rows decoded from the change log.
+func qualifiedColumns(cols []string) string {
+	qualified := make([]string, len(cols))
+	for i, col := range cols {
+		qualified[i] = fmt.Sprintf("_change_row.%s", col)
+	}
+	return strings.Join(qualified, ", ")
+}
+
+// rollback reverts all the data indexed for the blocks after height using the change log and commits it.
+func (i *indexerImpl) rollback(height uint64) error {
+	var firstBlock, lastBlock sql.NullInt64
+	err := i.tx.QueryRowContext(i.ctx, "SELECT MIN(number), MAX(number) FROM block").Scan(&firstBlock, &lastBlock)
+	if err != nil {
+		return err
+	}
+
+	if !lastBlock.Valid || uint64(lastBlock.Int64) <= height {
+		// nothing to roll back
+		return nil
+	}
+
+	if uint64(firstBlock.Int64) > height {
+		return fmt.Errorf("cannot roll back to block %d which is before the first indexed block %d", height, firstBlock.Int64)
+	}
+
+	if uint64(lastBlock.Int64)-height > i.rollbackBlocks {
+		return fmt.Errorf("cannot roll back from block %d to block %d, change logs are only kept for the last %d blocks",
+			lastBlock.Int64, height, i.rollbackBlocks)
+	}
+
+	if i.logger != nil {
+		i.logger.Info("Rolling back indexed data", "from", lastBlock.Int64, "to", height)
+	}
+
+	tables, err := i.loadTables()
+	if err != nil {
+		return err
+	}
+
+	tableNames, err := i.changedTables(height)
+	if err != nil {
+		return err
+	}
+
+	for _, tableName := range tableNames {
+		tm, ok := tables[tableName]
+		if !ok {
+			return fmt.Errorf("no stored schema for table %s found in the change log", tableName)
+		}
+
+		stmts, err := tm.rollbackSql()
+		if err != nil {
+			return err
+		}
+
+		for _, stmt := range stmts {
+			if i.logger != nil {
+				i.logger.Debug("Rolling back", "table", tableName, "sql", stmt, "height", height)
+			}
+			_, err = i.tx.ExecContext(i.ctx, stmt, int64(height))
+			if err != nil {
+				return fmt.Errorf("failed to roll back table %s: %v", tableName, err) //nolint:errorlint // using %v for go 1.12 compat
+			}
+		}
+	}
+
+	for _, stmt := range []string{
+		"DELETE FROM event WHERE block_number > $1",
+		"DELETE FROM tx WHERE block_number > $1",
+		"DELETE FROM block WHERE number > $1",
+		"DELETE FROM change_log WHERE block_number > $1",
+	} {
+		_, err = i.tx.ExecContext(i.ctx, stmt, int64(height))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = i.tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	i.tx, err = i.db.BeginTx(i.ctx, nil)
+	return err
+}
+
+// changedTables returns the names of the tables changed after height according to the change log.
+func (i *indexerImpl) changedTables(height uint64) ([]string, error) {
+	rows, err := i.tx.QueryContext(i.ctx, "SELECT DISTINCT table_name FROM change_log WHERE block_number > $1", int64(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tableNames []string
+	for rows.Next() {
+		var tableName string
+		err = rows.Scan(&tableName)
+		if err != nil {
+			return nil, err
+		}
+		tableNames = append(tableNames, tableName)
+	}
+
+	return tableNames, rows.Err()
+}
+
+// loadTables returns the object indexers of all the object types of the stored module schemas by table name.
+func (i *indexerImpl) loadTables() (map[string]*objectIndexer, error) {
+	rows, err := i.tx.QueryContext(i.ctx, "SELECT module_name FROM module_schema")
+	if err != nil {
+		return nil, err
+	}
+
+	var moduleNames []string
+	for rows.Next() {
+		var moduleName string
+		err = rows.Scan(&moduleName)
+		if err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		moduleNames = append(moduleNames, moduleName)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tables := map[string]*objectIndexer{}
+	for _, moduleName := range moduleNames {
+		modSchema, _, err := newModuleIndexer(moduleName, schema.ModuleSchema{}, i.opts).loadSchema(i.ctx, i.tx)
+		if err != nil {
+			return nil, err
+		}
+
+		modSchema.ObjectTypes(func(typ schema.ObjectType) bool {
+			tm := newObjectIndexer(moduleName, typ, i.opts)
+			tables[tm.tableName()] = tm
+			return true
+		})
+	}
+
+	return tables, nil
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_changeLogSql_vote() {
+	exampleHistorySql(testdata.VoteObject, func(tm *objectIndexer) []func() ([]interface{}, error) {
+		key := []interface{}{int64(1), []byte{0xde, 0xad}}
+		return []func() ([]interface{}, error){
+			func() ([]interface{}, error) { return tm.changeLogSql(os.Stdout, 10, key, false) },
+			func() ([]interface{}, error) { return tm.changeLogSql(os.Stdout, 10, key, true) },
+		}
+	})
+	// Output:
+	// INSERT INTO change_log (block_number, table_name, data, created) SELECT $3, 'test_vote', to_jsonb(_change_row), false FROM "test_vote" _change_row WHERE "proposal" = $1 AND "address" = $2;
+	// [1 0xdead 10]
+	// INSERT INTO change_log (block_number, table_name, data, created) SELECT $3, 'test_vote', to_jsonb(_change_row), true FROM "test_vote" _change_row WHERE "proposal" = $1 AND "address" = $2;
+	// [1 0xdead 10]
+}
+
+func Example_objectIndexer_changeLogSql_singleton() {
+	exampleHistorySql(testdata.SingletonObject, func(tm *objectIndexer) []func() ([]interface{}, error) {
+		return []func() ([]interface{}, error){
+			func() ([]interface{}, error) { return tm.changeLogSql(os.Stdout, 10, nil, false) },
+		}
+	})
+	// Output:
+	// INSERT INTO change_log (block_number, table_name, data, created) SELECT $1, 'test_singleton', to_jsonb(_change_row), false FROM "test_singleton" _change_row WHERE _id = 1;
+	// [10]
+}
+
+func Example_objectIndexer_rollbackSql_vote() {
+	exampleRollbackSql(testdata.VoteObject, options{retainHistory: true})
+	// Output:
+	// DELETE FROM "test_vote" WHERE ("proposal", "address") IN (SELECT _change_row."proposal", _change_row."address" FROM change_log, jsonb_populate_record(NULL::"test_vote", change_log.data) _change_row WHERE change_log.table_name = 'test_vote' AND change_log.block_number > $1);
+	// INSERT INTO "test_vote" ("proposal", "address", "vote", _deleted) SELECT "proposal", "address", "vote", _deleted FROM (SELECT DISTINCT ON (_change_row."proposal", _change_row."address") _change_row.*, change_log.created AS _created FROM change_log, jsonb_populate_record(NULL::"test_vote", change_log.data) _change_row WHERE change_log.table_name = 'test_vote' AND change_log.block_number > $1 ORDER BY _change_row."proposal", _change_row."address", change_log.id) _prior WHERE NOT _prior._created;
+	// DELETE FROM "test_vote_history" WHERE _valid_from > $1;
+	// UPDATE "test_vote_history" SET _valid_to = NULL WHERE _valid_to > $1;
+}
+
+func Example_objectIndexer_rollbackSql_singleton() {
+	exampleRollbackSql(testdata.SingletonObject, options{})
+	// Output:
+	// DELETE FROM "test_singleton" WHERE (_id) IN (SELECT _change_row._id FROM change_log, jsonb_populate_record(NULL::"test_singleton", change_log.data) _change_row WHERE change_log.table_name = 'test_singleton' AND change_log.block_number > $1);
+	// INSERT INTO "test_singleton" (_id, "foo", "bar", "an_enum") SELECT _id, "foo", "bar", "an_enum" FROM (SELECT DISTINCT ON (_change_row._id) _change_row.*, change_log.created AS _created FROM change_log, jsonb_populate_record(NULL::"test_singleton", change_log.data) _change_row WHERE change_log.table_name = 'test_singleton' AND change_log.block_number > $1 ORDER BY _change_row._id, change_log.id) _prior WHERE NOT _prior._created;
+}
+
+func exampleRollbackSql(objectType schema.ObjectType, opts options) {
+	opts.logger = logutil.NoopLogger{}
+	opts.addressCodec = addressutil.HexAddressCodec{}
+	tm := newObjectIndexer("test", objectType, opts)
+	stmts, err := tm.rollbackSql()
+	if err != nil {
+		panic(err)
+	}
+	for _, stmt := range stmts {
+		fmt.Println(stmt)
+	}
+}
@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/logutil"
+)
+
+func TestMigrateSchema(t *testing.T) {
+	connectionUrl := createTestDB(t)
+	require.NoError(t, initModule(t, connectionUrl, testdata.ExampleSchema))
+
+	// initializing the module again with the same schema is a no-op
+	require.NoError(t, initModule(t, connectionUrl, testdata.ExampleSchema))
+
+	// compatible changes are migrated
+	voteType := schema.EnumType{Name: "vote_type", Values: []string{"yes", "no", "abstain", "veto"}}
+	singleton := testdata.SingletonObject
+	singleton.ValueFields = append(singleton.ValueFields[:len(singleton.ValueFields):len(singleton.ValueFields)],
+		schema.Field{Name: "ts", Kind: schema.TimeKind, Nullable: true})
+	vote := testdata.VoteObject
+	vote.ValueFields = []schema.Field{{Name: "vote", Kind: schema.EnumKind, EnumType: voteType}}
+	migrated, err := schema.NewModuleSchema([]schema.ObjectType{
+		testdata.AllKindsObject,
+		singleton,
+		vote,
+		{
+			Name:        "counter",
+			KeyFields:   []schema.Field{{Name: "name", Kind: schema.StringKind}},
+			ValueFields: []schema.Field{{Name: "count", Kind: schema.Uint64Kind}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, initModule(t, connectionUrl, migrated))
+
+	// incompatible changes are refused
+	err = initModule(t, connectionUrl, testdata.ExampleSchema)
+	require.ErrorContains(t, err, "incompatible schema changes for module test")
+	require.ErrorContains(t, err, "object type counter was removed")
+}
+
+func initModule(t *testing.T, connectionUrl string, modSchema schema.ModuleSchema) error {
+	t.Helper()
+	cfg, err := postgresConfigToIndexerConfig(postgres.Config{
+		DatabaseURL: connectionUrl,
+	})
+	require.NoError(t, err)
+
+	res, err := postgres.StartIndexer(indexer.InitParams{
+		Config:  cfg,
+		Context: context.Background(),
+		Logger:  logutil.NoopLogger{},
+	})
+	require.NoError(t, err)
+
+	err = res.Listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     modSchema,
+	})
+	if err != nil {
+		return err
+	}
+
+	cb, err := res.Listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	if cb != nil {
+		require.NoError(t, cb())
+	}
+	return nil
+}
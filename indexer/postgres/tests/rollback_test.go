@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/logutil"
+)
+
+var counterSchema = func() schema.ModuleSchema {
+	modSchema, err := schema.NewModuleSchema([]schema.ObjectType{
+		{
+			Name:        "counter",
+			KeyFields:   []schema.Field{{Name: "name", Kind: schema.StringKind}},
+			ValueFields: []schema.Field{{Name: "count", Kind: schema.Int64Kind}},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return modSchema
+}()
+
+func TestRollback(t *testing.T) {
+	connectionUrl := createTestDB(t)
+	res := startRollbackIndexer(t, connectionUrl)
+	requireBlockNum(t, res, 0)
+	initCounterModule(t, res.Listener)
+
+	// block 1 creates a and b, block 2 updates a, deletes b and creates c, block 3 updates c
+	indexBlock(t, res.Listener, 1,
+		schema.ObjectUpdate{TypeName: "counter", Key: "a", Value: []interface{}{int64(1)}},
+		schema.ObjectUpdate{TypeName: "counter", Key: "b", Value: []interface{}{int64(1)}},
+	)
+	indexBlock(t, res.Listener, 2,
+		schema.ObjectUpdate{TypeName: "counter", Key: "a", Value: []interface{}{int64(2)}},
+		schema.ObjectUpdate{TypeName: "counter", Key: "b", Delete: true},
+		schema.ObjectUpdate{TypeName: "counter", Key: "c", Value: []interface{}{int64(1)}},
+	)
+	indexBlock(t, res.Listener, 3,
+		schema.ObjectUpdate{TypeName: "counter", Key: "c", Value: []interface{}{int64(2)}},
+	)
+	require.Equal(t, map[string]int64{"a": 2, "c": 2}, readCounters(t, connectionUrl))
+
+	res = startRollbackIndexer(t, connectionUrl)
+	require.NotNil(t, res.Rollback)
+	requireBlockNum(t, res, 3)
+
+	// rolling back to the last block is a no-op
+	require.NoError(t, res.Rollback(3))
+	require.Equal(t, map[string]int64{"a": 2, "c": 2}, readCounters(t, connectionUrl))
+
+	require.NoError(t, res.Rollback(1))
+	require.Equal(t, map[string]int64{"a": 1, "b": 1}, readCounters(t, connectionUrl))
+	requireBlockNum(t, res, 1)
+
+	// indexing resumes after the block rolled back to
+	initCounterModule(t, res.Listener)
+	indexBlock(t, res.Listener, 2,
+		schema.ObjectUpdate{TypeName: "counter", Key: "a", Value: []interface{}{int64(3)}},
+	)
+	require.Equal(t, map[string]int64{"a": 3, "b": 1}, readCounters(t, connectionUrl))
+
+	// data before the first indexed block cannot be restored
+	res = startRollbackIndexer(t, connectionUrl)
+	require.ErrorContains(t, res.Rollback(0), "before the first indexed block")
+}
+
+func startRollbackIndexer(t *testing.T, connectionUrl string) indexer.InitResult {
+	t.Helper()
+	cfg, err := postgresConfigToIndexerConfig(postgres.Config{
+		DatabaseURL:   connectionUrl,
+		RetainHistory: true,
+	})
+	require.NoError(t, err)
+
+	res, err := postgres.StartIndexer(indexer.InitParams{
+		Config:  cfg,
+		Context: context.Background(),
+		Logger:  logutil.NoopLogger{},
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+// requireBlockNum checks the last block reported by the view of the indexer.
+func requireBlockNum(t *testing.T, res indexer.InitResult, expected uint64) {
+	t.Helper()
+	require.NotNil(t, res.View)
+	blockNum, err := res.View.BlockNum()
+	require.NoError(t, err)
+	require.Equal(t, expected, blockNum)
+}
+
+func initCounterModule(t *testing.T, listener appdata.Listener) {
+	t.Helper()
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     counterSchema,
+	}))
+}
+
+func indexBlock(t *testing.T, listener appdata.Listener, height uint64, updates ...schema.ObjectUpdate) {
+	t.Helper()
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: height}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{ModuleName: "test", Updates: updates}))
+	cb, err := listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	if cb != nil {
+		require.NoError(t, cb())
+	}
+}
+
+func readCounters(t *testing.T, connectionUrl string) map[string]int64 {
+	t.Helper()
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, db.Close()) }()
+
+	rows, err := db.Query(`SELECT name, count FROM "test_counter"`)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, rows.Close()) }()
+
+	counters := map[string]int64{}
+	for rows.Next() {
+		var name string
+		var count int64
+		require.NoError(t, rows.Scan(&name, &count))
+		counters[name] = count
+	}
+	require.NoError(t, rows.Err())
+
+	return counters
+}
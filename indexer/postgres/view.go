@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"cosmossdk.io/schema/view"
+)
+
+var _ view.AppData = (*indexerImpl)(nil)
+
+// BlockNum returns the number of the last indexed block, or 0 if no block was indexed yet, so that the indexer
+// manager performs a catch-up sync of an empty database.
+func (i *indexerImpl) BlockNum() (uint64, error) {
+	var lastBlock sql.NullInt64
+	err := i.tx.QueryRowContext(i.ctx, "SELECT MAX(number) FROM block").Scan(&lastBlock)
+	if err != nil || !lastBlock.Valid {
+		return 0, err
+	}
+
+	return uint64(lastBlock.Int64), nil
+}
+
+// AppState returns nil as the indexed state cannot be queried through the view yet.
+func (i *indexerImpl) AppState() view.AppState {
+	return nil
+}
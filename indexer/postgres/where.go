@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"fmt"
+	"io"
+)
+
+// whereSqlAndParams generates a WHERE clause matching the primary key of the object, without the WHERE keyword,
+// and returns its parameters. Parameter placeholders are numbered from startParamIdx.
+func (tm *objectIndexer) whereSqlAndParams(writer io.Writer, key interface{}, startParamIdx int) ([]interface{}, error) {
+	if len(tm.typ.KeyFields) == 0 {
+		_, err := fmt.Fprintf(writer, "_id = 1")
+		return nil, err
+	}
+
+	cols, params, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, col := range cols {
+		if i > 0 {
+			_, err = fmt.Fprintf(writer, " AND ")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = fmt.Fprintf(writer, "%s = $%d", col, startParamIdx+i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
+
+// primaryKeyColumnNames returns the names of the primary key columns of the object type.
+func (tm *objectIndexer) primaryKeyColumnNames() ([]string, error) {
+	if len(tm.typ.KeyFields) == 0 {
+		return []string{"_id"}, nil
+	}
+
+	var pKeys []string
+	for _, field := range tm.typ.KeyFields {
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, err
+		}
+
+		pKeys = append(pKeys, name)
+	}
+
+	return pKeys, nil
+}
+
+// columnNames returns the names of all the updatable columns of the object type, starting with the
+// primary key columns.
+func (tm *objectIndexer) columnNames() ([]string, error) {
+	cols, err := tm.primaryKeyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range tm.typ.ValueFields {
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, err
+		}
+
+		cols = append(cols, name)
+	}
+
+	return cols, nil
+}
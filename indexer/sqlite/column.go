@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// createColumnDefinition writes a column definition within a CREATE TABLE statement for the field.
+func (tm *objectIndexer) createColumnDefinition(writer io.Writer, field schema.Field) error {
+	typ := columnType(field.Kind)
+	if typ == "" {
+		return fmt.Errorf("unexpected kind: %v", field.Kind)
+	}
+
+	_, err := fmt.Fprintf(writer, "%q %s", field.Name, typ)
+	if err != nil {
+		return err
+	}
+
+	if field.Nullable {
+		_, err = fmt.Fprintf(writer, " NULL")
+	} else {
+		_, err = fmt.Fprintf(writer, " NOT NULL")
+	}
+	if err != nil {
+		return err
+	}
+
+	if field.Kind == schema.EnumKind {
+		// SQLite has no enum types so we constrain the column to the enum values instead
+		values := make([]string, 0, len(field.EnumType.Values))
+		for _, value := range field.EnumType.Values {
+			values = append(values, fmt.Sprintf("'%s'", value))
+		}
+
+		_, err = fmt.Fprintf(writer, " CHECK (%q IN (%s))", field.Name, strings.Join(values, ", "))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(writer, ",\n\t")
+	return err
+}
+
+// columnType returns the SQLite column type for the kind, or an empty string for unknown kinds.
+//
+// Values which may not fit losslessly in a 64-bit signed integer or a double, such as uint64 values and
+// integer and decimal strings, are stored as TEXT. Times and durations are stored as INTEGER nanoseconds.
+func columnType(kind schema.Kind) string {
+	//nolint:goconst // adding constants for these sqlite type names would impede readability
+	switch kind {
+	case schema.StringKind:
+		return "TEXT"
+	case schema.BoolKind:
+		return "INTEGER"
+	case schema.BytesKind:
+		return "BLOB"
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind, schema.Int64Kind:
+		return "INTEGER"
+	case schema.Uint8Kind, schema.Uint16Kind, schema.Uint32Kind:
+		return "INTEGER"
+	case schema.Uint64Kind:
+		return "TEXT"
+	case schema.IntegerStringKind:
+		return "TEXT"
+	case schema.DecimalStringKind:
+		return "TEXT"
+	case schema.Float32Kind, schema.Float64Kind:
+		return "REAL"
+	case schema.JSONKind:
+		return "TEXT"
+	case schema.TimeKind:
+		return "INTEGER"
+	case schema.DurationKind:
+		return "INTEGER"
+	case schema.AddressKind:
+		return "TEXT"
+	case schema.EnumKind:
+		return "TEXT"
+	default:
+		return ""
+	}
+}
+
+// columnName is the quoted name of the column for the field.
+func columnName(field schema.Field) string {
+	return fmt.Sprintf("%q", field.Name)
+}
@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// delete deletes the object with the given key, or marks it as deleted if the object type retains deletions.
+func (tm *objectIndexer) delete(ctx context.Context, conn dbConn, key interface{}) error {
+	buf := new(strings.Builder)
+	params, err := tm.deleteSql(buf, key)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.logger != nil {
+		tm.options.logger.Debug("Delete", "table", tm.tableName(), "sql", sqlStr, "params", params)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// deleteSql generates a DELETE statement for the object, or an UPDATE statement setting _deleted
+// if the object type retains deletions, and returns its parameters.
+func (tm *objectIndexer) deleteSql(writer io.Writer, key interface{}) ([]interface{}, error) {
+	var err error
+	if !tm.options.disableRetainDeletions && tm.typ.RetainDeletions {
+		_, err = fmt.Fprintf(writer, "UPDATE %q SET _deleted = 1 WHERE ", tm.tableName())
+	} else {
+		_, err = fmt.Fprintf(writer, "DELETE FROM %q WHERE ", tm.tableName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := tm.whereSqlAndParams(writer, key)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, ";")
+	return params, err
+}
@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_deleteSql_vote() {
+	exampleDelete(testdata.VoteObject, false, []interface{}{int64(1), []byte{0xde, 0xad}})
+	// Output:
+	// UPDATE "test_vote" SET _deleted = 1 WHERE "proposal" = ? AND "address" = ?;
+	// [1 0xdead]
+}
+
+func Example_objectIndexer_deleteSql_voteNoRetainDelete() {
+	exampleDelete(testdata.VoteObject, true, []interface{}{int64(1), []byte{0xde, 0xad}})
+	// Output:
+	// DELETE FROM "test_vote" WHERE "proposal" = ? AND "address" = ?;
+	// [1 0xdead]
+}
+
+func Example_objectIndexer_deleteSql_singleton() {
+	exampleDelete(testdata.SingletonObject, false, nil)
+	// Output:
+	// DELETE FROM "test_singleton" WHERE _id = 1;
+	// []
+}
+
+func exampleDelete(objectType schema.ObjectType, noRetainDelete bool, key interface{}) {
+	tm := newObjectIndexer("test", objectType, options{
+		logger:                 logutil.NoopLogger{},
+		disableRetainDeletions: noRetainDelete,
+		addressCodec:           addressutil.HexAddressCodec{},
+	})
+	params, err := tm.deleteSql(os.Stdout, key)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+}
@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/logutil"
+)
+
+func Example_objectIndexer_insertUpdateSql_vote() {
+	exampleInsertUpdate(testdata.VoteObject, false, []interface{}{int64(1), []byte{0xde, 0xad}}, "yes")
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote") VALUES (?, ?, ?) ON CONFLICT ("proposal", "address") DO UPDATE SET "vote" = excluded."vote", _deleted = 0;
+	// [1 0xdead yes]
+}
+
+func Example_objectIndexer_insertUpdateSql_voteNoRetainDelete() {
+	exampleInsertUpdate(testdata.VoteObject, true, []interface{}{int64(1), []byte{0xde, 0xad}}, "yes")
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote") VALUES (?, ?, ?) ON CONFLICT ("proposal", "address") DO UPDATE SET "vote" = excluded."vote";
+	// [1 0xdead yes]
+}
+
+func Example_objectIndexer_insertUpdateSql_singleton() {
+	exampleInsertUpdate(testdata.SingletonObject, false, nil, []interface{}{"abc", nil, "a"})
+	// Output:
+	// INSERT INTO "test_singleton" (_id, "foo", "bar", "an_enum") VALUES (1, ?, ?, ?) ON CONFLICT (_id) DO UPDATE SET "foo" = excluded."foo", "bar" = excluded."bar", "an_enum" = excluded."an_enum";
+	// [abc <nil> a]
+}
+
+func Example_objectIndexer_insertUpdateSql_singletonValueUpdates() {
+	exampleInsertUpdate(testdata.SingletonObject, false, nil, schema.MapValueUpdates{"bar": int32(3)})
+	// Output:
+	// UPDATE "test_singleton" SET "bar" = ? WHERE _id = 1;
+	// [3]
+}
+
+func Example_objectIndexer_insertUpdateSql_allKindsKey() {
+	exampleInsertUpdate(testdata.AllKindsObject, false, []interface{}{int64(1), time.Unix(1, 2)}, schema.MapValueUpdates{
+		"duration": time.Second,
+		"uint64":   uint64(1) << 63,
+		"json":     json.RawMessage(`{}`),
+	})
+	// Output:
+	// UPDATE "test_all_kinds" SET "duration" = ?, "json" = ?, "uint64" = ? WHERE "id" = ? AND "ts" = ?;
+	// [1000000000 {} 9223372036854775808 1 1000000002]
+}
+
+func exampleInsertUpdate(objectType schema.ObjectType, noRetainDelete bool, key, value interface{}) {
+	tm := newObjectIndexer("test", objectType, options{
+		logger:                 logutil.NoopLogger{},
+		disableRetainDeletions: noRetainDelete,
+		addressCodec:           addressutil.HexAddressCodec{},
+	})
+	params, err := tm.insertUpdateSql(os.Stdout, key, value)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/sqlite"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/indexer"
+)
+
+var counterSchema = func() schema.ModuleSchema {
+	modSchema, err := schema.NewModuleSchema([]schema.ObjectType{{
+		Name:        "counter",
+		KeyFields:   []schema.Field{{Name: "name", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "count", Kind: schema.StringKind}},
+	}})
+	if err != nil {
+		panic(err)
+	}
+	return modSchema
+}()
+
+// counterModule is a module whose state maps counter names to counts.
+type counterModule struct{}
+
+func (counterModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: counterSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			return []schema.ObjectUpdate{{
+				TypeName: "counter",
+				Key:      string(update.Key),
+				Value:    string(update.Value),
+				Delete:   update.Remove,
+			}}, nil
+		},
+	}, nil
+}
+
+// mapSyncSource is a decoding.SyncSource over the state of each module.
+type mapSyncSource map[string]map[string]string
+
+func (s mapSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	for key, value := range s[moduleName] {
+		if err := fn([]byte(key), []byte(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestCatchUp(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "index.db")
+	opts := indexer.ManagerOptions{
+		Config: indexer.ManagerConfig{Target: map[string]indexer.Config{
+			"sqlite": indexerConfig(t, sqlite.Config{DatabasePath: dbPath}),
+		}},
+		Resolver:   decoding.ModuleSetDecoderResolver(map[string]interface{}{"test": counterModule{}}),
+		SyncSource: mapSyncSource{"test": {"a": "1", "b": "2"}},
+		SyncHeight: 5,
+	}
+
+	// the empty database is caught up with the state at the sync height
+	listener, err := indexer.StartManager(opts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "1", "b": "2"}, readCounters(t, dbPath))
+	require.Equal(t, []int64{5}, readBlocks(t, dbPath))
+
+	// live data is indexed from the next block on
+	indexCounterBlock(t, listener, 6, "a", "3")
+	require.Equal(t, map[string]string{"a": "3", "b": "2"}, readCounters(t, dbPath))
+
+	// a restarted indexer has data and is not caught up again
+	opts.SyncSource = mapSyncSource{"test": {"c": "1"}}
+	opts.SyncHeight = 6
+	listener, err = indexer.StartManager(opts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "3", "b": "2"}, readCounters(t, dbPath))
+
+	indexCounterBlock(t, listener, 7, "b", "4")
+	require.Equal(t, map[string]string{"a": "3", "b": "4"}, readCounters(t, dbPath))
+	require.Equal(t, []int64{5, 6, 7}, readBlocks(t, dbPath))
+}
+
+func indexCounterBlock(t *testing.T, listener appdata.Listener, height uint64, name, count string) {
+	t.Helper()
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: height}))
+	require.NoError(t, listener.OnKVPair(appdata.KVPairData{Updates: []appdata.ActorKVPairUpdate{{
+		Actor:        []byte("test"),
+		StateChanges: []schema.KVPairUpdate{{Key: []byte(name), Value: []byte(count)}},
+	}}}))
+	commit(t, listener)
+}
+
+func readCounters(t *testing.T, dbPath string) map[string]string {
+	t.Helper()
+	rows, err := openDB(t, dbPath).Query(`SELECT name, count FROM test_counter`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	counters := map[string]string{}
+	for rows.Next() {
+		var name, count string
+		require.NoError(t, rows.Scan(&name, &count))
+		counters[name] = count
+	}
+	require.NoError(t, rows.Err())
+
+	return counters
+}
+
+func readBlocks(t *testing.T, dbPath string) []int64 {
+	t.Helper()
+	rows, err := openDB(t, dbPath).Query(`SELECT number FROM block ORDER BY number`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var blocks []int64
+	for rows.Next() {
+		var number int64
+		require.NoError(t, rows.Scan(&number))
+		blocks = append(blocks, number)
+	}
+	require.NoError(t, rows.Err())
+
+	return blocks
+}
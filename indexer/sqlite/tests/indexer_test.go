@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3" // this is where we get our sqlite3 database driver from
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/sqlite"
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+)
+
+func TestIndexer(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "index.db")
+	listener := startIndexer(t, sqlite.Config{DatabasePath: dbPath})
+
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testdata.ExampleSchema,
+	}))
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 1}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "singleton", Value: []interface{}{"abc", nil, "a"}},
+			{TypeName: "vote", Key: []interface{}{int64(1), []byte{0xde, 0xad}}, Value: "yes"},
+			{TypeName: "vote", Key: []interface{}{int64(2), []byte{0xbe, 0xef}}, Value: "no"},
+		},
+	}))
+	commit(t, listener)
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 2}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "singleton", Value: schema.MapValueUpdates{"bar": int32(3)}},
+			{TypeName: "vote", Key: []interface{}{int64(1), []byte{0xde, 0xad}}, Value: "abstain"},
+			{TypeName: "vote", Key: []interface{}{int64(2), []byte{0xbe, 0xef}}, Delete: true},
+		},
+	}))
+	commit(t, listener)
+
+	// enum columns are constrained to their values
+	require.Error(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "vote", Key: []interface{}{int64(3), []byte{0x01}}, Value: "maybe"},
+		},
+	}))
+
+	db := openDB(t, dbPath)
+
+	var foo, anEnum string
+	var bar int32
+	require.NoError(t, db.QueryRow(`SELECT foo, bar, an_enum FROM test_singleton`).Scan(&foo, &bar, &anEnum))
+	require.Equal(t, "abc", foo)
+	require.Equal(t, int32(3), bar)
+	require.Equal(t, "a", anEnum)
+
+	type vote struct {
+		proposal int64
+		address  string
+		vote     string
+		deleted  bool
+	}
+	rows, err := db.Query(`SELECT proposal, address, vote, _deleted FROM test_vote ORDER BY proposal`)
+	require.NoError(t, err)
+	defer rows.Close()
+	var votes []vote
+	for rows.Next() {
+		var v vote
+		require.NoError(t, rows.Scan(&v.proposal, &v.address, &v.vote, &v.deleted))
+		votes = append(votes, v)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []vote{
+		{proposal: 1, address: "0xdead", vote: "abstain"},
+		{proposal: 2, address: "0xbeef", vote: "no", deleted: true},
+	}, votes)
+
+	var blocks int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM block`).Scan(&blocks))
+	require.Equal(t, 2, blocks)
+}
+
+func TestStartIndexerMissingPath(t *testing.T) {
+	_, err := sqlite.StartIndexer(indexer.InitParams{Config: indexerConfig(t, sqlite.Config{})})
+	require.ErrorContains(t, err, "missing database path")
+}
+
+func startIndexer(t *testing.T, cfg sqlite.Config) appdata.Listener {
+	t.Helper()
+	res, err := sqlite.StartIndexer(indexer.InitParams{
+		Config: indexerConfig(t, cfg),
+	})
+	require.NoError(t, err)
+	return res.Listener
+}
+
+func openDB(t *testing.T, dbPath string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func commit(t *testing.T, listener appdata.Listener) {
+	t.Helper()
+	cb, err := listener.Commit(appdata.CommitData{})
+	require.NoError(t, err)
+	if cb != nil {
+		require.NoError(t, cb())
+	}
+}
+
+func indexerConfig(t *testing.T, cfg sqlite.Config) indexer.Config {
+	t.Helper()
+	cfgBz, err := json.Marshal(cfg)
+	require.NoError(t, err)
+
+	var cfgMap map[string]interface{}
+	require.NoError(t, json.Unmarshal(cfgBz, &cfgMap))
+
+	return indexer.Config{
+		Type:   sqlite.IndexerType,
+		Config: cfgMap,
+	}
+}
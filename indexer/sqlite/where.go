@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"fmt"
+	"io"
+)
+
+// whereSqlAndParams generates a WHERE clause matching the primary key of the object, without the WHERE keyword,
+// and returns its parameters.
+func (tm *objectIndexer) whereSqlAndParams(writer io.Writer, key interface{}) ([]interface{}, error) {
+	if len(tm.typ.KeyFields) == 0 {
+		_, err := fmt.Fprintf(writer, "_id = 1")
+		return nil, err
+	}
+
+	cols, params, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, col := range cols {
+		if i > 0 {
+			_, err = fmt.Fprintf(writer, " AND ")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = fmt.Fprintf(writer, "%s = ?", col)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
+
+// primaryKeyColumnNames returns the names of the primary key columns of the object type.
+func (tm *objectIndexer) primaryKeyColumnNames() []string {
+	if len(tm.typ.KeyFields) == 0 {
+		return []string{"_id"}
+	}
+
+	pKeys := make([]string, 0, len(tm.typ.KeyFields))
+	for _, field := range tm.typ.KeyFields {
+		pKeys = append(pKeys, columnName(field))
+	}
+
+	return pKeys
+}
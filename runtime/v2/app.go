@@ -8,6 +8,7 @@ import (
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
 	runtimev2 "cosmossdk.io/api/cosmos/app/runtime/v2"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/core/transaction"
@@ -40,6 +41,7 @@ type App[T transaction.Tx] struct {
 
 	// modules configuration
 	storeKeys          []string
+	moduleStoreKeys    map[string]string
 	interfaceRegistrar registry.InterfaceRegistrar
 	amino              legacy.Amino
 	moduleManager      *MM[T]
@@ -64,6 +66,11 @@ func (a *App[T]) ModuleManager() *MM[T] {
 	return a.moduleManager
 }
 
+// Modules returns the modules of the app by name.
+func (a *App[T]) Modules() map[string]appmodulev2.AppModule {
+	return a.moduleManager.Modules()
+}
+
 // DefaultGenesis returns a default genesis from the registered modules.
 func (a *App[T]) DefaultGenesis() map[string]json.RawMessage {
 	return a.moduleManager.DefaultGenesis()
@@ -94,6 +101,11 @@ func (a *App[T]) GetStoreKeys() []string {
 	return a.storeKeys
 }
 
+// ModuleStoreKeys returns the KV store key of each module with a store by module name.
+func (a *App[T]) ModuleStoreKeys() map[string]string {
+	return a.moduleStoreKeys
+}
+
 // UnsafeFindStoreKey fetches a registered StoreKey from the App in linear time.
 // NOTE: This should only be used in testing.
 func (a *App[T]) UnsafeFindStoreKey(storeKey string) (string, error) {
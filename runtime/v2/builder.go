@@ -7,6 +7,7 @@ import (
 	"io"
 	"path/filepath"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 
 	"cosmossdk.io/core/appmodule"
@@ -126,7 +127,12 @@ func (a *AppBuilder[T]) Build(opts ...AppBuilderOption[T]) (*App[T], error) {
 
 	storeOpts := rootstore.DefaultStoreOptions()
 	if s := a.viper.Sub("store.options"); s != nil {
-		if err := s.Unmarshal(&storeOpts); err != nil {
+		if err := s.Unmarshal(&storeOpts, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+			rootstore.SSTypeDecodeHook,
+			// viper's default decode hooks
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		))); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal store options: %w", err)
 		}
 	}
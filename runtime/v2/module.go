@@ -128,6 +128,7 @@ func ProvideAppBuilder[T transaction.Tx](
 	msgRouterBuilder := stf.NewMsgRouterBuilder()
 	app := &App[T]{
 		storeKeys:               nil,
+		moduleStoreKeys:         map[string]string{},
 		interfaceRegistrar:      interfaceRegistrar,
 		amino:                   amino,
 		msgRouterBuilder:        msgRouterBuilder,
@@ -199,6 +200,7 @@ func ProvideEnvironment[T transaction.Tx](
 		}
 
 		registerStoreKey(appBuilder, kvStoreKey)
+		appBuilder.app.moduleStoreKeys[key.Name()] = kvStoreKey
 		kvService = stf.NewKVStoreService([]byte(kvStoreKey))
 
 		memStoreKey := fmt.Sprintf("memory:%s", key.Name())
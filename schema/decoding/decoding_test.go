@@ -139,6 +139,40 @@ func TestSync(t *testing.T) {
 	}
 }
 
+func TestSync_batched(t *testing.T) {
+	tl := newTestFixture(t)
+	tl.bankMod.Mint("bob", "foo", 100)
+	tl.bankMod.Mint("bob", "bar", 100)
+	err := tl.bankMod.Send("bob", "alice", "foo", 50)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	var batches []int
+	onObjectUpdate := tl.Listener.OnObjectUpdate
+	tl.Listener.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+		if data.ModuleName == "bank" {
+			batches = append(batches, len(data.Updates))
+		}
+		return onObjectUpdate(data)
+	}
+
+	err = Sync(tl.Listener, tl.multiStore, tl.resolver, SyncOptions{BatchSize: 2})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	// 3 balances and 2 supplies
+	expectedBatches := []int{2, 2, 1}
+	if !reflect.DeepEqual(batches, expectedBatches) {
+		t.Fatalf("expected batches %v, got %v", expectedBatches, batches)
+	}
+
+	if len(tl.bankUpdates) != 5 {
+		t.Fatalf("expected 5 bank updates, got %d", len(tl.bankUpdates))
+	}
+}
+
 func TestSync_filtered(t *testing.T) {
 	tl := newTestFixture(t)
 	tl.bankMod.Mint("bob", "foo", 100)
@@ -15,6 +15,11 @@ type SyncSource interface {
 // SyncOptions are the options for Sync.
 type SyncOptions struct {
 	ModuleFilter func(moduleName string) bool
+
+	// BatchSize is the number of object updates accumulated for a module before they are sent to the listener
+	// in a single OnObjectUpdate call. If it is zero, the object updates decoded from each key-value pair are
+	// sent separately.
+	BatchSize int
 }
 
 // Sync synchronizes existing state from the sync source to the listener using the resolver to decode data.
@@ -47,7 +52,8 @@ func Sync(listener appdata.Listener, source SyncSource, resolver DecoderResolver
 			return nil
 		}
 
-		return source.IterateAllKVPairs(moduleName, func(key, value []byte) error {
+		var batch []schema.ObjectUpdate
+		err := source.IterateAllKVPairs(moduleName, func(key, value []byte) error {
 			updates, err := cdc.KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
 			if err != nil {
 				return err
@@ -57,7 +63,24 @@ func Sync(listener appdata.Listener, source SyncSource, resolver DecoderResolver
 				return nil
 			}
 
-			return onObjectUpdate(appdata.ObjectUpdateData{ModuleName: moduleName, Updates: updates})
+			if opts.BatchSize <= 0 {
+				return onObjectUpdate(appdata.ObjectUpdateData{ModuleName: moduleName, Updates: updates})
+			}
+
+			batch = append(batch, updates...)
+			if len(batch) < opts.BatchSize {
+				return nil
+			}
+
+			err = onObjectUpdate(appdata.ObjectUpdateData{ModuleName: moduleName, Updates: batch})
+			batch = nil
+			return err
 		})
+		if err != nil || len(batch) == 0 {
+			return err
+		}
+
+		// flush the last partial batch
+		return onObjectUpdate(appdata.ObjectUpdateData{ModuleName: moduleName, Updates: batch})
 	})
 }
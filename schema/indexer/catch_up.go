@@ -0,0 +1,145 @@
+package indexer
+
+import (
+	"fmt"
+
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+)
+
+// catchUp sends the state of SyncSource at SyncHeight to listener as the data of block SyncHeight and commits it.
+func catchUp(listener appdata.Listener, opts ManagerOptions, moduleFilter func(moduleName string) bool) error {
+	if listener.StartBlock != nil {
+		err := listener.StartBlock(appdata.StartBlockData{Height: opts.SyncHeight})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := decoding.Sync(listener, opts.SyncSource, opts.Resolver, decoding.SyncOptions{
+		ModuleFilter: moduleFilter,
+		BatchSize:    opts.SyncBatchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	if listener.Commit == nil {
+		return nil
+	}
+
+	cb, err := listener.Commit(appdata.CommitData{})
+	if err != nil {
+		return err
+	}
+
+	if cb != nil {
+		return cb()
+	}
+
+	return nil
+}
+
+// initializeModulesOnce returns a listener which forwards InitializeModuleData to listener only once per module,
+// so that modules initialized by a catch-up sync are not initialized again when they are first encountered
+// in live data.
+func initializeModulesOnce(listener appdata.Listener) appdata.Listener {
+	initializeModuleData := listener.InitializeModuleData
+	if initializeModuleData == nil {
+		return listener
+	}
+
+	initialized := map[string]bool{}
+	listener.InitializeModuleData = func(data appdata.ModuleInitializationData) error {
+		if initialized[data.ModuleName] {
+			return nil
+		}
+
+		err := initializeModuleData(data)
+		if err != nil {
+			return err
+		}
+
+		initialized[data.ModuleName] = true
+		return nil
+	}
+
+	return listener
+}
+
+// resumeAfter returns a listener which drops the data of the blocks up to lastBlock, which the indexer
+// already has, and forwards to listener all the data starting at block lastBlock + 1. Receiving a later
+// block first is an error because the data of the blocks in between would be lost.
+func resumeAfter(listener appdata.Listener, lastBlock uint64) appdata.Listener {
+	resumed := false
+	skipping := false
+
+	startBlock := listener.StartBlock
+	listener.StartBlock = func(data appdata.StartBlockData) error {
+		if !resumed {
+			if data.Height <= lastBlock {
+				skipping = true
+				return nil
+			}
+
+			if data.Height != lastBlock+1 {
+				return fmt.Errorf("indexer has data up to block %d but received block %d, the data of the blocks in between would be lost", lastBlock, data.Height)
+			}
+
+			resumed = true
+			skipping = false
+		}
+
+		if startBlock == nil {
+			return nil
+		}
+		return startBlock(data)
+	}
+
+	if onTx := listener.OnTx; onTx != nil {
+		listener.OnTx = func(data appdata.TxData) error {
+			if skipping {
+				return nil
+			}
+			return onTx(data)
+		}
+	}
+
+	if onEvent := listener.OnEvent; onEvent != nil {
+		listener.OnEvent = func(data appdata.EventData) error {
+			if skipping {
+				return nil
+			}
+			return onEvent(data)
+		}
+	}
+
+	if onKVPair := listener.OnKVPair; onKVPair != nil {
+		listener.OnKVPair = func(data appdata.KVPairData) error {
+			if skipping {
+				return nil
+			}
+			return onKVPair(data)
+		}
+	}
+
+	if onObjectUpdate := listener.OnObjectUpdate; onObjectUpdate != nil {
+		listener.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+			if skipping {
+				return nil
+			}
+			return onObjectUpdate(data)
+		}
+	}
+
+	if commit := listener.Commit; commit != nil {
+		listener.Commit = func(data appdata.CommitData) (func() error, error) {
+			if skipping {
+				return nil, nil
+			}
+			return commit(data)
+		}
+	}
+
+	return listener
+}
@@ -0,0 +1,111 @@
+package indexer
+
+import (
+	"errors"
+
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+)
+
+// moduleFilter returns a function selecting the modules whose state is indexed according to the
+// IncludeModules and ExcludeModules options, or nil if all modules are indexed.
+func (c Config) moduleFilter() (func(moduleName string) bool, error) {
+	if len(c.IncludeModules) > 0 && len(c.ExcludeModules) > 0 {
+		return nil, errors.New("only one of include_modules or exclude_modules can be specified")
+	}
+
+	if len(c.IncludeModules) > 0 {
+		include := make(map[string]bool, len(c.IncludeModules))
+		for _, name := range c.IncludeModules {
+			include[name] = true
+		}
+		return func(moduleName string) bool { return include[moduleName] }, nil
+	}
+
+	if len(c.ExcludeModules) > 0 {
+		exclude := make(map[string]bool, len(c.ExcludeModules))
+		for _, name := range c.ExcludeModules {
+			exclude[name] = true
+		}
+		return func(moduleName string) bool { return !exclude[moduleName] }, nil
+	}
+
+	return nil, nil
+}
+
+// filterListener returns a listener which only forwards to listener the data selected by the common options
+// of the indexer config. moduleFilter may be nil if all modules are indexed.
+func filterListener(
+	listener appdata.Listener,
+	cfg Config,
+	moduleFilter func(moduleName string) bool,
+	resolver decoding.DecoderResolver,
+) appdata.Listener {
+	if cfg.ExcludeState {
+		listener.InitializeModuleData = nil
+		listener.OnKVPair = nil
+		listener.OnObjectUpdate = nil
+	}
+
+	if cfg.ExcludeEvents {
+		listener.OnEvent = nil
+	}
+
+	if cfg.ExcludeTxs {
+		listener.OnTx = nil
+	}
+
+	if cfg.ExcludeBlockHeaders && listener.StartBlock != nil {
+		startBlock := listener.StartBlock
+		listener.StartBlock = func(data appdata.StartBlockData) error {
+			data.HeaderBytes = nil
+			data.HeaderJSON = nil
+			return startBlock(data)
+		}
+	}
+
+	if moduleFilter == nil {
+		return listener
+	}
+
+	if initializeModuleData := listener.InitializeModuleData; initializeModuleData != nil {
+		listener.InitializeModuleData = func(data appdata.ModuleInitializationData) error {
+			if !moduleFilter(data.ModuleName) {
+				return nil
+			}
+			return initializeModuleData(data)
+		}
+	}
+
+	if onObjectUpdate := listener.OnObjectUpdate; onObjectUpdate != nil {
+		listener.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+			if !moduleFilter(data.ModuleName) {
+				return nil
+			}
+			return onObjectUpdate(data)
+		}
+	}
+
+	if onKVPair := listener.OnKVPair; onKVPair != nil {
+		listener.OnKVPair = func(data appdata.KVPairData) error {
+			updates := make([]appdata.ActorKVPairUpdate, 0, len(data.Updates))
+			for _, update := range data.Updates {
+				moduleName, err := resolver.DecodeModuleName(update.Actor)
+				if err != nil {
+					return err
+				}
+
+				if moduleFilter(moduleName) {
+					updates = append(updates, update)
+				}
+			}
+
+			if len(updates) == 0 {
+				return nil
+			}
+			return onKVPair(appdata.KVPairData{Updates: updates})
+		}
+	}
+
+	return listener
+}
@@ -83,4 +83,11 @@ type InitResult struct {
 	// If the block number is non-zero but does not match the current chain height, a runtime error
 	// will occur because this is an unsafe condition that indicates lost data.
 	View view.AppData
+
+	// Rollback is an optional function which reverts all the data indexed for the blocks after the given block
+	// height, so that the last block of the indexer becomes that height. At indexer start-up, the indexer manager
+	// calls it with the height of the last block committed by the app so that indexers never get ahead of the
+	// chain when the app state was rolled back, for instance with the rollback command. It should do nothing if
+	// no block after that height was indexed and return an error if the data of these blocks cannot be reverted.
+	Rollback func(height uint64) error
 }
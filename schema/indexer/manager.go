@@ -2,6 +2,10 @@ package indexer
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 
 	"cosmossdk.io/schema/addressutil"
 	"cosmossdk.io/schema/appdata"
@@ -35,16 +39,165 @@ type ManagerOptions struct {
 	// provided, but if it is omitted, the indexer manager will use a default codec which encodes and decodes addresses
 	// as hex strings.
 	AddressCodec addressutil.AddressCodec
+
+	// SyncHeight is the height of the last committed block whose state is represented by SyncSource. Indexers
+	// which need a catch-up sync are synced to this height and then receive live data starting at the next height.
+	// If it is zero, catch-up syncs are not performed.
+	SyncHeight uint64
+
+	// SyncBatchSize is the number of object updates sent to indexers at once during a catch-up sync.
+	// It defaults to 1000.
+	SyncBatchSize int
+
+	// CommittedHeight is the height of the last block committed by the app. Indexers which support rollbacks
+	// revert the data of the blocks they indexed after it, and starting indexers which report with their View
+	// that they have data of later blocks fails otherwise. If it is zero, indexers are not rolled back.
+	CommittedHeight uint64
 }
 
 // ManagerConfig is the configuration of the indexer manager and contains the configuration for each indexer target.
 type ManagerConfig struct {
 	// Target is a map of named indexer targets to their configuration.
-	Target map[string]Config
+	Target map[string]Config `json:"target"`
 }
 
+// defaultSyncBatchSize is the default number of object updates sent to indexers at once during a catch-up sync.
+const defaultSyncBatchSize = 1000
+
 // StartManager starts the indexer manager with the given options. The state machine should write all relevant app data to
 // the returned listener.
+//
+// Each indexer target is started with the indexer type registered under its type name. If an indexer reports
+// with its View that it has no data (block 0) and a SyncSource is available, the current state of all the modules
+// it indexes is sent to it at SyncHeight before the returned listener forwards it any live data. Indexers which
+// already have data skip the live data of the blocks they already indexed and receive data starting at the block
+// following the last one they indexed. Before any of that, indexers are rolled back to CommittedHeight.
 func StartManager(opts ManagerOptions) (appdata.Listener, error) {
-	panic("TODO: this will be implemented in a follow-up PR, this function is just a stub to demonstrate the API")
+	if opts.Resolver == nil {
+		return appdata.Listener{}, errors.New("missing decoder resolver")
+	}
+
+	cfg, err := unmarshalManagerConfig(opts.Config)
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
+	if opts.Logger == nil {
+		opts.Logger = logutil.NoopLogger{}
+	}
+
+	if opts.AddressCodec == nil {
+		opts.AddressCodec = addressutil.HexAddressCodec{}
+	}
+
+	if opts.SyncBatchSize <= 0 {
+		opts.SyncBatchSize = defaultSyncBatchSize
+	}
+
+	targetNames := make([]string, 0, len(cfg.Target))
+	for name := range cfg.Target {
+		targetNames = append(targetNames, name)
+	}
+	sort.Strings(targetNames)
+
+	listeners := make([]appdata.Listener, 0, len(targetNames))
+	for _, name := range targetNames {
+		listener, err := startTarget(name, cfg.Target[name], opts)
+		if err != nil {
+			return appdata.Listener{}, fmt.Errorf("failed to start indexer target %s: %v", name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return decoding.Middleware(appdata.ListenerMux(listeners...), opts.Resolver, decoding.MiddlewareOptions{})
+}
+
+// startTarget starts the indexer of a target, performing a catch-up sync if needed, and returns the listener
+// the target should receive live data with.
+func startTarget(name string, cfg Config, opts ManagerOptions) (appdata.Listener, error) {
+	initFunc, ok := indexerRegistry[cfg.Type]
+	if !ok {
+		return appdata.Listener{}, fmt.Errorf("indexer type %q not found", cfg.Type)
+	}
+
+	res, err := initFunc(InitParams{
+		Config:       cfg,
+		Context:      opts.Context,
+		Logger:       opts.Logger,
+		AddressCodec: opts.AddressCodec,
+	})
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+
+	moduleFilter, err := cfg.moduleFilter()
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+
+	if res.Rollback != nil && opts.CommittedHeight != 0 {
+		err = res.Rollback(opts.CommittedHeight)
+		if err != nil {
+			return appdata.Listener{}, fmt.Errorf("rollback to block %d failed: %v", opts.CommittedHeight, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	listener := initializeModulesOnce(filterListener(res.Listener, cfg, moduleFilter, opts.Resolver))
+	if res.View == nil {
+		return listener, nil
+	}
+
+	lastBlock, err := res.View.BlockNum()
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+
+	if opts.CommittedHeight != 0 && lastBlock > opts.CommittedHeight {
+		return appdata.Listener{}, fmt.Errorf("indexer has data up to block %d which is ahead of the last committed block %d and cannot be rolled back", lastBlock, opts.CommittedHeight)
+	}
+
+	if lastBlock == 0 {
+		if opts.SyncSource == nil || opts.SyncHeight == 0 {
+			// the indexer can only index state from the first block it receives
+			return listener, nil
+		}
+
+		opts.Logger.Info("Starting indexer catch-up sync", "target", name, "height", opts.SyncHeight)
+		err = catchUp(listener, opts, moduleFilter)
+		if err != nil {
+			return appdata.Listener{}, fmt.Errorf("catch-up sync failed: %v", err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+		opts.Logger.Info("Finished indexer catch-up sync", "target", name, "height", opts.SyncHeight)
+
+		lastBlock = opts.SyncHeight
+	}
+
+	return resumeAfter(listener, lastBlock), nil
+}
+
+// unmarshalManagerConfig converts the user configuration, usually a map[string]interface{} decoded from
+// the app config, to a ManagerConfig.
+func unmarshalManagerConfig(config interface{}) (ManagerConfig, error) {
+	switch config := config.(type) {
+	case nil:
+		return ManagerConfig{}, nil
+	case ManagerConfig:
+		return config, nil
+	case *ManagerConfig:
+		return *config, nil
+	}
+
+	bz, err := json.Marshal(config)
+	if err != nil {
+		return ManagerConfig{}, err
+	}
+
+	var cfg ManagerConfig
+	err = json.Unmarshal(bz, &cfg)
+	return cfg, err
 }
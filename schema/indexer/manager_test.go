@@ -0,0 +1,337 @@
+package indexer
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/view"
+)
+
+func TestStartManager_catchUp(t *testing.T) {
+	packets := registerTestIndexer(t, "manager_test_catch_up", 0)
+	source := testSyncSource{
+		"one": {"a": "1", "b": "2", "c": "3"},
+		"two": {"d": "4"},
+	}
+
+	listener, err := StartManager(ManagerOptions{
+		Config:        ManagerConfig{Target: map[string]Config{"test": {Type: "manager_test_catch_up"}}},
+		Resolver:      testResolver(),
+		SyncSource:    source,
+		SyncHeight:    10,
+		SyncBatchSize: 2,
+	})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	expected := []appdata.Packet{
+		appdata.StartBlockData{Height: 10},
+		appdata.ModuleInitializationData{ModuleName: "one", Schema: testModuleSchema},
+		appdata.ObjectUpdateData{ModuleName: "one", Updates: []schema.ObjectUpdate{
+			{TypeName: "item", Key: "a", Value: "1"},
+			{TypeName: "item", Key: "b", Value: "2"},
+		}},
+		appdata.ObjectUpdateData{ModuleName: "one", Updates: []schema.ObjectUpdate{
+			{TypeName: "item", Key: "c", Value: "3"},
+		}},
+		appdata.ModuleInitializationData{ModuleName: "two", Schema: testModuleSchema},
+		appdata.ObjectUpdateData{ModuleName: "two", Updates: []schema.ObjectUpdate{
+			{TypeName: "item", Key: "d", Value: "4"},
+		}},
+		appdata.CommitData{},
+	}
+	if !reflect.DeepEqual(*packets, expected) {
+		t.Fatalf("expected %v, got %v", expected, *packets)
+	}
+	*packets = nil
+
+	// the data of the synced block is dropped
+	sendTestBlock(t, listener, 10, "one", "a", "5")
+	if len(*packets) != 0 {
+		t.Fatalf("expected no packets, got %v", *packets)
+	}
+
+	// live data is forwarded from the next block on, without initializing modules again
+	sendTestBlock(t, listener, 11, "one", "a", "6")
+	expected = []appdata.Packet{
+		appdata.StartBlockData{Height: 11},
+		testKVPairData("one", "a", "6"),
+		appdata.ObjectUpdateData{ModuleName: "one", Updates: []schema.ObjectUpdate{
+			{TypeName: "item", Key: "a", Value: "6"},
+		}},
+		appdata.CommitData{},
+	}
+	if !reflect.DeepEqual(*packets, expected) {
+		t.Fatalf("expected %v, got %v", expected, *packets)
+	}
+}
+
+func TestStartManager_resume(t *testing.T) {
+	registerTestIndexer(t, "manager_test_resume", 5)
+
+	start := func() appdata.Listener {
+		listener, err := StartManager(ManagerOptions{
+			Config:     ManagerConfig{Target: map[string]Config{"test": {Type: "manager_test_resume"}}},
+			Resolver:   testResolver(),
+			SyncSource: testSyncSource{},
+			SyncHeight: 10,
+		})
+		if err != nil {
+			t.Fatal("unexpected error", err)
+		}
+		return listener
+	}
+
+	listener := start()
+	for height := uint64(4); height <= 7; height++ {
+		if err := listener.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+	}
+
+	listener = start()
+	err := listener.StartBlock(appdata.StartBlockData{Height: 7})
+	if err == nil || !strings.Contains(err.Error(), "lost") {
+		t.Fatalf("expected lost data error, got %v", err)
+	}
+}
+
+func TestStartManager_rollback(t *testing.T) {
+	packets := &[]appdata.Packet{}
+	view := &testView{blockNum: 12}
+	var rollbacks []uint64
+	Register("manager_test_rollback", func(params InitParams) (InitResult, error) {
+		return InitResult{
+			Listener: appdata.PacketForwarder(func(packet appdata.Packet) error {
+				*packets = append(*packets, packet)
+				return nil
+			}),
+			View: view,
+			Rollback: func(height uint64) error {
+				rollbacks = append(rollbacks, height)
+				if view.blockNum > height {
+					view.blockNum = height
+				}
+				return nil
+			},
+		}, nil
+	})
+
+	listener, err := StartManager(ManagerOptions{
+		Config:          ManagerConfig{Target: map[string]Config{"test": {Type: "manager_test_rollback"}}},
+		Resolver:        testResolver(),
+		CommittedHeight: 10,
+	})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if !reflect.DeepEqual(rollbacks, []uint64{10}) {
+		t.Fatalf("expected a rollback to block 10, got %v", rollbacks)
+	}
+
+	// the blocks which were rolled back are indexed again
+	sendTestBlock(t, listener, 10, "one", "a", "1")
+	sendTestBlock(t, listener, 11, "one", "a", "2")
+	var heights []uint64
+	for _, packet := range *packets {
+		if startBlock, ok := packet.(appdata.StartBlockData); ok {
+			heights = append(heights, startBlock.Height)
+		}
+	}
+	if !reflect.DeepEqual(heights, []uint64{11}) {
+		t.Fatalf("expected live data to start at block 11, got blocks %v", heights)
+	}
+
+	// indexers which are ahead of the chain and cannot be rolled back fail to start
+	registerTestIndexer(t, "manager_test_no_rollback", 12)
+	_, err = StartManager(ManagerOptions{
+		Config:          ManagerConfig{Target: map[string]Config{"test": {Type: "manager_test_no_rollback"}}},
+		Resolver:        testResolver(),
+		CommittedHeight: 10,
+	})
+	if err == nil || !strings.Contains(err.Error(), "cannot be rolled back") {
+		t.Fatalf("expected rollback error, got %v", err)
+	}
+}
+
+func TestStartManager_filter(t *testing.T) {
+	packets := registerTestIndexer(t, "manager_test_filter", 0)
+
+	listener, err := StartManager(ManagerOptions{
+		Config: map[string]interface{}{
+			"target": map[string]interface{}{
+				"test": map[string]interface{}{
+					"type":            "manager_test_filter",
+					"include_modules": []string{"two"},
+					"exclude_txs":     true,
+				},
+			},
+		},
+		Resolver:   testResolver(),
+		SyncSource: testSyncSource{"one": {"a": "1"}, "two": {"b": "2"}},
+		SyncHeight: 1,
+	})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if err := listener.StartBlock(appdata.StartBlockData{Height: 2}); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if listener.OnTx != nil {
+		t.Fatalf("expected txs to be excluded")
+	}
+
+	expected := []appdata.Packet{
+		appdata.StartBlockData{Height: 1},
+		appdata.ModuleInitializationData{ModuleName: "two", Schema: testModuleSchema},
+		appdata.ObjectUpdateData{ModuleName: "two", Updates: []schema.ObjectUpdate{
+			{TypeName: "item", Key: "b", Value: "2"},
+		}},
+		appdata.CommitData{},
+		appdata.StartBlockData{Height: 2},
+	}
+	if !reflect.DeepEqual(*packets, expected) {
+		t.Fatalf("expected %v, got %v", expected, *packets)
+	}
+}
+
+func TestStartManager_errors(t *testing.T) {
+	_, err := StartManager(ManagerOptions{
+		Config:   ManagerConfig{Target: map[string]Config{"test": {Type: "manager_test_unknown"}}},
+		Resolver: testResolver(),
+	})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected indexer type not found error, got %v", err)
+	}
+
+	registerTestIndexer(t, "manager_test_errors", 0)
+	_, err = StartManager(ManagerOptions{
+		Config: ManagerConfig{Target: map[string]Config{"test": {
+			Type:           "manager_test_errors",
+			IncludeModules: []string{"one"},
+			ExcludeModules: []string{"two"},
+		}}},
+		Resolver: testResolver(),
+	})
+	if err == nil {
+		t.Fatalf("expected module filter error")
+	}
+
+	_, err = StartManager(ManagerOptions{})
+	if err == nil {
+		t.Fatalf("expected missing resolver error")
+	}
+}
+
+// registerTestIndexer registers an indexer type recording all the packets it receives and whose view
+// reports blockNum.
+func registerTestIndexer(t *testing.T, indexerType string, blockNum uint64) *[]appdata.Packet {
+	t.Helper()
+	packets := &[]appdata.Packet{}
+	Register(indexerType, func(params InitParams) (InitResult, error) {
+		return InitResult{
+			Listener: appdata.PacketForwarder(func(packet appdata.Packet) error {
+				*packets = append(*packets, packet)
+				return nil
+			}),
+			View: &testView{blockNum: blockNum},
+		}, nil
+	})
+	return packets
+}
+
+func sendTestBlock(t *testing.T, listener appdata.Listener, height uint64, moduleName, key, value string) {
+	t.Helper()
+	if err := listener.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if err := listener.OnKVPair(testKVPairData(moduleName, key, value)); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	cb, err := listener.Commit(appdata.CommitData{})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if cb != nil {
+		if err := cb(); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+	}
+}
+
+func testKVPairData(moduleName, key, value string) appdata.KVPairData {
+	return appdata.KVPairData{Updates: []appdata.ActorKVPairUpdate{{
+		Actor:        []byte(moduleName),
+		StateChanges: []schema.KVPairUpdate{{Key: []byte(key), Value: []byte(value)}},
+	}}}
+}
+
+var testModuleSchema = func() schema.ModuleSchema {
+	s, err := schema.NewModuleSchema([]schema.ObjectType{{
+		Name:        "item",
+		KeyFields:   []schema.Field{{Name: "key", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+	}})
+	if err != nil {
+		panic(err)
+	}
+	return s
+}()
+
+// testModule is a module whose state maps string keys to string values.
+type testModule struct{}
+
+func (testModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: testModuleSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			return []schema.ObjectUpdate{{TypeName: "item", Key: string(update.Key), Value: string(update.Value)}}, nil
+		},
+	}, nil
+}
+
+func testResolver() decoding.DecoderResolver {
+	return decoding.ModuleSetDecoderResolver(map[string]interface{}{
+		"one": testModule{},
+		"two": testModule{},
+	})
+}
+
+// testSyncSource is the state of modules by module name.
+type testSyncSource map[string]map[string]string
+
+func (s testSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	state, ok := s[moduleName]
+	if !ok {
+		return fmt.Errorf("don't have state for module %s", moduleName)
+	}
+
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := fn([]byte(key), []byte(state[key])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type testView struct {
+	blockNum uint64
+}
+
+func (v *testView) BlockNum() (uint64, error) { return v.blockNum, nil }
+
+func (v *testView) AppState() view.AppState { return nil }
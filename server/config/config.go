@@ -150,6 +150,10 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotMaxDeltas sets the number of incremental snapshots taken on top of each
+	// full snapshot. 0 disables incremental snapshots.
+	SnapshotMaxDeltas uint32 `mapstructure:"snapshot-max-deltas"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
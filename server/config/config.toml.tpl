@@ -186,6 +186,12 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-max-deltas specifies the number of incremental snapshots taken on top of each full
+# snapshot, which only contain the changes since the previous snapshot (0 to disable). They
+# require the heights since the previous snapshot not to be pruned, and are not served to
+# state syncing nodes.
+snapshot-max-deltas = {{ .StateSync.SnapshotMaxDeltas }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -43,6 +43,13 @@ application.
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
 
+			// rollback the indexers to the new last committed height
+			if rollbacker, ok := any(app).(indexerRollbacker); ok {
+				if err := rollbacker.RollbackIndexer(); err != nil {
+					return fmt.Errorf("failed to rollback indexer: %w", err)
+				}
+			}
+
 			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
 			return nil
 		},
@@ -51,3 +58,9 @@ application.
 	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
 	return cmd
 }
+
+// indexerRollbacker is implemented by apps which can roll back their indexers to the last committed
+// height, such as apps embedding BaseApp.
+type indexerRollbacker interface {
+	RollbackIndexer() error
+}
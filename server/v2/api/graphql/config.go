@@ -0,0 +1,59 @@
+package graphql
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:             false,
+		Address:            "localhost:8080",
+		Path:               "/graphql",
+		DefaultPageSize:    100,
+		MaxPageSize:        1000,
+		MaxRequestSize:     1024 * 1024,
+		MaxQueryDepth:      15,
+		MaxQueryComplexity: 10000,
+	}
+}
+
+// Config defines configuration for the GraphQL server.
+type Config struct {
+	// Enable defines if the GraphQL server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the GraphQL server should be enabled."`
+
+	// Address defines the GraphQL server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the GraphQL server address to bind to."`
+
+	// Path defines the HTTP path at which GraphQL queries are served.
+	Path string `mapstructure:"path" toml:"path" comment:"Path defines the HTTP path at which GraphQL queries are served."`
+
+	// DefaultPageSize defines the number of objects returned by list queries when first is not set.
+	DefaultPageSize int `mapstructure:"default-page-size" toml:"default-page-size" comment:"DefaultPageSize defines the number of objects returned by list queries when first is not set."`
+
+	// MaxPageSize defines the maximum number of objects which can be requested at once by list queries.
+	MaxPageSize int `mapstructure:"max-page-size" toml:"max-page-size" comment:"MaxPageSize defines the maximum number of objects which can be requested at once by list queries."`
+
+	// MaxRequestSize defines the maximum size in bytes of the body of a request.
+	MaxRequestSize int64 `mapstructure:"max-request-size" toml:"max-request-size" comment:"MaxRequestSize defines the maximum size in bytes of the body of a request."`
+
+	// MaxQueryDepth defines the maximum nesting depth of the fields selected by a query.
+	MaxQueryDepth int `mapstructure:"max-query-depth" toml:"max-query-depth" comment:"MaxQueryDepth defines the maximum nesting depth of the fields selected by a query."`
+
+	// MaxQueryComplexity defines the maximum complexity of a query, which is the number of fields it selects,
+	// the fields selected in a list query counting once for every object of the requested page.
+	MaxQueryComplexity int `mapstructure:"max-query-complexity" toml:"max-query-complexity" comment:"MaxQueryComplexity defines the maximum complexity of a query, which is the number of fields it selects, the fields selected in a list query counting once for every object of the requested page."`
+}
+
+// CfgOption is a function that allows to overwrite the default server configuration.
+type CfgOption func(*Config)
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
+
+// Enable the GraphQL server (default disabled).
+func Enable() CfgOption {
+	return func(cfg *Config) {
+		cfg.Enable = true
+	}
+}
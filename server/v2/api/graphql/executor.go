@@ -0,0 +1,248 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// request is a GraphQL request as sent over HTTP.
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// execute executes a GraphQL request against the schema. The query is rejected before any field is
+// resolved if it is invalid or exceeds the limits of the config.
+func execute(ctx context.Context, s *graphql.Schema, config *Config, req request) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{
+		Body: []byte(req.Query),
+		Name: "GraphQL request",
+	})})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	validation := graphql.ValidateDocument(s, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	variables, _ := normalizeNumbers(req.Variables).(map[string]any)
+	if err := checkLimits(s, config, doc, req.OperationName, variables); err != nil {
+		return errorResponse(err)
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        *s,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          variables,
+		Context:       ctx,
+	})
+}
+
+func errorResponse(err error) *graphql.Result {
+	return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+}
+
+// normalizeNumbers converts the json.Number values of variables decoded with json.Decoder.UseNumber to
+// integers when possible, so that integers which don't fit in a float64 are kept exact.
+func normalizeNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return u
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		res := make([]any, len(v))
+		for i, item := range v {
+			res[i] = normalizeNumbers(item)
+		}
+		return res
+	case map[string]any:
+		res := make(map[string]any, len(v))
+		for k, item := range v {
+			res[k] = normalizeNumbers(item)
+		}
+		return res
+	default:
+		return value
+	}
+}
+
+// limitChecker computes the depth and complexity of a validated query.
+type limitChecker struct {
+	schema    *graphql.Schema
+	config    *Config
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+}
+
+// checkLimits returns an error if the fields of the operation are nested deeper than MaxQueryDepth or if
+// its complexity exceeds MaxQueryComplexity.
+func checkLimits(s *graphql.Schema, config *Config, doc *ast.Document, operationName string, variables map[string]any) error {
+	c := &limitChecker{
+		schema:    s,
+		config:    config,
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+	}
+
+	var op *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			c.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				op = def
+			}
+		}
+	}
+	if op == nil {
+		// unknown operations are reported when the query is executed
+		return nil
+	}
+
+	_, err := c.complexity(s.QueryType(), []*ast.SelectionSet{op.SelectionSet}, 1)
+	return err
+}
+
+// complexity returns the complexity of the selections on the object type, at the given depth. Each field
+// counts once, except that the fields selected in a list query count once for every object of the
+// requested page.
+func (c *limitChecker) complexity(obj *graphql.Object, selectionSets []*ast.SelectionSet, depth int) (int, error) {
+	if depth > c.config.MaxQueryDepth {
+		return 0, fmt.Errorf("query exceeds the maximum depth of %d", c.config.MaxQueryDepth)
+	}
+
+	var (
+		keys   []string
+		groups = map[string][]*ast.Field{}
+	)
+	for _, set := range selectionSets {
+		c.collectFields(obj, set, func(key string, f *ast.Field) {
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], f)
+		})
+	}
+
+	total := 0
+	for _, key := range keys {
+		total++
+
+		sel := groups[key][0]
+		def := c.fieldDef(obj, sel.Name.Value)
+		if def == nil {
+			continue
+		}
+
+		if sub, ok := graphql.GetNamed(def.Type).(*graphql.Object); ok {
+			var subSelections []*ast.SelectionSet
+			for _, f := range groups[key] {
+				if f.SelectionSet != nil {
+					subSelections = append(subSelections, f.SelectionSet)
+				}
+			}
+
+			subTotal, err := c.complexity(sub, subSelections, depth+1)
+			if err != nil {
+				return 0, err
+			}
+			total += c.pageSize(def, sel) * subTotal
+		}
+
+		if total > c.config.MaxQueryComplexity {
+			return 0, fmt.Errorf("query exceeds the maximum complexity of %d", c.config.MaxQueryComplexity)
+		}
+	}
+	return total, nil
+}
+
+// collectFields calls f with the response key of each field selected on the object type, expanding
+// fragments. Fragment cycles are rejected by the validation of the query.
+func (c *limitChecker) collectFields(obj *graphql.Object, set *ast.SelectionSet, f func(string, *ast.Field)) {
+	for _, sel := range set.Selections {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			key := sel.Name.Value
+			if sel.Alias != nil {
+				key = sel.Alias.Value
+			}
+			f(key, sel)
+		case *ast.FragmentSpread:
+			frag, ok := c.fragments[sel.Name.Value]
+			if ok && frag.TypeCondition.Name.Value == obj.Name() {
+				c.collectFields(obj, frag.SelectionSet, f)
+			}
+		case *ast.InlineFragment:
+			if sel.TypeCondition == nil || sel.TypeCondition.Name.Value == obj.Name() {
+				c.collectFields(obj, sel.SelectionSet, f)
+			}
+		}
+	}
+}
+
+// fieldDef returns the definition of the field of the object type, including the introspection fields
+// of the query type.
+func (c *limitChecker) fieldDef(obj *graphql.Object, name string) *graphql.FieldDefinition {
+	if obj == c.schema.QueryType() {
+		switch name {
+		case graphql.SchemaMetaFieldDef.Name:
+			return graphql.SchemaMetaFieldDef
+		case graphql.TypeMetaFieldDef.Name:
+			return graphql.TypeMetaFieldDef
+		}
+	}
+	return obj.Fields()[name]
+}
+
+// pageSize returns the number of objects a list query may return, or 1 for the other fields. Invalid page
+// sizes are reported when the field is executed.
+func (c *limitChecker) pageSize(def *graphql.FieldDefinition, sel *ast.Field) int {
+	paginated := false
+	for _, arg := range def.Args {
+		if arg.Name() == "first" {
+			paginated = true
+			break
+		}
+	}
+	if !paginated {
+		return 1
+	}
+
+	first := c.config.DefaultPageSize
+	for _, arg := range sel.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+
+		var value any
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			value = v.Value
+		case *ast.Variable:
+			value = c.variables[v.Name.Value]
+		}
+		if n, err := strconv.Atoi(fmt.Sprint(value)); err == nil {
+			first = n
+		}
+	}
+	return min(max(first, 0), c.config.MaxPageSize)
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+// handler serves GraphQL requests over HTTP. Queries can be sent either with a POST request whose body is
+// a JSON object with the query, operationName and variables keys, or with a GET request with the same
+// keys as URL query parameters, variables being JSON encoded.
+type handler struct {
+	schema *graphql.Schema
+	config *Config
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := decodeJSON(strings.NewReader(variables), &req.Variables); err != nil {
+				writeResponse(w, http.StatusBadRequest, errorResponse(fmt.Errorf("invalid variables: %w", err)))
+				return
+			}
+		}
+	case http.MethodPost:
+		r.Body = http.MaxBytesReader(w, r.Body, h.config.MaxRequestSize)
+		if err := decodeJSON(r.Body, &req); err != nil {
+			writeResponse(w, http.StatusBadRequest, errorResponse(fmt.Errorf("invalid request body: %w", err)))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeResponse(w, http.StatusMethodNotAllowed, errorResponse(fmt.Errorf("method %s is not allowed", r.Method)))
+		return
+	}
+
+	if req.Query == "" {
+		writeResponse(w, http.StatusBadRequest, errorResponse(fmt.Errorf("query is required")))
+		return
+	}
+
+	writeResponse(w, http.StatusOK, execute(r.Context(), h.schema, h.config, req))
+}
+
+func decodeJSON(r io.Reader, v any) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func writeResponse(w http.ResponseWriter, status int, res *graphql.Result) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		status = http.StatusInternalServerError
+		bz, _ = json.Marshal(errorResponse(err))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(bz)
+}
@@ -0,0 +1,50 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// newStringScalar creates a custom scalar type whose values are represented as strings in queries,
+// and optionally as integer numbers as well. Values are resolved to their JSON representation by the
+// schema, so they are serialized as is.
+func newStringScalar(name, desc string, acceptInt bool) *graphql.Scalar {
+	return graphql.NewScalar(graphql.ScalarConfig{
+		Name:        name,
+		Description: desc,
+		Serialize: func(value any) any {
+			return value
+		},
+		ParseValue: func(value any) any {
+			switch v := value.(type) {
+			case string:
+				return v
+			case int, int64, uint64:
+				if acceptInt {
+					return fmt.Sprint(v)
+				}
+			}
+			return nil
+		},
+		ParseLiteral: func(value ast.Value) any {
+			switch v := value.(type) {
+			case *ast.StringValue:
+				return v.Value
+			case *ast.IntValue:
+				if !acceptInt {
+					return nil
+				}
+				if _, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+					return v.Value
+				}
+				if _, err := strconv.ParseUint(v.Value, 10, 64); err == nil {
+					return v.Value
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,404 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+)
+
+// schemaBuilder generates the GraphQL schema of an app from the module schemas of its app data.
+//
+// The query type has a field for each module, whose type has the following fields for each object type:
+//   - <object>, which looks up an object by its key fields passed as arguments, or returns the object
+//     itself if the object type is a singleton
+//   - <object>_list, which iterates over the objects with cursor pagination, except for singletons
+type schemaBuilder struct {
+	appData      view.AppData
+	addressCodec addressutil.AddressCodec
+	config       *Config
+
+	pageInfo *graphql.Object
+}
+
+func buildSchema(appData view.AppData, addressCodec addressutil.AddressCodec, config *Config) (graphql.Schema, error) {
+	b := &schemaBuilder{
+		appData:      appData,
+		addressCodec: addressCodec,
+		config:       config,
+		pageInfo: graphql.NewObject(graphql.ObjectConfig{
+			Name:        "PageInfo",
+			Description: "Information about pagination in a connection.",
+			Fields: graphql.Fields{
+				"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+				"endCursor":   &graphql.Field{Type: graphql.String},
+			},
+		}),
+	}
+
+	fields := graphql.Fields{
+		"block_num": &graphql.Field{
+			Description: "The last block which was persisted.",
+			Type:        graphql.NewNonNull(kindScalars[schema.Uint64Kind]),
+			Resolve: func(graphql.ResolveParams) (any, error) {
+				blockNum, err := appData.BlockNum()
+				if err != nil {
+					return nil, err
+				}
+				return encodeValue(schema.Uint64Kind, blockNum, addressCodec)
+			},
+		},
+	}
+
+	if appState := appData.AppState(); appState != nil {
+		var err error
+		appState.Modules(func(modState view.ModuleState, modErr error) bool {
+			if modErr != nil {
+				err = modErr
+				return false
+			}
+
+			var f *graphql.Field
+			f, err = b.moduleField(modState)
+			if err != nil {
+				err = fmt.Errorf("module %s: %w", modState.ModuleName(), err)
+				return false
+			}
+			fields[modState.ModuleName()] = f
+			return true
+		})
+		if err != nil {
+			return graphql.Schema{}, err
+		}
+	}
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: fields}),
+	})
+}
+
+func (b *schemaBuilder) moduleField(modState view.ModuleState) (*graphql.Field, error) {
+	moduleName := modState.ModuleName()
+	modSchema := modState.ModuleSchema()
+
+	enums := map[string]*graphql.Enum{}
+	modSchema.EnumTypes(func(enum schema.EnumType) bool {
+		values := graphql.EnumValueConfigMap{}
+		for _, v := range enum.Values {
+			values[v] = &graphql.EnumValueConfig{Value: v}
+		}
+		enums[enum.Name] = graphql.NewEnum(graphql.EnumConfig{
+			Name:   fmt.Sprintf("%s_%s", moduleName, enum.Name),
+			Values: values,
+		})
+		return true
+	})
+
+	fields := graphql.Fields{}
+	var err error
+	modSchema.ObjectTypes(func(objType schema.ObjectType) bool {
+		err = b.addObjectFields(fields, moduleName, objType, enums)
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name:        fmt.Sprintf("Module_%s", moduleName),
+			Description: fmt.Sprintf("The state of module %s.", moduleName),
+			Fields:      fields,
+		})),
+		Resolve: func(graphql.ResolveParams) (any, error) {
+			return modState, nil
+		},
+	}, nil
+}
+
+// addObjectFields adds the fields of the object type to the fields of a module type.
+func (b *schemaBuilder) addObjectFields(
+	fields graphql.Fields,
+	moduleName string,
+	objType schema.ObjectType,
+	enums map[string]*graphql.Enum,
+) error {
+	typeName := fmt.Sprintf("%s_%s", moduleName, objType.Name)
+	objFields := graphql.Fields{}
+
+	keyArgs := graphql.FieldConfigArgument{}
+	for _, f := range objType.KeyFields {
+		typ, err := b.fieldType(f, enums)
+		if err != nil {
+			return err
+		}
+		objFields[f.Name] = &graphql.Field{Type: graphql.NewNonNull(typ)}
+		keyArgs[f.Name] = &graphql.ArgumentConfig{Type: graphql.NewNonNull(typ)}
+	}
+
+	for _, f := range objType.ValueFields {
+		typ, err := b.fieldType(f, enums)
+		if err != nil {
+			return err
+		}
+		if !f.Nullable {
+			typ = graphql.NewNonNull(typ)
+		}
+		objFields[f.Name] = &graphql.Field{Type: typ}
+	}
+
+	if objType.RetainDeletions {
+		objFields["_deleted"] = &graphql.Field{
+			Description: "Whether the object was deleted.",
+			Type:        graphql.NewNonNull(graphql.Boolean),
+		}
+	}
+
+	obj := graphql.NewObject(graphql.ObjectConfig{Name: typeName, Fields: objFields})
+
+	if len(objType.KeyFields) == 0 {
+		fields[objType.Name] = &graphql.Field{
+			Type: obj,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				coll, err := p.Source.(view.ModuleState).GetObjectCollection(objType.Name)
+				if err != nil || coll == nil {
+					return nil, err
+				}
+
+				var res any
+				coll.AllState(func(update schema.ObjectUpdate, iterErr error) bool {
+					if iterErr != nil {
+						err = iterErr
+						return false
+					}
+					res, err = b.objectValue(objType, update)
+					return false
+				})
+				return res, err
+			},
+		}
+		return nil
+	}
+
+	fields[objType.Name] = &graphql.Field{
+		Type: obj,
+		Args: keyArgs,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			coll, err := p.Source.(view.ModuleState).GetObjectCollection(objType.Name)
+			if err != nil || coll == nil {
+				return nil, err
+			}
+
+			key, err := b.objectKey(objType, p.Args)
+			if err != nil {
+				return nil, err
+			}
+
+			update, found, err := coll.GetObject(key)
+			if err != nil || !found {
+				return nil, err
+			}
+			return b.objectValue(objType, update)
+		},
+	}
+
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s_Edge", typeName),
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(obj)},
+		},
+	})
+	connection := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s_Connection", typeName),
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge)))},
+			"nodes":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(obj)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(b.pageInfo)},
+			"totalCount": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					coll, ok := p.Source.(map[string]any)["collection"].(view.ObjectCollection)
+					if !ok {
+						return 0, nil
+					}
+					return coll.Len()
+				},
+			},
+		},
+	})
+	fields[fmt.Sprintf("%s_list", objType.Name)] = &graphql.Field{
+		Type: graphql.NewNonNull(connection),
+		Args: graphql.FieldConfigArgument{
+			"first": &graphql.ArgumentConfig{Type: graphql.Int, Description: "The maximum number of objects to return."},
+			"after": &graphql.ArgumentConfig{Type: graphql.String, Description: "The cursor after which to start returning objects."},
+		},
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			coll, err := p.Source.(view.ModuleState).GetObjectCollection(objType.Name)
+			if err != nil {
+				return nil, err
+			}
+			return b.connection(objType, coll, p.Args)
+		},
+	}
+
+	return nil
+}
+
+func (b *schemaBuilder) fieldType(f schema.Field, enums map[string]*graphql.Enum) (graphql.Output, error) {
+	if f.Kind == schema.EnumKind {
+		enum, ok := enums[f.EnumType.Name]
+		if !ok {
+			return nil, fmt.Errorf("enum type %s of field %s not found", f.EnumType.Name, f.Name)
+		}
+		return enum, nil
+	}
+	return scalarForKind(f.Kind)
+}
+
+// objectKey converts the key arguments of a lookup to the key of the object type.
+func (b *schemaBuilder) objectKey(objType schema.ObjectType, args map[string]any) (any, error) {
+	keys := make([]any, len(objType.KeyFields))
+	for i, f := range objType.KeyFields {
+		var err error
+		keys[i], err = decodeValue(f, args[f.Name], b.addressCodec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(keys) == 1 {
+		return keys[0], nil
+	}
+	return keys, nil
+}
+
+// objectValue converts an object update of the object type to a map of the JSON values of its fields.
+func (b *schemaBuilder) objectValue(objType schema.ObjectType, update schema.ObjectUpdate) (map[string]any, error) {
+	res := map[string]any{"_deleted": update.Delete}
+
+	err := b.setFieldValues(res, objType.KeyFields, update.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.setFieldValues(res, objType.ValueFields, update.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (b *schemaBuilder) setFieldValues(res map[string]any, fields []schema.Field, value any) error {
+	var values []any
+	switch len(fields) {
+	case 0:
+		return nil
+	case 1:
+		values = []any{value}
+	default:
+		var ok bool
+		values, ok = value.([]any)
+		if !ok || len(values) != len(fields) {
+			return fmt.Errorf("expected %d values for fields, got %v", len(fields), value)
+		}
+	}
+
+	for i, f := range fields {
+		v, err := encodeValue(f.Kind, values[i], b.addressCodec)
+		if err != nil {
+			return fmt.Errorf("invalid value for field %s: %w", f.Name, err)
+		}
+		res[f.Name] = v
+	}
+	return nil
+}
+
+// connection returns a page of the objects of the collection.
+func (b *schemaBuilder) connection(objType schema.ObjectType, coll view.ObjectCollection, args map[string]any) (any, error) {
+	first := b.config.DefaultPageSize
+	if v, ok := args["first"]; ok {
+		first = v.(int)
+	}
+	if first < 0 || first > b.config.MaxPageSize {
+		return nil, fmt.Errorf("first must be between 0 and %d", b.config.MaxPageSize)
+	}
+
+	start := 0
+	if after, ok := args["after"]; ok {
+		offset, err := decodeCursor(after.(string))
+		if err != nil {
+			return nil, err
+		}
+		start = offset + 1
+	}
+
+	res := map[string]any{"collection": coll}
+	edges, nodes := []any{}, []any{}
+	hasNextPage := false
+	if coll != nil {
+		var err error
+		i := 0
+		coll.AllState(func(update schema.ObjectUpdate, iterErr error) bool {
+			if iterErr != nil {
+				err = iterErr
+				return false
+			}
+
+			defer func() { i++ }()
+			if i < start {
+				return true
+			}
+			if len(edges) == first {
+				hasNextPage = true
+				return false
+			}
+
+			var node map[string]any
+			node, err = b.objectValue(objType, update)
+			if err != nil {
+				return false
+			}
+			edges = append(edges, map[string]any{"cursor": encodeCursor(i), "node": node})
+			nodes = append(nodes, node)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pageInfo := map[string]any{"hasNextPage": hasNextPage, "endCursor": nil}
+	if len(edges) > 0 {
+		pageInfo["endCursor"] = edges[len(edges)-1].(map[string]any)["cursor"]
+	}
+	res["edges"] = edges
+	res["nodes"] = nodes
+	res["pageInfo"] = pageInfo
+	return res, nil
+}
+
+const cursorPrefix = "offset:"
+
+// encodeCursor encodes the position of an object in a collection as an opaque cursor.
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil && strings.HasPrefix(string(bz), cursorPrefix) {
+		offset, err := strconv.Atoi(strings.TrimPrefix(string(bz), cursorPrefix))
+		if err == nil && offset >= 0 {
+			return offset, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid cursor %q", cursor)
+}
@@ -0,0 +1,127 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+var _ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+
+const ServerName = "graphql"
+
+// Server is a read-only GraphQL API over the data of an indexer which implements view.AppData.
+// Its schema is generated in Init from the module schemas of the app data, so the modules must be
+// initialized by the indexer before the server is initialized.
+type Server[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+
+	appData      view.AppData
+	addressCodec addressutil.AddressCodec
+	httpSrv      *http.Server
+}
+
+// New creates a new GraphQL server serving the app data. Addresses are rendered with the address codec,
+// or as hex strings if it is nil.
+func New[T transaction.Tx](appData view.AppData, addressCodec addressutil.AddressCodec, cfgOptions ...CfgOption) *Server[T] {
+	if addressCodec == nil {
+		addressCodec = addressutil.HexAddressCodec{}
+	}
+
+	return &Server[T]{
+		appData:      appData,
+		addressCodec: addressCodec,
+		cfgOptions:   cfgOptions,
+	}
+}
+
+// Init configures the GraphQL server.
+// Note, the caller is responsible for starting the server.
+func (s *Server[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger log.Logger) error {
+	cfg := s.Config().(*Config)
+	if v != nil {
+		if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+
+	schema, err := buildSchema(s.appData, s.addressCodec, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to build schema: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(cfg.Path, &handler{schema: &schema, config: cfg})
+
+	s.httpSrv = &http.Server{Handler: mux}
+	s.config = cfg
+	s.logger = logger.With(log.ModuleKey, s.Name())
+
+	return nil
+}
+
+func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
+	flags.String(FlagAddress, "localhost:8080", "Listen address")
+	return flags
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || *s.config == (Config{}) {
+		cfg := DefaultConfig()
+		// overwrite the default config with the provided options
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+	}
+
+	s.logger.Info("starting GraphQL server...", "address", s.config.Address, "path", s.config.Path)
+	err = s.httpSrv.Serve(listener)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error("failed to start GraphQL server", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	s.logger.Info("stopping GraphQL server...", "address", s.config.Address)
+	return s.httpSrv.Shutdown(ctx)
+}
@@ -0,0 +1,388 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+)
+
+func TestQuery_lookup(t *testing.T) {
+	testQuery(t, `{
+		block_num
+		bank {
+			balance(address: "0x0a0b", denom: "stake") { address denom amount }
+			missing: balance(address: "0x0a0b", denom: "osmo") { amount }
+		}
+	}`, nil, `{"data": {
+		"block_num": "7",
+		"bank": {
+			"balance": {"address": "0x0a0b", "denom": "stake", "amount": "100"},
+			"missing": null
+		}
+	}}`)
+}
+
+func TestQuery_singleton(t *testing.T) {
+	testQuery(t, `{ bank { params { send_enabled max_fee } } }`, nil, `{"data": {
+		"bank": {"params": {"send_enabled": true, "max_fee": "18446744073709551615"}}
+	}}`)
+}
+
+func TestQuery_list(t *testing.T) {
+	testQuery(t, `{ bank { balance_list(first: 2) { totalCount nodes { denom } pageInfo { hasNextPage endCursor } } } }`, nil, `{"data": {
+		"bank": {"balance_list": {
+			"totalCount": 3,
+			"nodes": [{"denom": "atom"}, {"denom": "stake"}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "b2Zmc2V0OjE"}
+		}}
+	}}`)
+
+	testQuery(t, `{ bank { balance_list(first: 2, after: "b2Zmc2V0OjE") { edges { cursor node { denom } } pageInfo { hasNextPage endCursor } } } }`, nil, `{"data": {
+		"bank": {"balance_list": {
+			"edges": [{"cursor": "b2Zmc2V0OjI", "node": {"denom": "stake"}}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "b2Zmc2V0OjI"}
+		}}
+	}}`)
+}
+
+func TestQuery_variablesAndFragments(t *testing.T) {
+	testQuery(t, `
+		query Proposal($id: Uint64!, $withTime: Boolean = false) {
+			gov {
+				proposal(id: $id) {
+					...ProposalFields
+					submit_time @include(if: $withTime)
+					... on gov_proposal { __typename }
+				}
+			}
+		}
+
+		fragment ProposalFields on gov_proposal {
+			id
+			status
+			_deleted
+		}`,
+		map[string]any{"id": 1, "withTime": true},
+		`{"data": {"gov": {"proposal": {
+			"id": "1",
+			"status": "PASSED",
+			"_deleted": true,
+			"submit_time": "2024-01-02T03:04:05.000000006Z",
+			"__typename": "gov_proposal"
+		}}}}`)
+}
+
+func TestQuery_introspection(t *testing.T) {
+	testQuery(t, `{
+		__type(name: "gov_proposal") {
+			kind
+			name
+			fields { name type { kind name ofType { name } } }
+		}
+	}`, nil, `{"data": {"__type": {
+		"kind": "OBJECT",
+		"name": "gov_proposal",
+		"fields": [
+			{"name": "_deleted", "type": {"kind": "NON_NULL", "name": null, "ofType": {"name": "Boolean"}}},
+			{"name": "id", "type": {"kind": "NON_NULL", "name": null, "ofType": {"name": "Uint64"}}},
+			{"name": "status", "type": {"kind": "NON_NULL", "name": null, "ofType": {"name": "gov_proposal_status"}}},
+			{"name": "submit_time", "type": {"kind": "SCALAR", "name": "Time", "ofType": null}}
+		]
+	}}}`)
+
+	res := execute(context.Background(), mustBuildSchema(t), DefaultConfig(), request{Query: `{ __schema { queryType { name } types { name } directives { name } } }`})
+	require.Empty(t, res.Errors)
+}
+
+func TestQuery_errors(t *testing.T) {
+	testQuery(t, `{ bank { balance(denom: "stake") { amount } } }`, nil, `{
+		"data": null,
+		"errors": [{
+			"message": "Field \"balance\" argument \"address\" of type \"Address!\" is required but not provided.",
+			"locations": [{"line": 1, "column": 10}]
+		}]
+	}`)
+
+	testQuery(t, `{ bank { unknown } }`, nil, `{
+		"data": null,
+		"errors": [{
+			"message": "Cannot query field \"unknown\" on type \"Module_bank\".",
+			"locations": [{"line": 1, "column": 10}]
+		}]
+	}`)
+
+	testQuery(t, `{ bank { balance_list(first: 5000) { totalCount } } }`, nil, `{
+		"data": null,
+		"errors": [{
+			"message": "first must be between 0 and 1000",
+			"locations": [{"line": 1, "column": 10}],
+			"path": ["bank", "balance_list"]
+		}]
+	}`)
+
+	testQuery(t, `mutation { bank }`, nil, `{
+		"data": null,
+		"errors": [{"message": "Schema is not configured for mutations", "locations": [{"line": 1, "column": 1}]}]
+	}`)
+}
+
+func TestQuery_limits(t *testing.T) {
+	// deeply nested fields
+	deep := "{ __schema { types { fields { type " + strings.Repeat("{ ofType ", 12) + "{ name }" + strings.Repeat(" }", 16)
+	testQuery(t, deep, nil, `{
+		"data": null,
+		"errors": [{"message": "query exceeds the maximum depth of 15", "locations": []}]
+	}`)
+
+	// recursive fragments
+	testQuery(t, `
+		{ __type(name: "Query") { ...TypeRef } }
+
+		fragment TypeRef on __Type { name ofType { ...TypeRef } }`, nil, `{
+		"data": null,
+		"errors": [{"message": "Cannot spread fragment \"TypeRef\" within itself.", "locations": [{"line": 4, "column": 46}]}]
+	}`)
+
+	// many aliases of large pages
+	testQuery(t, `{ bank {
+		a: balance_list(first: 1000) { nodes { denom amount } }
+		b: balance_list(first: 1000) { nodes { denom amount } }
+		c: balance_list(first: 1000) { nodes { denom amount } }
+		d: balance_list(first: 1000) { nodes { denom amount } }
+	} }`, nil, `{
+		"data": null,
+		"errors": [{"message": "query exceeds the maximum complexity of 10000", "locations": []}]
+	}`)
+
+	// the page size can be set with a variable
+	testQuery(t, `query($first: Int) { bank {
+		a: balance_list(first: $first) { nodes { denom amount } }
+		b: balance_list(first: $first) { nodes { denom amount } }
+		c: balance_list(first: $first) { nodes { denom amount } }
+		d: balance_list(first: $first) { nodes { denom amount } }
+	} }`, map[string]any{"first": 1000}, `{
+		"data": null,
+		"errors": [{"message": "query exceeds the maximum complexity of 10000", "locations": []}]
+	}`)
+
+	// queries within the limits are executed
+	testQuery(t, `{ bank {
+		a: balance_list(first: 1000) { nodes { denom } }
+		b: balance_list(first: 1000) { nodes { denom } }
+	} }`, nil, `{"data": {"bank": {
+		"a": {"nodes": [{"denom": "atom"}, {"denom": "stake"}, {"denom": "stake"}]},
+		"b": {"nodes": [{"denom": "atom"}, {"denom": "stake"}, {"denom": "stake"}]}
+	}}}`)
+}
+
+func TestHandler(t *testing.T) {
+	h := &handler{schema: mustBuildSchema(t), config: DefaultConfig()}
+	query := `query($denom: String!) { bank { balance(address: "0x0a0b", denom: $denom) { amount } } }`
+	expected := `{"data": {"bank": {"balance": {"amount": "100"}}}}`
+
+	body, err := json.Marshal(request{Query: query, Variables: map[string]any{"denom": "stake"}})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, expected, rec.Body.String())
+
+	params := url.Values{"query": {query}, "variables": {`{"denom": "stake"}`}}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?"+params.Encode(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, expected, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/graphql", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestServer_Config(t *testing.T) {
+	s := New[transaction.Tx](testAppData(), nil, Enable())
+	require.True(t, s.Config().(*Config).Enable)
+
+	// an empty config is replaced by the default one
+	s.config = &Config{}
+	require.Equal(t, s.Config(), func() *Config {
+		cfg := DefaultConfig()
+		cfg.Enable = true
+		return cfg
+	}())
+}
+
+func testQuery(t *testing.T, query string, variables map[string]any, expected string) {
+	t.Helper()
+	res := execute(context.Background(), mustBuildSchema(t), DefaultConfig(), request{Query: query, Variables: variables})
+	bz, err := json.Marshal(res)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(bz))
+}
+
+func mustBuildSchema(t *testing.T) *graphql.Schema {
+	t.Helper()
+	s, err := buildSchema(testAppData(), addressutil.HexAddressCodec{}, DefaultConfig())
+	require.NoError(t, err)
+	return &s
+}
+
+func testAppData() view.AppData {
+	bankSchema, err := schema.NewModuleSchema([]schema.ObjectType{
+		{
+			Name: "balance",
+			KeyFields: []schema.Field{
+				{Name: "address", Kind: schema.AddressKind},
+				{Name: "denom", Kind: schema.StringKind},
+			},
+			ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerStringKind}},
+		},
+		{
+			Name: "params",
+			ValueFields: []schema.Field{
+				{Name: "send_enabled", Kind: schema.BoolKind},
+				{Name: "max_fee", Kind: schema.Uint64Kind},
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	govSchema, err := schema.NewModuleSchema([]schema.ObjectType{{
+		Name:      "proposal",
+		KeyFields: []schema.Field{{Name: "id", Kind: schema.Uint64Kind}},
+		ValueFields: []schema.Field{
+			{Name: "status", Kind: schema.EnumKind, EnumType: schema.EnumType{Name: "proposal_status", Values: []string{"PENDING", "PASSED"}}},
+			{Name: "submit_time", Kind: schema.TimeKind, Nullable: true},
+		},
+		RetainDeletions: true,
+	}})
+	if err != nil {
+		panic(err)
+	}
+
+	return &memAppData{
+		blockNum: 7,
+		modules: []*memModule{
+			{
+				name:   "bank",
+				schema: bankSchema,
+				objects: map[string][]schema.ObjectUpdate{
+					"balance": {
+						{TypeName: "balance", Key: []any{[]byte{0x0a, 0x0b}, "atom"}, Value: "5"},
+						{TypeName: "balance", Key: []any{[]byte{0x0a, 0x0b}, "stake"}, Value: "100"},
+						{TypeName: "balance", Key: []any{[]byte{0x0c}, "stake"}, Value: "3"},
+					},
+					"params": {
+						{TypeName: "params", Value: []any{true, uint64(18446744073709551615)}},
+					},
+				},
+			},
+			{
+				name:   "gov",
+				schema: govSchema,
+				objects: map[string][]schema.ObjectUpdate{
+					"proposal": {
+						{TypeName: "proposal", Key: uint64(1), Value: []any{"PASSED", time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)}, Delete: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// memAppData is an in-memory view.AppData.
+type memAppData struct {
+	blockNum uint64
+	modules  []*memModule
+}
+
+func (a *memAppData) BlockNum() (uint64, error) { return a.blockNum, nil }
+
+func (a *memAppData) AppState() view.AppState { return a }
+
+func (a *memAppData) GetModule(moduleName string) (view.ModuleState, error) {
+	for _, m := range a.modules {
+		if m.name == moduleName {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+func (a *memAppData) Modules(f func(modState view.ModuleState, err error) bool) {
+	for _, m := range a.modules {
+		if !f(m, nil) {
+			return
+		}
+	}
+}
+
+func (a *memAppData) NumModules() (int, error) { return len(a.modules), nil }
+
+type memModule struct {
+	name    string
+	schema  schema.ModuleSchema
+	objects map[string][]schema.ObjectUpdate
+}
+
+func (m *memModule) ModuleName() string { return m.name }
+
+func (m *memModule) ModuleSchema() schema.ModuleSchema { return m.schema }
+
+func (m *memModule) GetObjectCollection(objectType string) (view.ObjectCollection, error) {
+	typ, ok := m.schema.LookupType(objectType)
+	if !ok {
+		return nil, nil
+	}
+	return &memCollection{typ: typ.(schema.ObjectType), updates: m.objects[objectType]}, nil
+}
+
+func (m *memModule) ObjectCollections(f func(value view.ObjectCollection, err error) bool) {
+	m.schema.ObjectTypes(func(typ schema.ObjectType) bool {
+		return f(&memCollection{typ: typ, updates: m.objects[typ.Name]}, nil)
+	})
+}
+
+func (m *memModule) NumObjectCollections() (int, error) { return len(m.objects), nil }
+
+type memCollection struct {
+	typ     schema.ObjectType
+	updates []schema.ObjectUpdate
+}
+
+func (c *memCollection) ObjectType() schema.ObjectType { return c.typ }
+
+func (c *memCollection) GetObject(key any) (schema.ObjectUpdate, bool, error) {
+	for _, update := range c.updates {
+		if fmt.Sprint(update.Key) == fmt.Sprint(key) {
+			return update, true, nil
+		}
+	}
+	return schema.ObjectUpdate{}, false, nil
+}
+
+func (c *memCollection) AllState(f func(schema.ObjectUpdate, error) bool) {
+	for _, update := range c.updates {
+		if !f(update, nil) {
+			return
+		}
+	}
+}
+
+func (c *memCollection) Len() (int, error) { return len(c.updates), nil }
@@ -0,0 +1,223 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+// kindScalars are the custom scalar types of kinds which have no built-in GraphQL scalar equivalent.
+var kindScalars = map[schema.Kind]*graphql.Scalar{
+	schema.Uint32Kind:        newStringScalar("Uint32", "A 32-bit unsigned integer, represented as a JSON number.", true),
+	schema.Int64Kind:         newStringScalar("Int64", "A 64-bit signed integer, represented as a base10 string.", true),
+	schema.Uint64Kind:        newStringScalar("Uint64", "A 64-bit unsigned integer, represented as a base10 string.", true),
+	schema.IntegerStringKind: newStringScalar("IntegerString", "An arbitrary precision integer, represented as a base10 string.", true),
+	schema.DecimalStringKind: newStringScalar("DecimalString", "An arbitrary precision decimal, represented as a base10 string.", false),
+	schema.BytesKind:         newStringScalar("Bytes", "A byte array, represented as a base64 string.", false),
+	schema.TimeKind:          newStringScalar("Time", "A nanosecond precision time, represented as an RFC 3339 string.", false),
+	schema.DurationKind:      newStringScalar("Duration", "A nanosecond precision duration, represented as a decimal number of seconds followed by an s.", false),
+	schema.AddressKind:       newStringScalar("Address", "An account address, represented as a string.", false),
+	schema.JSONKind:          newStringScalar("JSON", "Arbitrary JSON data.", false),
+}
+
+// scalarForKind returns the GraphQL scalar type used for fields of kind.
+func scalarForKind(kind schema.Kind) (*graphql.Scalar, error) {
+	switch kind {
+	case schema.StringKind:
+		return graphql.String, nil
+	case schema.BoolKind:
+		return graphql.Boolean, nil
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind:
+		return graphql.Int, nil
+	case schema.Float32Kind, schema.Float64Kind:
+		return graphql.Float, nil
+	}
+
+	scalar, ok := kindScalars[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+	return scalar, nil
+}
+
+// encodeValue converts a value of kind to its JSON representation in query results.
+func encodeValue(kind schema.Kind, value any, addressCodec addressutil.AddressCodec) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch kind {
+	case schema.Int64Kind, schema.Uint64Kind:
+		return fmt.Sprintf("%d", value), nil
+	case schema.BytesKind:
+		bz, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected []byte, got %T", value)
+		}
+		return base64.StdEncoding.EncodeToString(bz), nil
+	case schema.AddressKind:
+		bz, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected []byte, got %T", value)
+		}
+		return addressCodec.BytesToString(bz)
+	case schema.TimeKind:
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Time, got %T", value)
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	case schema.DurationKind:
+		d, ok := value.(time.Duration)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Duration, got %T", value)
+		}
+		return formatDuration(d), nil
+	case schema.JSONKind:
+		raw, ok := value.(json.RawMessage)
+		if !ok {
+			return nil, fmt.Errorf("expected json.RawMessage, got %T", value)
+		}
+		return raw, nil
+	default:
+		return value, nil
+	}
+}
+
+// decodeValue converts an input value checked by the scalar type of field to the Go value of the field's kind.
+func decodeValue(field schema.Field, value any, addressCodec addressutil.AddressCodec) (any, error) {
+	res, err := decodeKindValue(field.Kind, value, addressCodec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for field %s: %w", field.Name, err)
+	}
+
+	if err := field.ValidateValue(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func decodeKindValue(kind schema.Kind, value any, addressCodec addressutil.AddressCodec) (any, error) {
+	switch kind {
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind:
+		i, ok := value.(int)
+		if !ok {
+			return nil, fmt.Errorf("expected an integer, got %v", value)
+		}
+		return convertInt(kind, int64(i))
+	case schema.Float32Kind:
+		f, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected a number, got %v", value)
+		}
+		return float32(f), nil
+	case schema.Float64Kind, schema.StringKind, schema.BoolKind, schema.EnumKind,
+		schema.IntegerStringKind, schema.DecimalStringKind:
+		return value, nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a string, got %v", value)
+	}
+
+	switch kind {
+	case schema.Uint32Kind:
+		u, err := strconv.ParseUint(s, 10, 32)
+		return uint32(u), err
+	case schema.Int64Kind:
+		return strconv.ParseInt(s, 10, 64)
+	case schema.Uint64Kind:
+		return strconv.ParseUint(s, 10, 64)
+	case schema.BytesKind:
+		return decodeBase64(s)
+	case schema.AddressKind:
+		return addressCodec.StringToBytes(s)
+	case schema.TimeKind:
+		return time.Parse(time.RFC3339Nano, s)
+	case schema.DurationKind:
+		return parseDuration(s)
+	case schema.JSONKind:
+		if !json.Valid([]byte(s)) {
+			return nil, fmt.Errorf("invalid JSON")
+		}
+		return json.RawMessage(s), nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+}
+
+func convertInt(kind schema.Kind, i int64) (any, error) {
+	var (
+		res   any
+		valid bool
+	)
+	switch kind {
+	case schema.Int8Kind:
+		res, valid = int8(i), int64(int8(i)) == i
+	case schema.Uint8Kind:
+		res, valid = uint8(i), int64(uint8(i)) == i
+	case schema.Int16Kind:
+		res, valid = int16(i), int64(int16(i)) == i
+	case schema.Uint16Kind:
+		res, valid = uint16(i), int64(uint16(i)) == i
+	default:
+		res, valid = int32(i), int64(int32(i)) == i
+	}
+	if !valid {
+		return nil, fmt.Errorf("%d is out of range for %s", i, kind)
+	}
+	return res, nil
+}
+
+// decodeBase64 accepts standard or URL encoding with or without padding.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// formatDuration formats d as a decimal number of seconds with no trailing zeros followed by an s.
+func formatDuration(d time.Duration) string {
+	sign := ""
+	n := new(big.Int).SetInt64(int64(d))
+	if n.Sign() < 0 {
+		sign = "-"
+		n.Neg(n)
+	}
+
+	secs, nanos := new(big.Int).QuoRem(n, big.NewInt(int64(time.Second)), new(big.Int))
+	if nanos.Sign() == 0 {
+		return fmt.Sprintf("%s%ss", sign, secs)
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%09d", nanos.Int64()), "0")
+	return fmt.Sprintf("%s%s.%ss", sign, secs, frac)
+}
+
+// parseDuration parses a duration formatted by formatDuration.
+func parseDuration(s string) (time.Duration, error) {
+	if !strings.HasSuffix(s, "s") {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	r, ok := new(big.Rat).SetString(strings.TrimSuffix(s, "s"))
+	if !ok {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	r.Mul(r, new(big.Rat).SetInt64(int64(time.Second)))
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return time.Duration(r.Num().Int64()), nil
+}
@@ -506,6 +506,18 @@ func (c *Consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (
 
 	c.snapshotManager.SnapshotIfApplicable(lastCommittedHeight)
 
+	// evict the txs made stale by the committed block from the mempool
+	err := c.mempool.Recheck(ctx, uint64(lastCommittedHeight), func(tx T) error {
+		resp, err := c.app.ValidateTx(ctx, tx)
+		if err != nil {
+			return err
+		}
+		return resp.Error
+	})
+	if err != nil {
+		c.logger.Error("failed to recheck mempool", "height", lastCommittedHeight, "err", err)
+	}
+
 	cp, err := c.GetConsensusParams(ctx)
 	if err != nil {
 		return nil, err
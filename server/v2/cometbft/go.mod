@@ -6,6 +6,9 @@ replace (
 	cosmossdk.io/api => ../../../api
 	cosmossdk.io/core => ../../../core
 	cosmossdk.io/core/testing => ../../../core/testing
+	cosmossdk.io/indexer/export => ../../../indexer/export
+	cosmossdk.io/indexer/export/parquet => ../../../indexer/export/parquet
+	cosmossdk.io/schema => ../../../schema
 	cosmossdk.io/server/v2 => ../
 	cosmossdk.io/server/v2/appmanager => ../appmanager
 	cosmossdk.io/store => ../../../store
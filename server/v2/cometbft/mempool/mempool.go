@@ -26,6 +26,11 @@ type Mempool[T transaction.Tx] interface {
 	// Remove attempts to remove a transaction from the mempool, returning an error
 	// upon failure.
 	Remove([]T) error
+
+	// Recheck is called after every block commit with the committed block height.
+	// It removes the expired transactions and the transactions for which validate,
+	// checking them against the committed state, returns an error.
+	Recheck(ctx context.Context, height uint64, validate func(T) error) error
 }
 
 // Iterator defines an app-side mempool iterator interface that is as minimal as
@@ -16,7 +16,8 @@ var _ Mempool[transaction.Tx] = (*NoOpMempool[transaction.Tx])(nil)
 // is FIFO-ordered by default.
 type NoOpMempool[T transaction.Tx] struct{}
 
-func (NoOpMempool[T]) Insert(context.Context, T) error         { return nil }
-func (NoOpMempool[T]) Select(context.Context, []T) Iterator[T] { return nil }
-func (NoOpMempool[T]) CountTx() int                            { return 0 }
-func (NoOpMempool[T]) Remove([]T) error                        { return nil }
+func (NoOpMempool[T]) Insert(context.Context, T) error                      { return nil }
+func (NoOpMempool[T]) Select(context.Context, []T) Iterator[T]              { return nil }
+func (NoOpMempool[T]) CountTx() int                                         { return 0 }
+func (NoOpMempool[T]) Remove([]T) error                                     { return nil }
+func (NoOpMempool[T]) Recheck(context.Context, uint64, func(T) error) error { return nil }
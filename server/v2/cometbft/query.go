@@ -2,6 +2,7 @@ package cometbft
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -10,6 +11,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/server/v2/cometbft/types"
 	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/proof"
 )
 
 func (c *Consensus[T]) handleQueryP2P(path []string) (*abci.QueryResponse, error) {
@@ -108,24 +111,53 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 		Value:     qRes.Value,
 	}
 
+	if req.Prove && qRes.Unprovable {
+		return unprovableQueryResponse(res, storeNameBz, qRes)
+	}
+
 	if req.Prove {
-		for _, proof := range qRes.ProofOps {
-			bz, err := proof.Proof.Marshal()
+		res.ProofOps = &crypto.ProofOps{}
+		for _, op := range qRes.ProofOps {
+			bz, err := op.Proof.Marshal()
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "failed to marshal proof")
 			}
 
-			res.ProofOps = &crypto.ProofOps{
-				Ops: []crypto.ProofOp{
-					{
-						Type: proof.Type,
-						Key:  proof.Key,
-						Data: bz,
-					},
-				},
-			}
+			res.ProofOps.Ops = append(res.ProofOps.Ops, crypto.ProofOp{
+				Type: op.Type,
+				Key:  op.Key,
+				Data: bz,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// unprovableQueryResponse returns the response of a store query whose version
+// is pruned from the state commitment. Its only proof op is of the unprovable
+// type, with the commit info of the version as data if the store kept it, so
+// that the clients can at least check the store hashes against the app hash.
+func unprovableQueryResponse(res *abci.QueryResponse, storeName []byte, qRes storev2.QueryResult) (*abci.QueryResponse, error) {
+	var commitInfo []byte
+	if qRes.CommitInfo != nil {
+		var err error
+		commitInfo, err = qRes.CommitInfo.Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal commit info")
 		}
 	}
 
+	res.Info = fmt.Sprintf("height %d is pruned from the state commitment and cannot be proven", qRes.Version)
+	res.ProofOps = &crypto.ProofOps{
+		Ops: []crypto.ProofOp{
+			{
+				Type: proof.ProofOpUnprovable,
+				Key:  storeName,
+				Data: commitInfo,
+			},
+		},
+	}
+
 	return res, nil
 }
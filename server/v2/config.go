@@ -30,7 +30,8 @@ func ReadConfig(configPath string) (*viper.Viper, error) {
 // UnmarshalSubConfig unmarshals the given subconfig from the viper instance.
 // It unmarshals the config, env, flags into the target struct.
 // Use this instead of viper.Sub because viper does not unmarshal flags.
-func UnmarshalSubConfig(v *viper.Viper, subName string, target any) error {
+// Additional decode hooks, e.g. to convert legacy values, run before the default ones.
+func UnmarshalSubConfig(v *viper.Viper, subName string, target any, hooks ...mapstructure.DecodeHookFunc) error {
 	var sub any
 	for k, val := range v.AllSettings() {
 		if k == subName {
@@ -41,10 +42,10 @@ func UnmarshalSubConfig(v *viper.Viper, subName string, target any) error {
 
 	// Create a new decoder with custom decoding options
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(append(hooks,
 			mapstructure.StringToTimeDurationHookFunc(),
 			mapstructure.StringToSliceHookFunc(","),
-		),
+		)...),
 		Result:           target,
 		WeaklyTypedInput: true,
 	})
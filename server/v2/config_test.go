@@ -10,6 +10,7 @@ import (
 	serverv2 "cosmossdk.io/server/v2"
 	grpc "cosmossdk.io/server/v2/api/grpc"
 	store "cosmossdk.io/server/v2/store"
+	"cosmossdk.io/store/v2/root"
 )
 
 func TestReadConfig(t *testing.T) {
@@ -44,3 +45,19 @@ func TestUnmarshalSubConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, *store.DefaultConfig(), storeConfig)
 }
+
+func TestUnmarshalSubConfig_LegacySSType(t *testing.T) {
+	currentDir, err := os.Getwd()
+	require.NoError(t, err)
+
+	v, err := serverv2.ReadConfig(filepath.Join(currentDir, "testdata"))
+	require.NoError(t, err)
+
+	// app.toml files written before ss-type was a backend name have its integer value
+	v.Set("store.options.ss-type", 1)
+
+	storeConfig := store.Config{}
+	err = serverv2.UnmarshalSubConfig(v, "store", &storeConfig, root.SSTypeDecodeHook)
+	require.NoError(t, err)
+	require.Equal(t, root.SSTypePebble, storeConfig.Options.SSType)
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -126,7 +127,12 @@ func createRootStore(cmd *cobra.Command, v *viper.Viper, logger log.Logger) (sto
 
 	storeOpts := root.DefaultStoreOptions()
 	if v != nil && v.Sub("store.options") != nil {
-		if err := v.Sub("store.options").Unmarshal(&storeOpts); err != nil {
+		if err := v.Sub("store.options").Unmarshal(&storeOpts, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+			root.SSTypeDecodeHook,
+			// viper's default decode hooks
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		))); err != nil {
 			return nil, 0, fmt.Errorf("failed to store options: %w", err)
 		}
 	}
@@ -12,6 +12,7 @@ func DefaultConfig() *Config {
 }
 
 type Config struct {
-	AppDBBackend string       `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
-	Options      root.Options `mapstructure:"options" toml:"options"`
+	AppDBBackend             string       `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
+	ConsistencyCheckInterval uint64       `mapstructure:"consistency-check-interval" toml:"consistency-check-interval" comment:"Interval in seconds of the background check that the state storage matches the state commitment, 0 disables it."`
+	Options                  root.Options `mapstructure:"options" toml:"options"`
 }
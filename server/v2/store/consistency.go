@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/root"
+)
+
+// CheckConsistencyCmd returns a command to check that the state storage matches
+// the state commitment at a given height.
+func (s *StoreComponent[T]) CheckConsistencyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-consistency",
+		Short: "Check that the state storage matches the state commitment at a given height",
+		Long: `Check that every key/value pair of the state storage (SS) matches the state commitment (SC)
+for all the store keys at a given height, and report the divergences.
+
+The node must be stopped, as the command opens the application database.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+
+			height, err := cmd.Flags().GetUint64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			maxDivergences, err := cmd.Flags().GetInt(FlagMaxDivergences)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			rootStore, _, err := createRootStore(cmd, v, logger)
+			if err != nil {
+				return err
+			}
+			defer rootStore.Close()
+
+			if height == 0 {
+				height, err = rootStore.GetLatestVersion()
+				if err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("Checking consistency at height %d\n", height)
+			report, err := root.CheckConsistency(cmd.Context(), rootStore, height, maxDivergences)
+			if err != nil {
+				return err
+			}
+
+			for storeKey, keys := range report.Keys {
+				cmd.Printf("store %s: %d keys\n", storeKey, keys)
+			}
+			for _, divergence := range report.Divergences {
+				cmd.Println(divergence.String())
+			}
+			if report.Truncated {
+				cmd.Printf("Stopped after %d divergences\n", len(report.Divergences))
+			}
+			if !report.Consistent() {
+				return fmt.Errorf("found %d divergences at height %d", len(report.Divergences), height)
+			}
+
+			cmd.Printf("State storage and state commitment are consistent at height %d\n", height)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().Uint64(FlagHeight, 0, "Height to check, default to latest state height")
+	cmd.Flags().Int(FlagMaxDivergences, 100, "Maximum number of divergences to report, 0 for no limit")
+
+	return cmd
+}
+
+// runConsistencyChecks checks the consistency of the root store at every
+// interval until the context is done, logging the divergences found.
+func (s *StoreComponent[T]) runConsistencyChecks(ctx context.Context, rootStore storev2.RootStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		latest, err := rootStore.GetLatestVersion()
+		if err != nil {
+			s.logger.Error("failed to get the latest version for the consistency check", "err", err)
+			continue
+		}
+		// skip the latest version, which the SS backend may still be writing
+		if latest <= 1 {
+			continue
+		}
+		version := latest - 1
+
+		report, err := root.CheckConsistency(ctx, rootStore, version, 100)
+		if errors.Is(err, context.Canceled) {
+			return
+		} else if err != nil {
+			s.logger.Error("failed to check the consistency of the store", "version", version, "err", err)
+			continue
+		}
+
+		if report.Consistent() {
+			s.logger.Debug("state storage and state commitment are consistent", "version", version)
+			continue
+		}
+		for _, divergence := range report.Divergences {
+			s.logger.Error("state storage diverges from state commitment", "version", version, "store", divergence.StoreKey,
+				"key", fmt.Sprintf("%X", divergence.Key), "ss_value", fmt.Sprintf("%X", divergence.SSValue), "sc_value", fmt.Sprintf("%X", divergence.SCValue))
+		}
+		if report.Truncated {
+			s.logger.Error("stopped the consistency check after too many divergences", "version", version, "divergences", len(report.Divergences))
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/indexer/export"
+	_ "cosmossdk.io/indexer/export/parquet" // register the Parquet export format
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema/decoding"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+)
+
+// HasModules is implemented by apps which expose their modules by name, such as runtime/v2 apps.
+// It is required to decode the state of modules implementing schema.HasModuleCodec when exporting it.
+type HasModules interface {
+	Modules() map[string]appmodulev2.AppModule
+	// ModuleStoreKeys returns the KV store key of each module with a store by module name, which
+	// differs from the module name when the store key is overridden in the app config.
+	ModuleStoreKeys() map[string]string
+}
+
+// ExportStateCmd returns the command to export the state of all modules at a given height to CSV or Parquet files.
+func (s *StoreComponent[T]) ExportStateCmd(newApp serverv2.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state <height>",
+		Short: "Export the state of modules at a given height to CSV or Parquet files",
+		Long: `Export the state of all modules implementing schema.HasModuleCodec at a given height to CSV or Parquet files.
+Each module gets its own directory in the output directory with one file per object type of its schema.`,
+		Example: "export-state 1000 --format parquet --modules bank,staking",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+
+			outputDir, err := cmd.Flags().GetString(FlagOutputDir)
+			if err != nil {
+				return err
+			}
+			if outputDir == "" {
+				outputDir = fmt.Sprintf("export-%d", height)
+			}
+
+			modules, err := cmd.Flags().GetStringSlice(FlagModules)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := newApp(logger, v)
+			rootStore, ok := app.GetStore().(storev2.RootStore)
+			if !ok {
+				return fmt.Errorf("app %s store does not support exporting state", app.Name())
+			}
+
+			hasModules, ok := app.(HasModules)
+			if !ok {
+				return fmt.Errorf("app %s does not expose its modules", app.Name())
+			}
+
+			moduleSet := map[string]interface{}{}
+			for name, module := range hasModules.Modules() {
+				moduleSet[name] = module
+			}
+
+			state, err := rootStore.StateAt(height)
+			if err != nil {
+				return err
+			}
+
+			opts := export.Options{
+				OutputDir: outputDir,
+				Format:    export.Format(format),
+			}
+			if len(modules) > 0 {
+				opts.ModuleFilter = func(moduleName string) bool {
+					for _, m := range modules {
+						if m == moduleName {
+							return true
+						}
+					}
+					return false
+				}
+			}
+
+			err = export.Export(stateSyncSource{state: state, storeKeys: hasModules.ModuleStoreKeys()}, decoding.ModuleSetDecoderResolver(moduleSet), opts)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Exported state at height %d to %s\n", height, outputDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, string(export.FormatCSV), "The file format to export to (csv|parquet)")
+	cmd.Flags().String(FlagOutputDir, "", "The output directory, defaults to export-<height> in the current directory")
+	cmd.Flags().StringSlice(FlagModules, nil, "The modules to export, defaults to all modules")
+
+	return cmd
+}
+
+// stateSyncSource is a decoding.SyncSource over the state of modules at a given height.
+type stateSyncSource struct {
+	state     corestore.ReaderMap
+	storeKeys map[string]string
+}
+
+func (s stateSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	storeKey, ok := s.storeKeys[moduleName]
+	if !ok {
+		// modules without a store have no state to export
+		return nil
+	}
+
+	reader, err := s.state.GetReader([]byte(storeKey))
+	if err != nil {
+		return err
+	}
+
+	iter, err := reader.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := fn(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+
+	return iter.Error()
+}
@@ -1,7 +1,12 @@
 package store
 
 const (
-	FlagAppDBBackend = "app-db-backend"
-	FlagKeepRecent   = "keep-recent"
-	FlagInterval     = "interval"
+	FlagAppDBBackend   = "app-db-backend"
+	FlagKeepRecent     = "keep-recent"
+	FlagInterval       = "interval"
+	FlagFormat         = "format"
+	FlagOutputDir      = "output-dir"
+	FlagModules        = "modules"
+	FlagHeight         = "height"
+	FlagMaxDivergences = "max-divergences"
 )
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -10,14 +11,21 @@ import (
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/root"
 )
 
 // StoreComponent manages store config
 // and contains prune & snapshot commands
 type StoreComponent[T transaction.Tx] struct {
 	config *Config
-	// saving appCreator for only RestoreSnapshotCmd
+	// saving appCreator for only RestoreSnapshotCmd and ExportStateCmd
 	appCreator serverv2.AppCreator[T]
+
+	logger log.Logger
+	// store is the root store of the app checked by the background consistency check
+	store  storev2.RootStore
+	cancel context.CancelFunc
 }
 
 func New[T transaction.Tx](appCreator serverv2.AppCreator[T]) *StoreComponent[T] {
@@ -27,11 +35,15 @@ func New[T transaction.Tx](appCreator serverv2.AppCreator[T]) *StoreComponent[T]
 func (s *StoreComponent[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger log.Logger) error {
 	cfg := DefaultConfig()
 	if v != nil {
-		if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+		if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg, root.SSTypeDecodeHook); err != nil {
 			return fmt.Errorf("failed to unmarshal config: %w", err)
 		}
 	}
 	s.config = cfg
+	s.logger = logger.With(log.ModuleKey, s.Name())
+	if appI != nil {
+		s.store, _ = appI.GetStore().(storev2.RootStore)
+	}
 	return nil
 }
 
@@ -40,10 +52,19 @@ func (s *StoreComponent[T]) Name() string {
 }
 
 func (s *StoreComponent[T]) Start(ctx context.Context) error {
+	if s.config.ConsistencyCheckInterval == 0 || s.store == nil {
+		return nil
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	go s.runConsistencyChecks(ctx, s.store, time.Duration(s.config.ConsistencyCheckInterval)*time.Second)
 	return nil
 }
 
 func (s *StoreComponent[T]) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
 	return nil
 }
 
@@ -57,6 +78,8 @@ func (s *StoreComponent[T]) CLICommands() serverv2.CLIConfig {
 			s.DumpArchiveCmd(),
 			s.LoadArchiveCmd(),
 			s.RestoreSnapshotCmd(s.appCreator),
+			s.ExportStateCmd(s.appCreator),
+			s.CheckConsistencyCmd(),
 		},
 	}
 }
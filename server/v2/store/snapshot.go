@@ -37,6 +37,10 @@ func (s *StoreComponent[T]) ExportSnapshotCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			delta, err := cmd.Flags().GetBool("delta")
+			if err != nil {
+				return err
+			}
 
 			logger := log.NewLogger(cmd.OutOrStdout())
 			// app := appCreator(logger, db, nil, viper)
@@ -59,7 +63,12 @@ func (s *StoreComponent[T]) ExportSnapshotCmd() *cobra.Command {
 				return err
 			}
 
-			snapshot, err := sm.Create(uint64(height))
+			var snapshot *types.Snapshot
+			if delta {
+				snapshot, err = sm.CreateDelta(uint64(height))
+			} else {
+				snapshot, err = sm.Create(uint64(height))
+			}
 			if err != nil {
 				return err
 			}
@@ -71,6 +80,7 @@ func (s *StoreComponent[T]) ExportSnapshotCmd() *cobra.Command {
 
 	addSnapshotFlagsToCmd(cmd)
 	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().Bool("delta", false, "Export an incremental snapshot on top of the latest snapshot")
 
 	return cmd
 }
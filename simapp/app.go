@@ -25,8 +25,13 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	lockup "cosmossdk.io/x/accounts/defaults/lockup"
+	recoveryaccount "cosmossdk.io/x/accounts/defaults/recovery"
+	sessionkeyaccount "cosmossdk.io/x/accounts/defaults/sessionkey"
+	subscriptionaccount "cosmossdk.io/x/accounts/defaults/subscription"
+	webauthnaccount "cosmossdk.io/x/accounts/defaults/webauthn"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
 	"cosmossdk.io/x/accounts/testing/counter"
+	"cosmossdk.io/x/accounts/testing/lockupv2"
 	"cosmossdk.io/x/auth"
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/ante/unorderedtx"
@@ -299,6 +304,10 @@ func NewSimApp(
 		// TESTING: do not add
 		accountstd.AddAccount("counter", counter.NewAccount),
 		accountstd.AddAccount("aa_minimal", account_abstraction.NewMinimalAbstractedAccount),
+		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT_V2, lockupv2.NewContinuousLockingAccount),
+		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT_V2, lockupv2.NewPeriodicLockingAccount),
+		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT_V2, lockupv2.NewDelayedLockingAccount),
+		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT_V2, lockupv2.NewPermanentLockingAccount),
 		// Lockup account
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
@@ -306,6 +315,10 @@ func NewSimApp(
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		// PRODUCTION: add
 		baseaccount.NewAccount("base", txConfig.SignModeHandler()),
+		webauthnaccount.NewAccount(webauthnaccount.WEBAUTHN_ACCOUNT, txConfig.SignModeHandler()),
+		sessionkeyaccount.NewAccount(sessionkeyaccount.SESSION_KEY_ACCOUNT, txConfig.SignModeHandler()),
+		recoveryaccount.NewAccount(recoveryaccount.SOCIAL_RECOVERY_ACCOUNT, txConfig.SignModeHandler()),
+		subscriptionaccount.NewAccount(subscriptionaccount.SUBSCRIPTION_ACCOUNT, txConfig.SignModeHandler()),
 	)
 	if err != nil {
 		panic(err)
@@ -233,6 +233,58 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 3, version)
+
+	stream := func(write func(protoWriter *snapshots.StreamWriter) error) *snapshots.StreamReader {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(t, streamWriter)
+			defer streamWriter.Close()
+			require.NoError(t, write(streamWriter))
+		}()
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		require.NoError(t, err)
+		return streamReader
+	}
+
+	// restore the full snapshot at height 1, then the delta up to the latest height
+	_, err := target.Restore(1, snapshottypes.CurrentFormat, stream(func(w *snapshots.StreamWriter) error {
+		return source.Snapshot(1, w)
+	}))
+	require.NoError(t, err)
+
+	_, err = target.RestoreDelta(2, version, nil)
+	require.Error(t, err)
+
+	_, err = target.RestoreDelta(1, version, stream(func(w *snapshots.StreamWriter) error {
+		return source.SnapshotDelta(1, version, w)
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		sourceStore := source.GetStoreByName(name).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(name).(types.CommitKVStore)
+		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", name)
+	}
+
+	// the intermediate heights are restored as well
+	sourceStore, err := source.GetStoreByName("iavl1").(*iavl.Store).GetImmutable(2)
+	require.NoError(t, err)
+	targetStore, err := target.GetStoreByName("iavl1").(*iavl.Store).GetImmutable(2)
+	require.NoError(t, err)
+	assert.Equal(t, sourceStore.LastCommitID(), targetStore.LastCommitID())
+
+	// a delta can't be taken on top of a missing height
+	require.Error(t, source.SnapshotDelta(0, version, nil))
+	require.Error(t, source.SnapshotDelta(version, version, nil))
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
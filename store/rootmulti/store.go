@@ -76,8 +76,9 @@ type Store struct {
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore         = (*Store)(nil)
+	_ types.Queryable                = (*Store)(nil)
+	_ snapshottypes.DeltaSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -841,28 +842,10 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
-	keys := keysFromStoreKeyMap(rs.stores)
-	for _, key := range keys {
-		switch store := rs.GetCommitKVStore(key).(type) {
-		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *transient.Store, *mem.Store:
-			// Non-persisted stores shouldn't be snapshotted
-			continue
-		default:
-			return errorsmod.Wrapf(types.ErrLogic,
-				"don't know how to snapshot store %q of type %T", key.Name(), store)
-		}
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
 	}
-	sort.Slice(stores, func(i, j int) bool {
-		return strings.Compare(stores[i].name, stores[j].name) == -1
-	})
 
 	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
@@ -926,6 +909,190 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	return nil
 }
 
+// namedStore is an IAVL store with its name, to snapshot.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores collects the stores to snapshot (only IAVL stores are supported), sorted by name.
+func (rs *Store) snapshotStores() ([]namedStore, error) {
+	stores := []namedStore{}
+	keys := keysFromStoreKeyMap(rs.stores)
+	for _, key := range keys {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return nil, errorsmod.Wrapf(types.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+	return stores, nil
+}
+
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter. The changesets are extracted from
+// the IAVL versions in (base, height], which must not be pruned.
+func (rs *Store) SnapshotDelta(base, height uint64, protoWriter protoio.Writer) error {
+	if base >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot height %v on top of height %v", height, base)
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if !store.VersionExists(int64(base)) {
+			return errorsmod.Wrapf(types.ErrLogic, "version %v of store %q doesn't exist", base, store.name)
+		}
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		rs.logger.Debug("starting delta snapshot", "store", store.name, "base", base, "height", height)
+		err = store.TraverseStateChanges(int64(base)+1, int64(height), func(version int64, changeSet *iavltree.ChangeSet) error {
+			for _, pair := range changeSet.Pairs {
+				item := &snapshottypes.SnapshotIAVLItem{
+					Key:     pair.Key,
+					Value:   pair.Value,
+					Version: version,
+				}
+				if pair.Delete {
+					item.Value = nil
+					item.Height = -1
+				}
+				if err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_IAVL{IAVL: item},
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to traverse the changes of store %q", store.name)
+		}
+	}
+
+	return nil
+}
+
+// RestoreDelta implements snapshottypes.DeltaSnapshotter. The changesets of each store are
+// applied and committed height by height, so that the stores are identical to the ones the
+// snapshot was taken from.
+// returns next snapshot item and error.
+func (rs *Store) RestoreDelta(
+	base, height uint64, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if version := rs.LastCommitID().Version; version != int64(base) {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+			"cannot restore delta on top of height %v at height %v", base, version)
+	}
+
+	var (
+		store        *iavl.Store
+		storeVersion int64
+		snapshotItem snapshottypes.SnapshotItem
+	)
+	restored := make(map[string]bool)
+	// commitUntil commits the store until the given version, the changes of each version being
+	// set before committing it.
+	commitUntil := func(version int64) {
+		for ; storeVersion < version; storeVersion++ {
+			store.Commit()
+		}
+	}
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if store != nil {
+				commitUntil(int64(height))
+			}
+			var ok bool
+			store, ok = rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot restore delta into non-IAVL store %q", item.Store.Name)
+			}
+			storeVersion = store.LastCommitID().Version
+			if storeVersion != int64(base) || restored[item.Store.Name] {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot restore delta into store %q at height %v", item.Store.Name, storeVersion)
+			}
+			restored[item.Store.Name] = true
+			rs.logger.Debug("restoring delta snapshot", "store", item.Store.Name)
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL item before store item")
+			}
+			if item.IAVL.Version <= storeVersion || item.IAVL.Version > int64(height) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "unexpected change at height %v", item.IAVL.Version)
+			}
+			commitUntil(item.IAVL.Version - 1)
+			// Protobuf does not differentiate between []byte{} as nil, see Restore.
+			if item.IAVL.Key == nil {
+				item.IAVL.Key = []byte{}
+			}
+			switch item.IAVL.Height {
+			case 0:
+				if item.IAVL.Value == nil {
+					item.IAVL.Value = []byte{}
+				}
+				store.Set(item.IAVL.Key, item.IAVL.Value)
+			case -1:
+				store.Delete(item.IAVL.Key)
+			default:
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "invalid change height %v", item.IAVL.Height)
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if store != nil {
+		commitUntil(int64(height))
+	}
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	for _, store := range stores {
+		if !restored[store.name] {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "store %q is missing from the delta", store.name)
+		}
+	}
+
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
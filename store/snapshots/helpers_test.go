@@ -6,6 +6,7 @@ import (
 	"compress/zlib"
 	"crypto/sha256"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"testing"
@@ -171,6 +172,62 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockDeltaSnapshotter is a mockSnapshotter with an append-only state, which items at each
+// height are given by versions.
+type mockDeltaSnapshotter struct {
+	mockSnapshotter
+	versions map[uint64][][]byte
+	height   uint64
+}
+
+func (m *mockDeltaSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	m.items = m.versions[height]
+	return m.mockSnapshotter.Snapshot(height, protoWriter)
+}
+
+func (m *mockDeltaSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	m.height = height
+	return m.mockSnapshotter.Restore(height, format, protoReader)
+}
+
+func (m *mockDeltaSnapshotter) SnapshotDelta(base, height uint64, protoWriter protoio.Writer) error {
+	for _, item := range m.versions[height][len(m.versions[base]):] {
+		if err := snapshottypes.WriteExtensionPayload(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockDeltaSnapshotter) RestoreDelta(
+	base, height uint64, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if m.height != base {
+		return snapshottypes.SnapshotItem{}, fmt.Errorf("cannot restore delta on top of height %d at height %d", base, m.height)
+	}
+
+	var item snapshottypes.SnapshotItem
+	for {
+		item.Reset()
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			break
+		}
+		m.items = append(m.items, payload.Payload)
+	}
+	m.height = height
+
+	return item, nil
+}
+
 type mockErrorSnapshotter struct{}
 
 var _ snapshottypes.Snapshotter = (*mockErrorSnapshotter)(nil)
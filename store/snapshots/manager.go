@@ -187,6 +187,49 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// CreateDelta creates an incremental snapshot in the types.DeltaFormat on top of the latest
+// snapshot and returns its metadata. The multistore must implement types.DeltaSnapshotter.
+func (m *Manager) CreateDelta(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	defer m.multistore.PruneSnapshotHeight(int64(height))
+
+	return m.createDelta(height)
+}
+
+// createDelta creates a delta snapshot, without releasing the height to the pruning.
+func (m *Manager) createDelta(height uint64) (*types.Snapshot, error) {
+	deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrUnknownFormat, "multistore doesn't support delta snapshots")
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if base == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot to take a delta snapshot on")
+	}
+	if base.Height >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrConflict,
+			"a more recent snapshot already exists at height %v", base.Height)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createDeltaSnapshot(deltaSnapshotter, base, height, ch)
+
+	return m.store.Save(height, types.DeltaFormat, ch)
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
@@ -204,6 +247,37 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// createDeltaSnapshot writes the chunks of a delta snapshot to the channel, the first one
+// being the header referencing the base snapshot.
+func (m *Manager) createDeltaSnapshot(deltaSnapshotter types.DeltaSnapshotter, base *types.Snapshot, height uint64, ch chan<- io.ReadCloser) {
+	ch <- io.NopCloser(bytes.NewReader(encodeDeltaHeader(base.Height, base.Format)))
+
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	if err := deltaSnapshotter.SnapshotDelta(base.Height, height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// writeExtensions writes the snapshots of the extensions at the given height.
+func (m *Manager) writeExtensions(height uint64, streamWriter *StreamWriter) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
@@ -216,22 +290,33 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
 			return types.WriteExtensionPayload(streamWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+// Delta snapshots are not listed, as they can't be restored through state sync.
 func (m *Manager) List() ([]*types.Snapshot, error) {
-	return m.store.List()
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	listed := snapshots[:0]
+	for _, snapshot := range snapshots {
+		if snapshot.Format != types.DeltaFormat {
+			listed = append(listed, snapshot)
+		}
+	}
+	return listed, nil
 }
 
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
@@ -273,7 +358,8 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
-	// check multistore supported format preemptive
+	// check multistore supported format preemptive, delta snapshots can only be restored locally
+	// since they require the state of their base snapshot
 	if snapshot.Format != types.CurrentFormat {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
@@ -342,6 +428,23 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
+	var base uint64
+	if snapshot.Format == types.DeltaFormat {
+		header, ok := <-chChunks
+		if !ok {
+			return errorsmod.Wrap(types.ErrInvalidMetadata, "missing delta header")
+		}
+		bz, err := io.ReadAll(io.LimitReader(header, deltaHeaderMaxSize+1))
+		header.Close()
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to read delta header")
+		}
+		base, _, err = decodeDeltaHeader(bz)
+		if err != nil {
+			return err
+		}
+	}
+
 	var nextItem types.SnapshotItem
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
@@ -362,7 +465,15 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if snapshot.Format == types.DeltaFormat {
+		deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+		if !ok {
+			return errorsmod.Wrap(types.ErrUnknownFormat, "multistore doesn't support delta snapshots")
+		}
+		nextItem, err = deltaSnapshotter.RestoreDelta(base, snapshot.Height, streamReader)
+	} else {
+		nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -459,14 +570,15 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A delta snapshot is restored
+// by restoring its chain of snapshots in order, starting with the full snapshot.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	chain, err := m.store.GetChain(height, format)
 	if err != nil {
 		return err
 	}
 
-	if snapshot == nil {
+	if chain == nil {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
 
@@ -479,7 +591,17 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, snapshot := range chain {
+		_, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+		if err != nil {
+			return err
+		}
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			DrainChunks(ch)
+			return errorsmod.Wrapf(err, "failed to restore snapshot at height %d format %d", snapshot.Height, snapshot.Format)
+		}
+	}
+	return nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -522,6 +644,27 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// shouldTakeDelta returns true if the next snapshot should be a delta of the latest one, which
+// is the case until the latest one has MaxDeltas deltas since the last full snapshot.
+func (m *Manager) shouldTakeDelta() bool {
+	if m.opts.MaxDeltas == 0 {
+		return false
+	}
+	if _, ok := m.multistore.(types.DeltaSnapshotter); !ok {
+		return false
+	}
+	latest, err := m.store.GetLatest()
+	if err != nil || latest == nil {
+		return false
+	}
+	chain, err := m.store.GetChain(latest.Height, latest.Format)
+	if err != nil {
+		m.logger.Error("failed to load the chain of the latest snapshot", "height", latest.Height, "err", err)
+		return false
+	}
+	return uint32(len(chain)-1) < m.opts.MaxDeltas
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
@@ -530,10 +673,24 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
-	if err != nil {
-		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
-		return
+	var (
+		snapshot *types.Snapshot
+		err      error
+	)
+	if m.shouldTakeDelta() {
+		snapshot, err = m.createDelta(uint64(height))
+		if err != nil {
+			m.logger.Error("failed to create delta state snapshot, creating a full one", "height", height, "err", err)
+		} else {
+			m.multistore.PruneSnapshotHeight(height)
+		}
+	}
+	if snapshot == nil {
+		snapshot, err = m.Create(uint64(height))
+		if err != nil {
+			m.logger.Error("failed to create state snapshot", "height", height, "err", err)
+			return
+		}
 	}
 
 	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
@@ -115,6 +115,75 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_DeltaSnapshot(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	source := &mockDeltaSnapshotter{
+		mockSnapshotter: mockSnapshotter{prunedHeights: make(map[int64]struct{})},
+		versions: map[uint64][][]byte{
+			1: {{1, 2, 3}},
+			2: {{1, 2, 3}, {4, 5, 6}},
+			3: {{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+		},
+	}
+	manager := snapshots.NewManager(store, opts, source, nil, log.NewNopLogger())
+
+	// a delta requires a base snapshot, and a snapshotter supporting them
+	_, err = manager.CreateDelta(1)
+	require.Error(t, err)
+	_, err = snapshots.NewManager(store, opts, &mockSnapshotter{prunedHeights: make(map[int64]struct{})}, nil, log.NewNopLogger()).CreateDelta(1)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+	snapshot, err := manager.CreateDelta(2)
+	require.NoError(t, err)
+	assert.Equal(t, types.DeltaFormat, snapshot.Format)
+	_, didPruneHeight := source.prunedHeights[2]
+	require.True(t, didPruneHeight)
+	snapshot, err = manager.CreateDelta(3)
+	require.NoError(t, err)
+
+	baseHeight, baseFormat, err := store.GetDeltaBase(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, baseHeight)
+	assert.Equal(t, types.DeltaFormat, baseFormat)
+
+	chain, err := store.GetChain(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+	for i, s := range chain {
+		assert.EqualValues(t, i+1, s.Height)
+	}
+
+	// deltas are not listed over ABCI
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, types.CurrentFormat, list[0].Format)
+
+	// the bases of the retained deltas are not pruned
+	pruned, err := manager.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	// deltas can't be restored through state sync
+	err = manager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	// restoring a delta restores its chain
+	target := &mockDeltaSnapshotter{}
+	manager = snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	assert.Equal(t, source.versions[3], target.items)
+	assert.EqualValues(t, 3, target.height)
+
+	// a chain with a missing base can't be restored
+	require.NoError(t, store.Delete(1, types.CurrentFormat))
+	_, err = store.GetChain(snapshot.Height, snapshot.Format)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	snapshotter := &mockSnapshotter{}
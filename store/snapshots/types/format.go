@@ -4,3 +4,9 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// DeltaFormat is the format of incremental snapshots, which only contain the changesets of the
+// heights since a base snapshot. The first chunk holds the height and format of the base
+// snapshot, and the following chunks hold the snapshot stream. It is numbered as in
+// cosmossdk.io/store/v2/snapshots/types, where format 4 is the indexed format.
+const DeltaFormat uint32 = 5
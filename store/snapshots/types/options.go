@@ -8,6 +8,12 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// MaxDeltas defines how many incremental snapshots are taken on top of each full
+	// snapshot, each one containing the changesets since the previous snapshot. The
+	// heights since the previous snapshot must not be pruned for it to be taken,
+	// otherwise a full snapshot is taken instead. 0 disables incremental snapshots.
+	MaxDeltas uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -26,6 +26,20 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// DeltaSnapshotter is an optional interface of the Snapshotter which creates and restores
+// incremental snapshots in the DeltaFormat. The stream of a delta snapshot contains, for each
+// store, a SnapshotStoreItem followed by the changed leaves of each height in ascending order,
+// as SnapshotIAVLItem with the height of the change as Version, and a Height of 0 for a set or
+// -1 for a delete.
+type DeltaSnapshotter interface {
+	// SnapshotDelta writes the changesets of the heights in (base, height] into the protobuf writer.
+	SnapshotDelta(base, height uint64, protoWriter protoio.Writer) error
+
+	// RestoreDelta applies the changesets of a delta snapshot on top of the state at the base
+	// height, taking the reader of protobuf message stream as input.
+	RestoreDelta(base, height uint64, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -12,6 +12,7 @@ import (
 const (
 	commitInfoKeyFmt      = "c/%d" // c/<version>
 	latestVersionKey      = "c/latest"
+	prunedVersionKey      = "c/pruned"
 	removedStoreKeyPrefix = "c/removed/" // c/removed/<version>/<store-name>
 )
 
@@ -54,6 +55,33 @@ func (m *MetadataStore) setLatestVersion(version uint64) error {
 	return m.kv.Set([]byte(latestVersionKey), buf.Bytes())
 }
 
+// GetPrunedVersion returns the latest pruned version, 0 if nothing was pruned.
+func (m *MetadataStore) GetPrunedVersion() (uint64, error) {
+	value, err := m.kv.Get([]byte(prunedVersionKey))
+	if err != nil {
+		return 0, err
+	}
+	if value == nil {
+		return 0, nil
+	}
+
+	version, _, err := encoding.DecodeUvarint(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (m *MetadataStore) setPrunedVersion(version uint64) error {
+	var buf bytes.Buffer
+	buf.Grow(encoding.EncodeUvarintSize(version))
+	if err := encoding.EncodeUvarint(&buf, version); err != nil {
+		return err
+	}
+	return m.kv.Set([]byte(prunedVersionKey), buf.Bytes())
+}
+
 // GetCommitInfo returns the commit info for the given version.
 func (m *MetadataStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
 	key := []byte(fmt.Sprintf(commitInfoKeyFmt, version))
@@ -73,7 +101,9 @@ func (m *MetadataStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error)
 	return cInfo, nil
 }
 
-func (m *MetadataStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo) (err error) {
+// flushCommitInfo flushes the commit info of the version, along with the store
+// keys removed at removedVersion if any.
+func (m *MetadataStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo, removedVersion uint64, removedStoreKeys []string) (err error) {
 	// do nothing if commit info is nil, as will be the case for an empty, initializing store
 	if cInfo == nil {
 		return nil
@@ -94,6 +124,9 @@ func (m *MetadataStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo)
 	if err := batch.Set(cInfoKey, value); err != nil {
 		return err
 	}
+	if err := setRemovedStoreKeys(batch, removedVersion, removedStoreKeys); err != nil {
+		return err
+	}
 
 	var buf bytes.Buffer
 	buf.Grow(encoding.EncodeUvarintSize(version))
@@ -110,19 +143,15 @@ func (m *MetadataStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo)
 	return nil
 }
 
-func (m *MetadataStore) flushRemovedStoreKeys(version uint64, storeKeys []string) (err error) {
-	batch := m.kv.NewBatch()
-	defer func() {
-		err = batch.Close()
-	}()
-
+// setRemovedStoreKeys records in the batch the store keys removed at the version.
+func setRemovedStoreKeys(batch corestore.Batch, version uint64, storeKeys []string) error {
 	for _, storeKey := range storeKeys {
 		key := []byte(fmt.Sprintf("%s%s", encoding.BuildPrefixWithVersion(removedStoreKeyPrefix, version), storeKey))
 		if err := batch.Set(key, []byte{}); err != nil {
 			return err
 		}
 	}
-	return batch.Write()
+	return nil
 }
 
 func (m *MetadataStore) GetRemovedStoreKeys(version uint64) (storeKeys [][]byte, err error) {
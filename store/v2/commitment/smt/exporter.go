@@ -0,0 +1,71 @@
+package smt
+
+import (
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ commitment.Exporter = (*Exporter)(nil)
+
+// Exporter exports the leaves of a version of the tree in key hash order. Inner nodes are not exported
+// because they are rebuilt from the leaves on import.
+type Exporter struct {
+	tree *SmtTree
+	// stack holds the subtrees left to export, with the next one on top.
+	stack []exportEntry
+}
+
+type exportEntry struct {
+	node  *node
+	depth int
+	path  []byte
+}
+
+func newExporter(tree *SmtTree, root *node) *Exporter {
+	e := &Exporter{tree: tree}
+	if root != nil {
+		e.stack = append(e.stack, exportEntry{node: root, path: make([]byte, hashSize)})
+	}
+	return e
+}
+
+// Next returns the next leaf of the tree as a snapshot item of height 0.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	for len(e.stack) > 0 {
+		entry := e.stack[len(e.stack)-1]
+		e.stack = e.stack[:len(e.stack)-1]
+
+		n, err := e.tree.resolve(entry.node, entry.depth, entry.path)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.leaf {
+			return &snapshotstypes.SnapshotIAVLItem{
+				Key:     n.key,
+				Value:   n.value,
+				Version: int64(n.version),
+				Height:  0,
+			}, nil
+		}
+
+		// push the right child first so that the left one is exported first
+		for b := 1; b >= 0; b-- {
+			if n.children[b] != nil {
+				e.stack = append(e.stack, exportEntry{
+					node:  n.children[b],
+					depth: entry.depth + 1,
+					path:  withBit(entry.path, entry.depth, b),
+				})
+			}
+		}
+	}
+
+	return nil, commitment.ErrorExportDone
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.stack = nil
+	return nil
+}
@@ -0,0 +1,89 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ commitment.Importer = (*Importer)(nil)
+
+// importFlushInterval is the number of leaves after which the complete subtrees of an import are saved
+// and released from memory.
+const importFlushInterval = 10_000
+
+// Importer imports the leaves exported by an Exporter into an empty tree and saves them as a single version.
+// Leaves must be added in key hash order, which makes every subtree on the left of the last added leaf
+// complete so that it can be saved before the import is committed.
+type Importer struct {
+	tree    *SmtTree
+	version uint64
+
+	lastKeyHash []byte
+	added       int
+}
+
+func newImporter(tree *SmtTree, version uint64) *Importer {
+	return &Importer{
+		tree:    tree,
+		version: version,
+	}
+}
+
+// Add adds the given leaf item to the importer.
+func (i *Importer) Add(item *snapshotstypes.SnapshotIAVLItem) error {
+	if item.Height != 0 {
+		return fmt.Errorf("unexpected inner node item of height %d, only leaves are imported", item.Height)
+	}
+
+	keyHash := sha256.Sum256(item.Key)
+	if i.lastKeyHash != nil && bytes.Compare(keyHash[:], i.lastKeyHash) <= 0 {
+		return errors.New("leaves must be imported in increasing key hash order")
+	}
+	i.lastKeyHash = keyHash[:]
+
+	if err := i.tree.Set(item.Key, item.Value); err != nil {
+		return err
+	}
+
+	i.added++
+	if i.added%importFlushInterval == 0 {
+		return i.flush()
+	}
+
+	return nil
+}
+
+// flush saves the subtrees on the left of the path of the last added leaf and replaces them with references.
+func (i *Importer) flush() error {
+	batch := i.tree.db.NewBatch()
+	defer batch.Close()
+
+	n := i.tree.root
+	for depth := 0; n != nil && !n.leaf && n.loaded; depth++ {
+		b := bit(i.lastKeyHash, depth)
+		if left := n.children[0]; b == 1 && left != nil && left.version == 0 {
+			if err := i.tree.saveNode(batch, left, i.version, depth+1, withBit(i.lastKeyHash, depth, 0)); err != nil {
+				return err
+			}
+			n.children[0] = &node{version: left.version, hash: left.hash}
+		}
+		n = n.children[b]
+	}
+
+	return batch.Write()
+}
+
+// Commit saves the imported leaves as the version of the import.
+func (i *Importer) Commit() error {
+	return i.tree.commit(i.version)
+}
+
+// Close closes the importer.
+func (i *Importer) Close() error {
+	return nil
+}
@@ -0,0 +1,233 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// hashSize is the size of key hashes and node hashes, which is also the maximum depth of the tree.
+	hashSize = sha256.Size
+
+	// maxDepth is the number of bits of key hashes.
+	maxDepth = hashSize * 8
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+
+	// emptyHash is the hash of empty subtrees.
+	emptyHash = make([]byte, hashSize)
+)
+
+// Storage key prefixes.
+var (
+	// nodePrefix prefixes nodes stored by node key: version (8 bytes) | depth (2 bytes) | path bits.
+	nodePrefix = []byte{'n'}
+	// rootPrefix prefixes the root reference of each version.
+	rootPrefix = []byte{'r'}
+	// stalePrefix prefixes the keys of the nodes which stopped being part of the tree at a version:
+	// stale since version (8 bytes) | node key.
+	stalePrefix = []byte{'s'}
+	// latestVersionKey is the key of the latest saved version.
+	latestVersionKey = []byte{'l'}
+)
+
+// node is a node of the tree. A subtree containing a single leaf is represented by the leaf itself, so that
+// leaves are stored at the depth at which their key hash diverges from the key hashes of all the other leaves
+// and inner nodes always have at least two leaves below them.
+type node struct {
+	// version is the version at which the node was saved, zero for the nodes created in the working tree.
+	version uint64
+	// hash is the hash of the node, nil until it is computed for the nodes created in the working tree.
+	hash []byte
+	// loaded is false for nodes of which only the version and hash are known.
+	loaded bool
+
+	leaf    bool
+	key     []byte
+	value   []byte
+	keyHash []byte
+
+	// children are the left and right children of an inner node, nil for empty subtrees.
+	children [2]*node
+}
+
+// newLeaf creates a new leaf node in the working tree.
+func newLeaf(key, value []byte) *node {
+	keyHash := sha256.Sum256(key)
+	return &node{
+		loaded:  true,
+		leaf:    true,
+		key:     key,
+		value:   value,
+		keyHash: keyHash[:],
+	}
+}
+
+// computeHash computes the hash of the node and of its new descendants, as defined by ics23.SmtSpec.
+func (n *node) computeHash() []byte {
+	if n.hash != nil {
+		return n.hash
+	}
+
+	h := sha256.New()
+	if n.leaf {
+		valueHash := sha256.Sum256(n.value)
+		h.Write(leafPrefix)
+		h.Write(n.keyHash)
+		h.Write(valueHash[:])
+	} else {
+		h.Write(innerPrefix)
+		h.Write(childHash(n.children[0]))
+		h.Write(childHash(n.children[1]))
+	}
+	n.hash = h.Sum(nil)
+
+	return n.hash
+}
+
+// childHash returns the hash of a child, which is the empty hash for empty subtrees.
+func childHash(child *node) []byte {
+	if child == nil {
+		return emptyHash
+	}
+	return child.computeHash()
+}
+
+// encode encodes a loaded node. The children of an inner node are encoded as their version and hash
+// so they must be saved first.
+func (n *node) encode() []byte {
+	var buf bytes.Buffer
+	if n.leaf {
+		buf.Write(leafPrefix)
+		buf.Write(binary.AppendUvarint(nil, uint64(len(n.key))))
+		buf.Write(n.key)
+		buf.Write(n.value)
+		return buf.Bytes()
+	}
+
+	buf.Write(innerPrefix)
+	for _, child := range n.children {
+		if child == nil {
+			buf.WriteByte(0)
+			continue
+		}
+
+		buf.WriteByte(1)
+		buf.Write(binary.AppendUvarint(nil, child.version))
+		buf.Write(child.computeHash())
+	}
+
+	return buf.Bytes()
+}
+
+// decodeNode decodes a node saved at version.
+func decodeNode(version uint64, bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty node")
+	}
+
+	n := &node{version: version, loaded: true}
+	switch bz[0] {
+	case leafPrefix[0]:
+		keyLen, size := binary.Uvarint(bz[1:])
+		if size <= 0 || uint64(len(bz)-1-size) < keyLen {
+			return nil, errors.New("invalid leaf node key length")
+		}
+
+		start := 1 + size
+		n.leaf = true
+		n.key = bz[start : start+int(keyLen)]
+		n.value = bz[start+int(keyLen):]
+		keyHash := sha256.Sum256(n.key)
+		n.keyHash = keyHash[:]
+
+	case innerPrefix[0]:
+		bz = bz[1:]
+		for i := range n.children {
+			if len(bz) == 0 {
+				return nil, errors.New("invalid inner node")
+			}
+
+			present := bz[0]
+			bz = bz[1:]
+			if present == 0 {
+				continue
+			}
+
+			childVersion, size := binary.Uvarint(bz)
+			if size <= 0 || len(bz)-size < hashSize {
+				return nil, errors.New("invalid inner node child")
+			}
+
+			n.children[i] = &node{version: childVersion, hash: bz[size : size+hashSize]}
+			bz = bz[size+hashSize:]
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid node type %d", bz[0])
+	}
+
+	n.computeHash()
+	return n, nil
+}
+
+// bit returns the bit of the key hash at depth, which is the index of the child followed at depth.
+func bit(keyHash []byte, depth int) int {
+	return int(keyHash[depth/8]>>(7-uint(depth%8))) & 1
+}
+
+// withBit returns a copy of the path with the bit at depth set to b.
+func withBit(path []byte, depth, b int) []byte {
+	res := make([]byte, hashSize)
+	copy(res, path)
+	mask := byte(1) << (7 - uint(depth%8))
+	if b == 1 {
+		res[depth/8] |= mask
+	} else {
+		res[depth/8] &^= mask
+	}
+	return res
+}
+
+// nodeKey returns the storage key of the node saved at version at depth, of which path holds the bits
+// leading to it.
+func nodeKey(version uint64, depth int, path []byte) []byte {
+	key := make([]byte, 0, len(nodePrefix)+10+(depth+7)/8)
+	key = append(key, nodePrefix...)
+	key = binary.BigEndian.AppendUint64(key, version)
+	key = binary.BigEndian.AppendUint16(key, uint16(depth))
+	if depth == 0 {
+		return key
+	}
+
+	key = append(key, path[:(depth+7)/8]...)
+	if depth%8 != 0 {
+		// clear the bits of the path below the node
+		key[len(key)-1] &= byte(0xff) << (8 - uint(depth%8))
+	}
+
+	return key
+}
+
+// versionKey returns the key of the entries of version under prefix, which is the root reference
+// of version under rootPrefix and the start of its entries under nodePrefix and stalePrefix.
+func versionKey(prefix []byte, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, prefix...), version)
+}
+
+// staleKey returns the storage key recording that the node with the given node key stopped being
+// part of the tree at version.
+func staleKey(version uint64, nodeKey []byte) []byte {
+	return append(versionKey(stalePrefix, version), nodeKey...)
+}
+
+// prefixEnd returns the end of the range of the keys starting with the single byte prefix.
+func prefixEnd(prefix []byte) []byte {
+	return []byte{prefix[0] + 1}
+}
@@ -0,0 +1,685 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
+)
+
+var (
+	_ commitment.Tree                 = (*SmtTree)(nil)
+	_ commitment.CommitmentOpProvider = (*SmtTree)(nil)
+)
+
+// SmtTree is a versioned sparse Merkle tree in the style of the Jellyfish Merkle tree, with the binary
+// layout and hashing of ics23.SmtSpec.
+//
+// Leaves are placed at the path of the SHA-256 hash of their key, and subtrees containing a single leaf
+// are replaced by the leaf, so that a tree of n leaves only has about log2(n) levels. Each node is saved
+// once under the version at which it was created and its position in the tree, and nodes which are not
+// modified are shared between versions. When a node is replaced, it is recorded as stale since the new
+// version so that pruning only deletes the nodes which are not part of the remaining versions.
+type SmtTree struct {
+	db     corestore.KVStoreWithBatch
+	logger log.Logger
+
+	// version is the version of the working tree, which is the latest saved version it was loaded from.
+	version uint64
+	// initialVersion is the version of the first commit of an empty tree, if set.
+	initialVersion uint64
+	// hash is the hash of the saved version.
+	hash []byte
+
+	// root is the root of the working tree, nil for an empty tree.
+	root *node
+	// staleNodes are the keys of the saved nodes which were replaced in the working tree.
+	staleNodes [][]byte
+}
+
+// NewSmtTree creates a new SmtTree instance storing its nodes in db.
+func NewSmtTree(db corestore.KVStoreWithBatch, logger log.Logger) *SmtTree {
+	return &SmtTree{
+		db:     db,
+		logger: logger,
+		hash:   emptyHash,
+	}
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *SmtTree) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+
+	root, err := t.set(t.root, 0, newLeaf(key, value))
+	if err != nil {
+		return err
+	}
+
+	t.root = root
+	return nil
+}
+
+// set inserts the leaf in the subtree rooted at n at depth and returns the new root of the subtree.
+func (t *SmtTree) set(n *node, depth int, leaf *node) (*node, error) {
+	n, err := t.resolve(n, depth, leaf.keyHash)
+	if err != nil || n == nil {
+		return leaf, err
+	}
+
+	if n.leaf {
+		t.markStale(n, depth, leaf.keyHash)
+		if bytes.Equal(n.keyHash, leaf.keyHash) {
+			return leaf, nil
+		}
+
+		// the leaf moves down to the depth at which both key hashes diverge, which makes it a new node
+		moved := &node{loaded: true, leaf: true, key: n.key, value: n.value, keyHash: n.keyHash, hash: n.hash}
+		return split(depth, moved, leaf)
+	}
+
+	b := bit(leaf.keyHash, depth)
+	child, err := t.set(n.children[b], depth+1, leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	inner := t.mutate(n, depth, leaf.keyHash)
+	inner.children[b] = child
+	return inner, nil
+}
+
+// split creates the inner nodes joining two leaves whose key hashes share all their bits up to depth.
+func split(depth int, a, b *node) (*node, error) {
+	if depth >= maxDepth {
+		return nil, errors.New("key hash collision")
+	}
+
+	inner := &node{loaded: true}
+	bitA, bitB := bit(a.keyHash, depth), bit(b.keyHash, depth)
+	if bitA != bitB {
+		inner.children[bitA] = a
+		inner.children[bitB] = b
+		return inner, nil
+	}
+
+	child, err := split(depth+1, a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	inner.children[bitA] = child
+	return inner, nil
+}
+
+// Remove removes the given key from the tree.
+func (t *SmtTree) Remove(key []byte) error {
+	keyHash := sha256.Sum256(key)
+	root, _, err := t.remove(t.root, 0, keyHash[:])
+	if err != nil {
+		return err
+	}
+
+	t.root = root
+	return nil
+}
+
+// remove removes the leaf with the given key hash from the subtree rooted at n at depth and returns the new
+// root of the subtree and whether the leaf was found.
+func (t *SmtTree) remove(n *node, depth int, keyHash []byte) (*node, bool, error) {
+	n, err := t.resolve(n, depth, keyHash)
+	if err != nil || n == nil {
+		return n, false, err
+	}
+
+	if n.leaf {
+		if !bytes.Equal(n.keyHash, keyHash) {
+			return n, false, nil
+		}
+
+		t.markStale(n, depth, keyHash)
+		return nil, true, nil
+	}
+
+	b := bit(keyHash, depth)
+	child, removed, err := t.remove(n.children[b], depth+1, keyHash)
+	if err != nil || !removed {
+		return n, false, err
+	}
+
+	if child != nil && !child.leaf {
+		inner := t.mutate(n, depth, keyHash)
+		inner.children[b] = child
+		return inner, true, nil
+	}
+
+	siblingPath := withBit(keyHash, depth, 1-b)
+	sibling, err := t.resolve(n.children[1-b], depth+1, siblingPath)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch {
+	case child == nil && sibling == nil:
+		return nil, true, nil
+
+	case child == nil && sibling.leaf:
+		// the sibling is the only leaf left in the subtree and moves up
+		t.markStale(n, depth, keyHash)
+		t.markStale(sibling, depth+1, siblingPath)
+		return &node{loaded: true, leaf: true, key: sibling.key, value: sibling.value, keyHash: sibling.keyHash, hash: sibling.hash}, true, nil
+
+	case child != nil && sibling == nil:
+		// the child is a leaf which moved up and is the only leaf left in the subtree
+		t.markStale(n, depth, keyHash)
+		return child, true, nil
+
+	default:
+		inner := t.mutate(n, depth, keyHash)
+		inner.children[b] = child
+		inner.children[1-b] = sibling
+		return inner, true, nil
+	}
+}
+
+// mutate returns the node to modify in place of n, which is n itself if it was created in the working
+// tree or a new copy of it otherwise.
+func (t *SmtTree) mutate(n *node, depth int, path []byte) *node {
+	if n.version == 0 {
+		n.hash = nil
+		return n
+	}
+
+	t.markStale(n, depth, path)
+	return &node{loaded: true, children: n.children}
+}
+
+// markStale records that a saved node is replaced in the working tree.
+func (t *SmtTree) markStale(n *node, depth int, path []byte) {
+	if n.version != 0 {
+		t.staleNodes = append(t.staleNodes, nodeKey(n.version, depth, path))
+	}
+}
+
+// resolve returns the loaded node of n, loading it from the database if only its version and hash are known.
+func (t *SmtTree) resolve(n *node, depth int, path []byte) (*node, error) {
+	if n == nil || n.loaded {
+		return n, nil
+	}
+
+	return t.loadNode(n.version, depth, path)
+}
+
+// loadNode loads the node saved at version at the given position.
+func (t *SmtTree) loadNode(version uint64, depth int, path []byte) (*node, error) {
+	if depth > maxDepth {
+		return nil, errors.New("tree is deeper than key hashes")
+	}
+
+	key := nodeKey(version, depth, path)
+	bz, err := t.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("node %x not found", key)
+	}
+
+	return decodeNode(version, bz)
+}
+
+// loadRoot loads the root node of a saved version, which is nil for an empty tree.
+func (t *SmtTree) loadRoot(version uint64) (*node, error) {
+	bz, err := t.db.Get(versionKey(rootPrefix, version))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+	if len(bz) == 1 && bz[0] == 0 {
+		// empty tree
+		return nil, nil
+	}
+	if len(bz) != 9 {
+		return nil, fmt.Errorf("invalid root reference of version %d", version)
+	}
+
+	return t.loadNode(binary.BigEndian.Uint64(bz[1:]), 0, nil)
+}
+
+// Hash returns the hash of the latest saved version of the tree.
+func (t *SmtTree) Hash() []byte {
+	return t.hash
+}
+
+// WorkingHash returns the working hash of the tree.
+func (t *SmtTree) WorkingHash() []byte {
+	return childHash(t.root)
+}
+
+// GetLatestVersion returns the latest version of the tree.
+func (t *SmtTree) GetLatestVersion() (uint64, error) {
+	bz, err := t.db.Get(latestVersionKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetInitialVersion sets the initial version of the database.
+func (t *SmtTree) SetInitialVersion(version uint64) error {
+	t.initialVersion = version
+	return nil
+}
+
+// LoadVersion loads the state at the given version, or at the latest version if it is zero. The versions
+// saved after it are deleted so that they can be overwritten.
+func (t *SmtTree) LoadVersion(version uint64) error {
+	latest, err := t.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if version == 0 {
+		version = latest
+	}
+	if version > latest {
+		return fmt.Errorf("version %d does not exist, the latest version is %d", version, latest)
+	}
+
+	var root *node
+	if version != 0 {
+		root, err = t.loadRoot(version)
+		if err != nil {
+			return err
+		}
+	}
+
+	if version < latest {
+		if err := t.deleteVersionsFrom(version + 1); err != nil {
+			return err
+		}
+	}
+
+	t.version = version
+	t.root = root
+	t.hash = childHash(root)
+	t.staleNodes = nil
+	return nil
+}
+
+// deleteVersionsFrom deletes all the data of the versions starting at version.
+func (t *SmtTree) deleteVersionsFrom(version uint64) error {
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	for _, prefix := range [][]byte{nodePrefix, rootPrefix, stalePrefix} {
+		if err := t.deleteRange(batch, versionKey(prefix, version), prefixEnd(prefix)); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(latestVersionKey, binary.BigEndian.AppendUint64(nil, version-1)); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// deleteRange deletes all the keys between start and end.
+func (t *SmtTree) deleteRange(batch corestore.Batch, start, end []byte) error {
+	itr, err := t.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Delete(bytes.Clone(itr.Key())); err != nil {
+			return err
+		}
+	}
+
+	return itr.Error()
+}
+
+// Commit commits the current state to the tree.
+func (t *SmtTree) Commit() ([]byte, uint64, error) {
+	version := t.version + 1
+	if version == 1 && t.initialVersion > 0 {
+		version = t.initialVersion
+	}
+
+	if err := t.commit(version); err != nil {
+		return nil, 0, err
+	}
+
+	return t.hash, version, nil
+}
+
+// commit saves the working tree as version.
+func (t *SmtTree) commit(version uint64) error {
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	if err := t.saveNode(batch, t.root, version, 0, make([]byte, hashSize)); err != nil {
+		return err
+	}
+
+	for _, key := range t.staleNodes {
+		if err := batch.Set(staleKey(version, key), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	// the root reference is the version of the root node, after a flag set for non-empty trees
+	rootRef := []byte{0}
+	if t.root != nil {
+		rootRef = binary.BigEndian.AppendUint64([]byte{1}, t.root.version)
+	}
+	if err := batch.Set(versionKey(rootPrefix, version), rootRef); err != nil {
+		return err
+	}
+
+	if err := batch.Set(latestVersionKey, binary.BigEndian.AppendUint64(nil, version)); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	t.version = version
+	t.hash = childHash(t.root)
+	t.staleNodes = nil
+	if t.root != nil {
+		// release the working tree, its nodes are loaded again when they are needed
+		t.root = &node{version: t.root.version, hash: t.hash}
+	}
+
+	return nil
+}
+
+// saveNode saves the nodes created in the working tree in the subtree rooted at n as nodes of version.
+func (t *SmtTree) saveNode(batch corestore.Batch, n *node, version uint64, depth int, path []byte) error {
+	if n == nil || n.version != 0 {
+		return nil
+	}
+
+	for b, child := range n.children {
+		if child != nil && child.version == 0 {
+			if err := t.saveNode(batch, child, version, depth+1, withBit(path, depth, b)); err != nil {
+				return err
+			}
+		}
+	}
+
+	n.computeHash()
+	n.version = version
+	return batch.Set(nodeKey(version, depth, path), n.encode())
+}
+
+// Get attempts to retrieve a value from the tree for a given version.
+func (t *SmtTree) Get(version uint64, key []byte) ([]byte, error) {
+	keyHash := sha256.Sum256(key)
+	leaf, _, err := t.findLeaf(version, keyHash[:])
+	if err != nil || leaf == nil || !bytes.Equal(leaf.key, key) {
+		return nil, err
+	}
+
+	return leaf.value, nil
+}
+
+// findLeaf returns the leaf found at the path of the key hash in the given version, if any, together with
+// the hashes of the siblings of the nodes on that path from the root.
+func (t *SmtTree) findLeaf(version uint64, keyHash []byte) (*node, [][]byte, error) {
+	n, err := t.loadRoot(version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var siblings [][]byte
+	for depth := 0; n != nil; depth++ {
+		if n.leaf {
+			return n, siblings, nil
+		}
+
+		b := bit(keyHash, depth)
+		siblings = append(siblings, childHash(n.children[1-b]))
+		n, err = t.resolve(n.children[b], depth+1, keyHash)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return nil, siblings, nil
+}
+
+// Prune prunes all versions up to and including the provided version, which must be lower than the
+// latest version. Use Clear to delete the whole tree.
+func (t *SmtTree) Prune(version uint64) error {
+	latest, err := t.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if version >= latest {
+		return fmt.Errorf("latest version %d is less than or equal to the prune version %d", latest, version)
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// nodes which became stale at version+1 at the latest are only part of the pruned versions
+	itr, err := t.db.Iterator(stalePrefix, versionKey(stalePrefix, version+2))
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		key := bytes.Clone(itr.Key())
+		if err := batch.Delete(key[len(stalePrefix)+8:]); err != nil {
+			return err
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+
+	if err := t.deleteRange(batch, rootPrefix, versionKey(rootPrefix, version+1)); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// Clear deletes all the versions of the tree, which happens when its store key is removed.
+func (t *SmtTree) Clear() error {
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	for _, prefix := range [][]byte{nodePrefix, rootPrefix, stalePrefix} {
+		if err := t.deleteRange(batch, prefix, prefixEnd(prefix)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Delete(latestVersionKey); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// GetProof returns a proof for the given key and version, which is an existence proof if the key exists and
+// a non-existence proof with the existence proofs of its neighbors in key hash order otherwise.
+func (t *SmtTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	keyHash := sha256.Sum256(key)
+	leaf, _, err := t.findLeaf(version, keyHash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if leaf != nil && bytes.Equal(leaf.key, key) {
+		exist, err := t.existenceProof(version, leaf.key)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: exist}}, nil
+	}
+
+	root, err := t.loadRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := t.neighbor(root, 0, keyHash[:], 0)
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.neighbor(root, 0, keyHash[:], 1)
+	if err != nil {
+		return nil, err
+	}
+	if left == nil && right == nil {
+		return nil, fmt.Errorf("cannot prove the absence of a key in the empty tree of version %d", version)
+	}
+
+	nonExist := &ics23.NonExistenceProof{Key: key}
+	if left != nil {
+		nonExist.Left, err = t.existenceProof(version, left.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if right != nil {
+		nonExist.Right, err = t.existenceProof(version, right.key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonExist}}, nil
+}
+
+// existenceProof returns the existence proof of a key of the given version.
+func (t *SmtTree) existenceProof(version uint64, key []byte) (*ics23.ExistenceProof, error) {
+	keyHash := sha256.Sum256(key)
+	leaf, siblings, err := t.findLeaf(version, keyHash[:])
+	if err != nil {
+		return nil, err
+	}
+	if leaf == nil || !bytes.Equal(leaf.key, key) {
+		return nil, fmt.Errorf("key %x not found in version %d", key, version)
+	}
+
+	// the path starts from the leaf
+	path := make([]*ics23.InnerOp, len(siblings))
+	for depth, sibling := range siblings {
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if bit(keyHash[:], depth) == 0 {
+			op.Prefix = innerPrefix
+			op.Suffix = sibling
+		} else {
+			op.Prefix = append(append([]byte{}, innerPrefix...), sibling...)
+		}
+		path[len(siblings)-1-depth] = op
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   key,
+		Value: leaf.value,
+		Leaf:  ics23.SmtSpec.LeafSpec,
+		Path:  path,
+	}, nil
+}
+
+// neighbor returns the leaf of the subtree rooted at n whose key hash is the closest to keyHash on the
+// left (dir 0) or on the right (dir 1) of it, if any.
+func (t *SmtTree) neighbor(n *node, depth int, keyHash []byte, dir int) (*node, error) {
+	n, err := t.resolve(n, depth, keyHash)
+	if err != nil || n == nil {
+		return nil, err
+	}
+
+	if n.leaf {
+		cmp := bytes.Compare(n.keyHash, keyHash)
+		if (dir == 0 && cmp < 0) || (dir == 1 && cmp > 0) {
+			return n, nil
+		}
+		return nil, nil
+	}
+
+	b := bit(keyHash, depth)
+	res, err := t.neighbor(n.children[b], depth+1, keyHash, dir)
+	if err != nil || res != nil || b == dir {
+		return res, err
+	}
+
+	// the whole subtree on the side of dir is beyond keyHash, the neighbor is its closest leaf
+	return t.edgeLeaf(n.children[dir], depth+1, withBit(keyHash, depth, dir), 1-dir)
+}
+
+// edgeLeaf returns the leftmost (side 0) or rightmost (side 1) leaf of the subtree rooted at n.
+func (t *SmtTree) edgeLeaf(n *node, depth int, path []byte, side int) (*node, error) {
+	n, err := t.resolve(n, depth, path)
+	if err != nil || n == nil || n.leaf {
+		return n, err
+	}
+
+	if n.children[side] != nil {
+		return t.edgeLeaf(n.children[side], depth+1, withBit(path, depth, side), side)
+	}
+	return t.edgeLeaf(n.children[1-side], depth+1, withBit(path, depth, 1-side), side)
+}
+
+// CommitmentOp returns the SMT commitment op wrapping a proof of the tree.
+func (t *SmtTree) CommitmentOp(key []byte, p *ics23.CommitmentProof) proof.CommitmentOp {
+	return proof.NewSMTCommitmentOp(key, p)
+}
+
+// Export exports the tree exporter at the given version.
+func (t *SmtTree) Export(version uint64) (commitment.Exporter, error) {
+	root, err := t.loadRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return newExporter(t, root), nil
+}
+
+// Import imports the tree importer at the given version.
+func (t *SmtTree) Import(version uint64) (commitment.Importer, error) {
+	latest, err := t.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if latest != 0 || t.root != nil {
+		return nil, errors.New("can only import into an empty tree")
+	}
+
+	return newImporter(t, version), nil
+}
+
+// Close closes the tree.
+func (t *SmtTree) Close() error {
+	return nil
+}
@@ -0,0 +1,310 @@
+package smt
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	corelog "cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2/commitment"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db corestore.KVStoreWithBatch, storeKeys, oldStoreKeys []string, logger corelog.Logger) (*commitment.CommitStore, error) {
+			multiTrees := make(map[string]commitment.Tree)
+			for _, storeKey := range storeKeys {
+				multiTrees[storeKey] = NewSmtTree(dbm.NewPrefixDB(db, []byte(storeKey)), logger)
+			}
+			oldTrees := make(map[string]commitment.Tree)
+			for _, storeKey := range oldStoreKeys {
+				oldTrees[storeKey] = NewSmtTree(dbm.NewPrefixDB(db, []byte(storeKey)), logger)
+			}
+
+			return commitment.NewCommitStore(multiTrees, oldTrees, db, logger)
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func generateTree(db corestore.KVStoreWithBatch) *SmtTree {
+	return NewSmtTree(db, coretesting.NewNopLogger())
+}
+
+func TestSmtTree(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := generateTree(db)
+	require.NoError(t, tree.LoadVersion(0))
+
+	initVersion, err := tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), initVersion)
+	require.Equal(t, emptyHash, tree.WorkingHash())
+
+	// write a batch of version 1
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+
+	workingHash := tree.WorkingHash()
+	require.NotEqual(t, emptyHash, workingHash)
+
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+	require.Equal(t, workingHash, hash)
+	require.Equal(t, hash, tree.Hash())
+
+	// write a batch of version 2
+	require.NoError(t, tree.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1.2")))
+	require.NoError(t, tree.Remove([]byte("key2")))
+	require.NoError(t, tree.Remove([]byte("unknown")))
+	hash2, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+	require.NotEqual(t, hash, hash2)
+
+	// committing without changes keeps the hash
+	hash3, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), version)
+	require.Equal(t, hash2, hash3)
+
+	// all the versions can be read
+	expectValue(t, tree, 1, "key1", "value1")
+	expectValue(t, tree, 1, "key2", "value2")
+	expectValue(t, tree, 1, "key4", "")
+	expectValue(t, tree, 3, "key1", "value1.2")
+	expectValue(t, tree, 3, "key2", "")
+	expectValue(t, tree, 3, "key4", "value4")
+
+	// the same state built in another order has the same hash
+	other := generateTree(dbm.NewMemDB())
+	require.NoError(t, other.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, other.Set([]byte("key3"), []byte("value3")))
+	require.NoError(t, other.Set([]byte("key1"), []byte("value1.2")))
+	require.Equal(t, hash3, other.WorkingHash())
+
+	// reopening the tree loads the latest version
+	tree = generateTree(db)
+	require.NoError(t, tree.LoadVersion(0))
+	require.Equal(t, hash3, tree.Hash())
+	latestVersion, err := tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), latestVersion)
+
+	// loading an older version overwrites the later ones
+	require.NoError(t, tree.LoadVersion(1))
+	require.Equal(t, hash, tree.Hash())
+	require.NoError(t, tree.Set([]byte("key5"), []byte("value5")))
+	_, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+	expectValue(t, tree, 2, "key2", "value2")
+	expectValue(t, tree, 2, "key5", "value5")
+	_, err = tree.Get(3, []byte("key1"))
+	require.Error(t, err)
+	require.Error(t, tree.LoadVersion(3))
+}
+
+func TestSmtTree_InitialVersion(t *testing.T) {
+	tree := generateTree(dbm.NewMemDB())
+	require.NoError(t, tree.SetInitialVersion(10))
+	require.NoError(t, tree.Set([]byte("key"), []byte("value")))
+
+	_, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), version)
+
+	_, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), version)
+}
+
+func TestSmtTree_Proofs(t *testing.T) {
+	tree := generateTree(dbm.NewMemDB())
+	_, err := tree.GetProof(0, []byte("key"))
+	require.Error(t, err)
+
+	r := rand.New(rand.NewSource(1))
+	keys := map[string]string{}
+	for version := uint64(1); version <= 5; version++ {
+		for i := 0; i < 50; i++ {
+			key := fmt.Sprintf("key-%d", r.Intn(200))
+			if _, ok := keys[key]; ok && r.Intn(3) == 0 {
+				require.NoError(t, tree.Remove([]byte(key)))
+				delete(keys, key)
+				continue
+			}
+
+			value := fmt.Sprintf("value-%d-%d", version, i)
+			require.NoError(t, tree.Set([]byte(key), []byte(value)))
+			keys[key] = value
+		}
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+
+	root := tree.Hash()
+	for i := 0; i < 250; i++ {
+		key := []byte(fmt.Sprintf("key-%d", i))
+		p, err := tree.GetProof(5, key)
+		require.NoError(t, err)
+
+		if value, ok := keys[string(key)]; ok {
+			require.NotNil(t, p.GetExist())
+			require.True(t, ics23.VerifyMembership(ics23.SmtSpec, root, p, key, []byte(value)))
+			require.False(t, ics23.VerifyMembership(ics23.SmtSpec, root, p, key, []byte("other")))
+		} else {
+			require.NotNil(t, p.GetNonexist())
+			require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, root, p, key))
+		}
+	}
+
+	// a tree with a single key proves the absence of any other key
+	single := generateTree(dbm.NewMemDB())
+	require.NoError(t, single.Set([]byte("key"), []byte("value")))
+	_, _, err = single.Commit()
+	require.NoError(t, err)
+	p, err := single.GetProof(1, []byte("other"))
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, single.Hash(), p, []byte("other")))
+}
+
+func TestSmtTree_Prune(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := generateTree(db)
+
+	r := rand.New(rand.NewSource(2))
+	keys := map[string]string{}
+	for version := uint64(1); version <= 20; version++ {
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("key-%d", r.Intn(100))
+			if _, ok := keys[key]; ok && r.Intn(3) == 0 {
+				require.NoError(t, tree.Remove([]byte(key)))
+				delete(keys, key)
+				continue
+			}
+
+			value := fmt.Sprintf("value-%d-%d", version, i)
+			require.NoError(t, tree.Set([]byte(key), []byte(value)))
+			keys[key] = value
+		}
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tree.Prune(10))
+	_, err := tree.Get(10, []byte("key-1"))
+	require.Error(t, err)
+	_, err = tree.Get(11, []byte("key-1"))
+	require.NoError(t, err)
+
+	require.NoError(t, tree.Prune(19))
+	for key, value := range keys {
+		expectValue(t, tree, 20, key, value)
+	}
+
+	// only the nodes of the latest version are left, which are as many as the nodes of the same state
+	// built at once
+	fresh := generateTree(dbm.NewMemDB())
+	for key, value := range keys {
+		require.NoError(t, fresh.Set([]byte(key), []byte(value)))
+	}
+	_, _, err = fresh.Commit()
+	require.NoError(t, err)
+	require.Equal(t, fresh.Hash(), tree.Hash())
+	require.Equal(t, countKeys(t, fresh.db, nodePrefix), countKeys(t, db, nodePrefix))
+	require.Equal(t, 0, countKeys(t, db, stalePrefix))
+
+	// the latest version cannot be pruned
+	require.Error(t, tree.Prune(20))
+	require.Error(t, tree.Prune(21))
+	for key, value := range keys {
+		expectValue(t, tree, 20, key, value)
+	}
+
+	// clearing the tree removes all its versions
+	require.NoError(t, tree.Clear())
+	_, err = tree.Get(20, []byte("key-1"))
+	require.Error(t, err)
+	require.Equal(t, 0, countKeys(t, db, nodePrefix))
+	require.Equal(t, 0, countKeys(t, db, rootPrefix))
+}
+
+func TestSmtTree_ExportImport(t *testing.T) {
+	tree := generateTree(dbm.NewMemDB())
+	for i := 0; i < 25_000; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	_, _, err := tree.Commit()
+	require.NoError(t, err)
+	require.NoError(t, tree.Remove([]byte("key-0")))
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	exporter, err := tree.Export(version)
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	target := generateTree(dbm.NewMemDB())
+	importer, err := target.Import(version)
+	require.NoError(t, err)
+	defer importer.Close()
+
+	count := 0
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, commitment.ErrorExportDone) {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(item))
+		count++
+	}
+	require.Equal(t, 24_999, count)
+	require.NoError(t, importer.Commit())
+
+	require.NoError(t, target.LoadVersion(version))
+	require.Equal(t, hash, target.Hash())
+	expectValue(t, target, version, "key-1", "value-1")
+	expectValue(t, target, version, "key-0", "")
+
+	// importing into a tree which is not empty fails
+	_, err = target.Import(version + 1)
+	require.Error(t, err)
+}
+
+func expectValue(t *testing.T, tree *SmtTree, version uint64, key, value string) {
+	t.Helper()
+	got, err := tree.Get(version, []byte(key))
+	require.NoError(t, err)
+	if value == "" {
+		require.Nil(t, got)
+	} else {
+		require.Equal(t, []byte(value), got)
+	}
+}
+
+func countKeys(t *testing.T, db corestore.KVStore, prefix []byte) int {
+	t.Helper()
+	itr, err := db.Iterator(prefix, prefixEnd(prefix))
+	require.NoError(t, err)
+	defer itr.Close()
+
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+	return count
+}
@@ -6,13 +6,16 @@ import (
 	"io"
 	"maps"
 	"math"
+	"runtime"
 	"slices"
 
 	protoio "github.com/cosmos/gogoproto/io"
+	"golang.org/x/sync/errgroup"
 
 	corelog "cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/internal"
 	"cosmossdk.io/store/v2/internal/conv"
 	"cosmossdk.io/store/v2/proof"
@@ -24,6 +27,7 @@ var (
 	_ store.Committer             = (*CommitStore)(nil)
 	_ store.UpgradeableStore      = (*CommitStore)(nil)
 	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
+	_ snapshots.StoreSnapshotter  = (*CommitStore)(nil)
 	_ store.PausablePruner        = (*CommitStore)(nil)
 )
 
@@ -43,55 +47,108 @@ type CommitStore struct {
 	// oldTrees is a map of store keys to old trees that have been deleted or renamed.
 	// It is used to get the proof for the old store keys.
 	oldTrees map[string]Tree
+	// maxConcurrency is the maximum number of trees written, hashed or committed
+	// concurrently.
+	maxConcurrency int
+	// keepCommitInfo is whether the commit info of the pruned versions is kept.
+	keepCommitInfo bool
+	// removedStoreKeys are the store keys removed by the last upgrade at
+	// removedVersion. They are flushed along with the commit info of the next
+	// version, so that the old trees are kept if the upgrade is not committed.
+	removedStoreKeys []string
+	removedVersion   uint64
 }
 
 // NewCommitStore creates a new CommitStore instance.
 func NewCommitStore(trees, oldTrees map[string]Tree, db corestore.KVStoreWithBatch, logger corelog.Logger) (*CommitStore, error) {
 	return &CommitStore{
-		logger:     logger,
-		multiTrees: trees,
-		oldTrees:   oldTrees,
-		metadata:   NewMetadataStore(db),
+		logger:         logger,
+		multiTrees:     trees,
+		oldTrees:       oldTrees,
+		metadata:       NewMetadataStore(db),
+		maxConcurrency: runtime.NumCPU(),
 	}, nil
 }
 
+// forEachTree calls fn for each of the given store keys with its index, running
+// at most maxConcurrency calls at a time. It returns the first error returned by fn.
+func (c *CommitStore) forEachTree(storeKeys []string, fn func(i int, storeKey string) error) error {
+	eg := new(errgroup.Group)
+	eg.SetLimit(c.maxConcurrency)
+	for i, storeKey := range storeKeys {
+		eg.Go(func() error {
+			return fn(i, storeKey)
+		})
+	}
+
+	return eg.Wait()
+}
+
+// committedStoreKeys returns the sorted store keys of the trees which are part of
+// the commit info, i.e. all the trees but the memory stores.
+func (c *CommitStore) committedStoreKeys() []string {
+	storeKeys := make([]string, 0, len(c.multiTrees))
+	for storeKey := range c.multiTrees {
+		if internal.IsMemoryStoreKey(storeKey) {
+			continue
+		}
+		storeKeys = append(storeKeys, storeKey)
+	}
+	slices.Sort(storeKeys)
+
+	return storeKeys
+}
+
+// WriteChangeset writes the changeset to the trees, applying the changes of
+// different trees concurrently and the changes of each tree in order.
 func (c *CommitStore) WriteChangeset(cs *corestore.Changeset) error {
+	storeKeys := make([]string, 0, len(cs.Changes))
+	changes := make(map[string][]corestore.KVPairs, len(cs.Changes))
 	for _, pairs := range cs.Changes {
 		key := conv.UnsafeBytesToStr(pairs.Actor)
-
-		tree, ok := c.multiTrees[key]
-		if !ok {
+		if _, ok := c.multiTrees[key]; !ok {
 			return fmt.Errorf("store key %s not found in multiTrees", key)
 		}
-		for _, kv := range pairs.StateChanges {
-			if kv.Remove {
-				if err := tree.Remove(kv.Key); err != nil {
+		if _, ok := changes[key]; !ok {
+			storeKeys = append(storeKeys, key)
+		}
+		changes[key] = append(changes[key], pairs.StateChanges)
+	}
+
+	return c.forEachTree(storeKeys, func(_ int, storeKey string) error {
+		tree := c.multiTrees[storeKey]
+		for _, kvPairs := range changes[storeKey] {
+			for _, kv := range kvPairs {
+				if kv.Remove {
+					if err := tree.Remove(kv.Key); err != nil {
+						return err
+					}
+				} else if err := tree.Set(kv.Key, kv.Value); err != nil {
 					return err
 				}
-			} else if err := tree.Set(kv.Key, kv.Value); err != nil {
-				return err
 			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
+// WorkingCommitInfo returns the commit info of the working trees at the given
+// version, hashing the trees concurrently. The store infos are sorted by store key.
 func (c *CommitStore) WorkingCommitInfo(version uint64) *proof.CommitInfo {
-	storeInfos := make([]proof.StoreInfo, 0, len(c.multiTrees))
-	for storeKey, tree := range c.multiTrees {
-		if internal.IsMemoryStoreKey(storeKey) {
-			continue
-		}
-		bz := []byte(storeKey)
-		storeInfos = append(storeInfos, proof.StoreInfo{
-			Name: bz,
+	storeKeys := c.committedStoreKeys()
+	storeInfos := make([]proof.StoreInfo, len(storeKeys))
+	// computing the working hash never fails
+	_ = c.forEachTree(storeKeys, func(i int, storeKey string) error {
+		storeInfos[i] = proof.StoreInfo{
+			Name: []byte(storeKey),
 			CommitID: proof.CommitID{
 				Version: version,
-				Hash:    tree.WorkingHash(),
+				Hash:    c.multiTrees[storeKey].WorkingHash(),
 			},
-		})
-	}
+		}
+		return nil
+	})
 
 	return &proof.CommitInfo{
 		Version:    version,
@@ -104,6 +161,7 @@ func (c *CommitStore) LoadVersion(targetVersion uint64) error {
 	for storeKey := range c.multiTrees {
 		storeKeys = append(storeKeys, storeKey)
 	}
+	c.removedStoreKeys, c.removedVersion = nil, 0
 	return c.loadVersion(targetVersion, storeKeys)
 }
 
@@ -112,21 +170,29 @@ func (c *CommitStore) LoadVersionAndUpgrade(targetVersion uint64, upgrades *core
 	// deterministic iteration order for upgrades (as the underlying store may change and
 	// upgrades make store changes where the execution order may matter)
 	storeKeys := slices.Sorted(maps.Keys(c.multiTrees))
+	// the removed trees are kept as old trees, their data is only pruned once the
+	// removal is committed
 	removeTree := func(storeKey string) error {
-		if oldTree, ok := c.multiTrees[storeKey]; ok {
-			if err := oldTree.Close(); err != nil {
-				return err
-			}
-			delete(c.multiTrees, storeKey)
+		oldTree, ok := c.multiTrees[storeKey]
+		if !ok {
+			return nil
+		}
+		delete(c.multiTrees, storeKey)
+		if _, ok := c.oldTrees[storeKey]; ok {
+			return oldTree.Close()
+		}
+		if c.oldTrees == nil {
+			c.oldTrees = make(map[string]Tree)
 		}
+		c.oldTrees[storeKey] = oldTree
 		return nil
 	}
 
 	newStoreKeys := make([]string, 0, len(c.multiTrees))
 	removedStoreKeys := make([]string, 0)
 	for _, storeKey := range storeKeys {
-		// If it has been deleted, remove the tree.
-		if upgrades.IsDeleted(storeKey) {
+		// If it has been deleted or renamed, remove the tree.
+		if upgrades.IsDeleted(storeKey) || upgrades.IsRenamed(storeKey) {
 			if err := removeTree(storeKey); err != nil {
 				return err
 			}
@@ -134,8 +200,9 @@ func (c *CommitStore) LoadVersionAndUpgrade(targetVersion uint64, upgrades *core
 			continue
 		}
 
-		// If it has been added, set the initial version.
-		if upgrades.IsAdded(storeKey) {
+		// If it has been added or renamed to, set the initial version, its data
+		// is written by the root store along with the first commit.
+		if upgrades.IsAdded(storeKey) || upgrades.RenamedFrom(storeKey) != "" {
 			if err := c.multiTrees[storeKey].SetInitialVersion(targetVersion + 1); err != nil {
 				return err
 			}
@@ -146,11 +213,12 @@ func (c *CommitStore) LoadVersionAndUpgrade(targetVersion uint64, upgrades *core
 		newStoreKeys = append(newStoreKeys, storeKey)
 	}
 
-	if err := c.metadata.flushRemovedStoreKeys(targetVersion, removedStoreKeys); err != nil {
+	if err := c.loadVersion(targetVersion, newStoreKeys); err != nil {
 		return err
 	}
+	c.removedStoreKeys, c.removedVersion = removedStoreKeys, targetVersion
 
-	return c.loadVersion(targetVersion, newStoreKeys)
+	return nil
 }
 
 func (c *CommitStore) loadVersion(targetVersion uint64, storeKeys []string) error {
@@ -180,26 +248,27 @@ func (c *CommitStore) loadVersion(targetVersion uint64, storeKeys []string) erro
 	// restore case, we should create a new commit info for the target version.
 	if targetVersion > latestVersion {
 		cInfo := c.WorkingCommitInfo(targetVersion)
-		return c.metadata.flushCommitInfo(targetVersion, cInfo)
+		return c.metadata.flushCommitInfo(targetVersion, cInfo, 0, nil)
 	}
 
 	return nil
 }
 
+// Commit commits the trees concurrently and flushes the commit info of the given
+// version. The store infos are sorted by store key.
 func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
-	storeInfos := make([]proof.StoreInfo, 0, len(c.multiTrees))
+	storeKeys := c.committedStoreKeys()
+	storeInfos := make([]proof.StoreInfo, len(storeKeys))
 
-	for storeKey, tree := range c.multiTrees {
-		if internal.IsMemoryStoreKey(storeKey) {
-			continue
-		}
+	err := c.forEachTree(storeKeys, func(i int, storeKey string) error {
+		tree := c.multiTrees[storeKey]
 		// If a commit event execution is interrupted, a new iavl store's version
 		// will be larger than the RMS's metadata, when the block is replayed, we
 		// should avoid committing that iavl store again.
 		var commitID proof.CommitID
 		v, err := tree.GetLatestVersion()
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if v >= version {
 			commitID.Version = version
@@ -207,20 +276,25 @@ func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
 		} else {
 			hash, cversion, err := tree.Commit()
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if cversion != version {
-				return nil, fmt.Errorf("commit version %d does not match the target version %d", cversion, version)
+				return fmt.Errorf("commit version %d does not match the target version %d", cversion, version)
 			}
 			commitID = proof.CommitID{
 				Version: version,
 				Hash:    hash,
 			}
 		}
-		storeInfos = append(storeInfos, proof.StoreInfo{
+		storeInfos[i] = proof.StoreInfo{
 			Name:     []byte(storeKey),
 			CommitID: commitID,
-		})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	cInfo := &proof.CommitInfo{
@@ -228,9 +302,12 @@ func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
 		StoreInfos: storeInfos,
 	}
 
-	if err := c.metadata.flushCommitInfo(version, cInfo); err != nil {
+	// the store keys removed by the last upgrade are flushed atomically with the
+	// commit info of the upgrade
+	if err := c.metadata.flushCommitInfo(version, cInfo, c.removedVersion, c.removedStoreKeys); err != nil {
 		return nil, err
 	}
+	c.removedStoreKeys, c.removedVersion = nil, 0
 
 	return cInfo, nil
 }
@@ -255,6 +332,14 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 		}
 	}
 
+	prunedVersion, err := c.metadata.GetPrunedVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version <= prunedVersion {
+		return nil, storeerrors.ErrVersionPruned{RequestedVersion: version, EarliestVersion: prunedVersion + 1}
+	}
+
 	iProof, err := tree.GetProof(version, key)
 	if err != nil {
 		return nil, err
@@ -267,6 +352,9 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 		return nil, fmt.Errorf("commit info not found for version %d", version)
 	}
 	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
+	if provider, ok := tree.(CommitmentOpProvider); ok {
+		commitOp = provider.CommitmentOp(key, iProof)
+	}
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
@@ -289,12 +377,30 @@ func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte,
 	return bz, nil
 }
 
+// SetKeepCommitInfo sets whether the commit info of the pruned versions is kept,
+// so that it can be returned along with the queries of these versions, which
+// can no longer be proven.
+func (c *CommitStore) SetKeepCommitInfo(keep bool) {
+	c.keepCommitInfo = keep
+}
+
+// SetMaxConcurrency sets the maximum number of trees written, hashed or
+// committed concurrently. A value of 0 or less uses the number of CPUs.
+func (c *CommitStore) SetMaxConcurrency(n int) {
+	if n <= 0 {
+		n = runtime.NumCPU()
+	}
+	c.maxConcurrency = n
+}
+
 // Prune implements store.Pruner.
 func (c *CommitStore) Prune(version uint64) error {
 	// prune the metadata
-	for v := version; v > 0; v-- {
-		if err := c.metadata.deleteCommitInfo(v); err != nil {
-			return err
+	if !c.keepCommitInfo {
+		for v := version; v > 0; v-- {
+			if err := c.metadata.deleteCommitInfo(v); err != nil {
+				return err
+			}
 		}
 	}
 	// prune the trees
@@ -308,7 +414,7 @@ func (c *CommitStore) Prune(version uint64) error {
 		return err
 	}
 
-	return nil
+	return c.metadata.setPrunedVersion(version)
 }
 
 func (c *CommitStore) pruneRemovedStoreKeys(version uint64) error {
@@ -317,6 +423,14 @@ func (c *CommitStore) pruneRemovedStoreKeys(version uint64) error {
 		if !ok {
 			return fmt.Errorf("store %s not found in oldTrees", storeKey)
 		}
+		// the tree is only cleared if its store key was not added again since it was removed
+		latest, err := tree.GetLatestVersion()
+		if err != nil {
+			return err
+		}
+		if clearer, ok := tree.(Clearer); ok && latest <= version {
+			return clearer.Clear()
+		}
 		return tree.Prune(version)
 	}
 	return c.metadata.deleteRemovedStoreKeys(version, clearKVStore)
@@ -346,47 +460,92 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 	}
 
 	for storeKey, tree := range c.multiTrees {
-		// TODO: check the parallelism of this loop
-		if err := func() error {
-			exporter, err := tree.Export(version)
-			if err != nil {
-				return fmt.Errorf("failed to export tree for version %d: %w", version, err)
-			}
-			defer exporter.Close()
+		if err := exportTree(tree, storeKey, version, protoWriter); err != nil {
+			return err
+		}
+	}
 
-			err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-				Item: &snapshotstypes.SnapshotItem_Store{
-					Store: &snapshotstypes.SnapshotStoreItem{
-						Name: storeKey,
-					},
-				},
-			})
-			if err != nil {
-				return fmt.Errorf("failed to write store name: %w", err)
-			}
+	return nil
+}
 
-			for {
-				item, err := exporter.Next()
-				if errors.Is(err, ErrorExportDone) {
-					break
-				} else if err != nil {
-					return fmt.Errorf("failed to get the next export node: %w", err)
-				}
+// SnapshotStore implements snapshots.StoreSnapshotter.
+func (c *CommitStore) SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
 
-				if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-					Item: &snapshotstypes.SnapshotItem_IAVL{
-						IAVL: item,
-					},
-				}); err != nil {
-					return fmt.Errorf("failed to write iavl node: %w", err)
-				}
-			}
+	return exportTree(tree, storeKey, version, protoWriter)
+}
+
+// IterateStore calls fn with each key/value pair of the tree of the given store
+// key at the given version, in the export order of the tree.
+func (c *CommitStore) IterateStore(storeKey string, version uint64, fn func(key, value []byte) error) error {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
 
+	exporter, err := tree.Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree for version %d: %w", version, err)
+	}
+	defer exporter.Close()
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
 			return nil
-		}(); err != nil {
+		} else if err != nil {
+			return fmt.Errorf("failed to get the next export node: %w", err)
+		}
+
+		// only the leaves hold key/value pairs
+		if item.Height != 0 {
+			continue
+		}
+		if err := fn(item.Key, item.Value); err != nil {
 			return err
 		}
 	}
+}
+
+// exportTree writes the store item of the tree followed by its exported nodes
+// at the given version.
+func exportTree(tree Tree, storeKey string, version uint64, protoWriter protoio.Writer) error {
+	exporter, err := tree.Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree for version %d: %w", version, err)
+	}
+	defer exporter.Close()
+
+	err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+		Item: &snapshotstypes.SnapshotItem_Store{
+			Store: &snapshotstypes.SnapshotStoreItem{
+				Name: storeKey,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write store name: %w", err)
+	}
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to get the next export node: %w", err)
+		}
+
+		if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_IAVL{
+				IAVL: item,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to write iavl node: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -440,35 +599,8 @@ loop:
 			if importer == nil {
 				return snapshotstypes.SnapshotItem{}, errors.New("received IAVL node item before store item")
 			}
-			node := item.IAVL
-			if node.Height > int32(math.MaxInt8) {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 {
-				if node.Value == nil {
-					node.Value = []byte{}
-				}
-
-				// If the node is a leaf node, it will be written to the storage.
-				chStorage <- &corestore.StateChanges{
-					Actor: storeKey,
-					StateChanges: []corestore.KVPair{
-						{
-							Key:   node.Key,
-							Value: node.Value,
-						},
-					},
-				}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
+			if err := importNode(importer, storeKey, item.IAVL, chStorage); err != nil {
+				return snapshotstypes.SnapshotItem{}, err
 			}
 		default:
 			break loop
@@ -484,6 +616,105 @@ loop:
 	return snapshotItem, c.LoadVersion(version)
 }
 
+// RestoreStore implements snapshots.StoreSnapshotter. It restores the tree of the
+// store from a stream written by SnapshotStore and returns the restored hash.
+func (c *CommitStore) RestoreStore(
+	version uint64,
+	storeKey string,
+	protoReader protoio.Reader,
+	chStorage chan<- *corestore.StateChanges,
+) ([]byte, error) {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return nil, fmt.Errorf("store %s not found", storeKey)
+	}
+
+	var snapshotItem snapshotstypes.SnapshotItem
+	if err := protoReader.ReadMsg(&snapshotItem); err != nil {
+		return nil, fmt.Errorf("invalid protobuf message: %w", err)
+	}
+	if item := snapshotItem.GetStore(); item == nil || item.Name != storeKey {
+		return nil, fmt.Errorf("expected the store item of %s, got %v", storeKey, snapshotItem.Item)
+	}
+
+	importer, err := tree.Import(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import tree for version %d: %w", version, err)
+	}
+	defer importer.Close()
+
+	for {
+		snapshotItem = snapshotstypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		node := snapshotItem.GetIAVL()
+		if node == nil {
+			return nil, fmt.Errorf("unexpected snapshot item %T in store %s", snapshotItem.Item, storeKey)
+		}
+		if err := importNode(importer, []byte(storeKey), node, chStorage); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit importer: %w", err)
+	}
+	if err := tree.LoadVersion(version); err != nil {
+		return nil, err
+	}
+
+	return tree.Hash(), nil
+}
+
+// FinalizeRestore implements snapshots.StoreSnapshotter. It saves the commit info
+// of the restored version, of which the store hashes were verified, and loads it.
+func (c *CommitStore) FinalizeRestore(version uint64, commitInfo *proof.CommitInfo) error {
+	if err := c.metadata.flushCommitInfo(version, commitInfo, 0, nil); err != nil {
+		return err
+	}
+
+	return c.LoadVersion(version)
+}
+
+// importNode adds an exported node to the importer, and sends the leaf nodes to
+// the storage.
+func importNode(importer Importer, storeKey []byte, node *snapshotstypes.SnapshotIAVLItem, chStorage chan<- *corestore.StateChanges) error {
+	if node.Height > int32(math.MaxInt8) {
+		return fmt.Errorf("node height %v cannot exceed %v", node.Height, math.MaxInt8)
+	}
+	// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 {
+		if node.Value == nil {
+			node.Value = []byte{}
+		}
+
+		// If the node is a leaf node, it will be written to the storage.
+		chStorage <- &corestore.StateChanges{
+			Actor: storeKey,
+			StateChanges: []corestore.KVPair{
+				{
+					Key:   node.Key,
+					Value: node.Value,
+				},
+			},
+		}
+	}
+	if err := importer.Add(node); err != nil {
+		return fmt.Errorf("failed to add node to importer: %w", err)
+	}
+
+	return nil
+}
+
 func (c *CommitStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
 	return c.metadata.GetCommitInfo(version)
 }
@@ -498,6 +729,11 @@ func (c *CommitStore) Close() error {
 			return err
 		}
 	}
+	for _, tree := range c.oldTrees {
+		if err := tree.Close(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"runtime"
 	"sync"
 
 	"github.com/stretchr/testify/suite"
@@ -13,6 +14,7 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/store/v2"
 	dbm "cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
@@ -125,6 +127,81 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_StoreSnapshotter() {
+	storeKeys := []string{storeKey1, storeKey2, storeKey3}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(5)
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			for j := 0; j < 10; j++ {
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{
+					Key:   []byte(fmt.Sprintf("key-%d-%d", i, j)),
+					Value: []byte(fmt.Sprintf("%s-value-%d-%d", storeKey, i, j)),
+				})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+	cInfo, err := commitStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	// each store is snapshotted and restored independently
+	chStorage := make(chan *corestore.StateChanges, 1000)
+	for _, storeInfo := range cInfo.StoreInfos {
+		chunks := make(chan io.ReadCloser, 10)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			s.Require().NotNil(streamWriter)
+			defer streamWriter.Close()
+			s.Require().NoError(commitStore.SnapshotStore(latestVersion, string(storeInfo.Name), streamWriter))
+		}()
+
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		s.Require().NoError(err)
+		hash, err := targetStore.RestoreStore(latestVersion, string(storeInfo.Name), streamReader, chStorage)
+		s.Require().NoError(err)
+		s.Require().NoError(streamReader.Close())
+		s.Require().Equal(storeInfo.GetHash(), hash)
+	}
+	close(chStorage)
+	leaves := 0
+	for kv := range chStorage {
+		leaves += len(kv.StateChanges)
+	}
+	s.Require().Equal(len(storeKeys)*10*int(latestVersion), leaves)
+
+	s.Require().NoError(targetStore.FinalizeRestore(latestVersion, cInfo))
+	targetVersion, err := targetStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(latestVersion, targetVersion)
+	targetCommitInfo, err := targetStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+	s.Require().Equal(cInfo.Hash(), targetCommitInfo.Hash())
+
+	// restoring a stream of another store fails
+	chunks := make(chan io.ReadCloser, 10)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		defer streamWriter.Close()
+		_ = commitStore.SnapshotStore(latestVersion, storeKey1, streamWriter)
+	}()
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	s.Require().NoError(err)
+	defer streamReader.Close()
+	emptyStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	_, err = emptyStore.RestoreStore(latestVersion, storeKey2, streamReader, make(chan *corestore.StateChanges, 1000))
+	s.Require().Error(err)
+}
+
 func (s *CommitStoreTestSuite) TestStore_LoadVersion() {
 	storeKeys := []string{storeKey1, storeKey2}
 	mdb := dbm.NewMemDB()
@@ -258,12 +335,50 @@ func (s *CommitStoreTestSuite) TestStore_GetProof() {
 
 	// check if proof for version 1 is pruned
 	_, err = commitStore.GetProof([]byte(storeKeys[0]), 1, []byte(fmt.Sprintf("key-%d-%d", 1, 0)))
-	s.Require().Error(err)
+	s.Require().ErrorAs(err, &storeerrors.ErrVersionPruned{})
 	// check the commit info
 	commit, _ := commitStore.GetCommitInfo(1)
 	s.Require().Nil(commit)
 }
 
+func (s *CommitStoreTestSuite) TestStore_KeepCommitInfo() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	commitStore.SetKeepCommitInfo(true)
+
+	toVersion := uint64(10)
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("value-%d", version)), false)
+		}
+		s.Require().NoError(commitStore.WriteChangeset(cs))
+		_, err = commitStore.Commit(version)
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(commitStore.Prune(5))
+	prunedVersion, err := commitStore.metadata.GetPrunedVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), prunedVersion)
+
+	for version := uint64(1); version <= toVersion; version++ {
+		// the commit info of the pruned versions is kept
+		commitInfo, err := commitStore.GetCommitInfo(version)
+		s.Require().NoError(err)
+		s.Require().NotNil(commitInfo)
+		s.Require().Equal(version, commitInfo.Version)
+
+		_, err = commitStore.GetProof([]byte(storeKey1), version, []byte(fmt.Sprintf("key-%d", version)))
+		if version <= prunedVersion {
+			s.Require().Equal(storeerrors.ErrVersionPruned{RequestedVersion: version, EarliestVersion: 6}, err)
+		} else {
+			s.Require().NoError(err)
+		}
+	}
+}
+
 func (s *CommitStoreTestSuite) TestStore_Get() {
 	storeKeys := []string{storeKey1, storeKey2}
 	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
@@ -298,6 +413,58 @@ func (s *CommitStoreTestSuite) TestStore_Get() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_CommitManyStores() {
+	storeKeys := make([]string, 25)
+	for i := range storeKeys {
+		storeKeys[i] = fmt.Sprintf("store%02d", len(storeKeys)-i)
+	}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	for version := uint64(1); version <= 3; version++ {
+		// the changes of a store may be split across several entries, which must be applied in order
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Changes = append(cs.Changes, corestore.StateChanges{
+				Actor: []byte(storeKey),
+				StateChanges: corestore.KVPairs{
+					{Key: []byte("key"), Value: []byte("old")},
+					{Key: []byte(fmt.Sprintf("key-%d", version)), Value: []byte("value")},
+				},
+			})
+		}
+		for _, storeKey := range storeKeys {
+			cs.Changes = append(cs.Changes, corestore.StateChanges{
+				Actor:        []byte(storeKey),
+				StateChanges: corestore.KVPairs{{Key: []byte("key"), Value: []byte(fmt.Sprintf("value-%d", version))}},
+			})
+		}
+		s.Require().NoError(commitStore.WriteChangeset(cs))
+
+		workingInfo := commitStore.WorkingCommitInfo(version)
+		commitInfo, err := commitStore.Commit(version)
+		s.Require().NoError(err)
+		s.Require().Equal(workingInfo.Hash(), commitInfo.Hash())
+
+		// the store infos are sorted by store key
+		s.Require().Len(commitInfo.StoreInfos, len(storeKeys))
+		for i := 1; i < len(commitInfo.StoreInfos); i++ {
+			s.Require().Equal(-1, bytes.Compare(commitInfo.StoreInfos[i-1].Name, commitInfo.StoreInfos[i].Name))
+		}
+
+		for _, storeKey := range storeKeys {
+			val, err := commitStore.Get([]byte(storeKey), version, []byte("key"))
+			s.Require().NoError(err)
+			s.Require().Equal([]byte(fmt.Sprintf("value-%d", version)), val)
+		}
+	}
+
+	// unknown store keys are rejected
+	cs := corestore.NewChangeset()
+	cs.Add([]byte("unknown"), []byte("key"), []byte("value"), false)
+	s.Require().Error(commitStore.WriteChangeset(cs))
+}
+
 func (s *CommitStoreTestSuite) TestStore_Upgrades() {
 	storeKeys := []string{storeKey1, storeKey2, storeKey3}
 	commitDB := dbm.NewMemDB()
@@ -465,3 +632,32 @@ func (s *CommitStoreTestSuite) TestStore_Upgrades() {
 		}
 	}
 }
+
+func (s *CommitStoreTestSuite) TestStore_MaxConcurrency() {
+	storeKeys := []string{"store1", "store2", "store3"}
+
+	var hashes [][]byte
+	for _, n := range []int{1, 2, 0} {
+		commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+		s.Require().NoError(err)
+		commitStore.SetMaxConcurrency(n)
+		if n == 0 {
+			s.Require().Equal(runtime.NumCPU(), commitStore.maxConcurrency)
+		} else {
+			s.Require().Equal(n, commitStore.maxConcurrency)
+		}
+
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte("key"), []byte("value"), false)
+		}
+		s.Require().NoError(commitStore.WriteChangeset(cs))
+		commitInfo, err := commitStore.Commit(1)
+		s.Require().NoError(err)
+		hashes = append(hashes, commitInfo.Hash())
+	}
+
+	// the commit hash doesn't depend on the concurrency
+	s.Require().Equal(hashes[0], hashes[1])
+	s.Require().Equal(hashes[0], hashes[2])
+}
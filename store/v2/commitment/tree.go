@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	"cosmossdk.io/store/v2/proof"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -42,6 +43,18 @@ type Tree interface {
 	io.Closer
 }
 
+// CommitmentOpProvider is an optional interface for trees whose proofs are not IAVL proofs, which returns
+// the commitment op of the corresponding proof type wrapping a proof generated by the tree.
+type CommitmentOpProvider interface {
+	CommitmentOp(key []byte, proof *ics23.CommitmentProof) proof.CommitmentOp
+}
+
+// Clearer is an optional interface for trees which can delete all their versions at once. The CommitStore
+// clears the trees of removed store keys instead of pruning them.
+type Clearer interface {
+	Clear() error
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
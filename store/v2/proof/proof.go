@@ -14,6 +14,11 @@ const (
 	ProofOpIAVLCommitment         = "ics23:iavl"
 	ProofOpSimpleMerkleCommitment = "ics23:simple"
 	ProofOpSMTCommitment          = "ics23:smt"
+
+	// ProofOpUnprovable marks the result of a query of a version which the SC
+	// backend can no longer prove, and carries the commit info of the version if
+	// it is kept.
+	ProofOpUnprovable = "unprovable"
 )
 
 var (
@@ -0,0 +1,194 @@
+package root
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/v2"
+)
+
+// checkContextInterval is the number of keys checked between checks of the context.
+const checkContextInterval = 1000
+
+// StoreIterator is implemented by the SC backends which can iterate over the
+// key/value pairs of a store at a given version, e.g. commitment.CommitStore.
+type StoreIterator interface {
+	IterateStore(storeKey string, version uint64, fn func(key, value []byte) error) error
+}
+
+// Divergence is a key of a store whose value differs between the SS and SC
+// backends. A nil value means the key is missing from the backend.
+type Divergence struct {
+	StoreKey string
+	Key      []byte
+	SSValue  []byte
+	SCValue  []byte
+}
+
+func (d Divergence) String() string {
+	switch {
+	case d.SSValue == nil:
+		return fmt.Sprintf("store %s key %X: missing from SS, SC value %X", d.StoreKey, d.Key, d.SCValue)
+	case d.SCValue == nil:
+		return fmt.Sprintf("store %s key %X: missing from SC, SS value %X", d.StoreKey, d.Key, d.SSValue)
+	default:
+		return fmt.Sprintf("store %s key %X: SS value %X, SC value %X", d.StoreKey, d.Key, d.SSValue, d.SCValue)
+	}
+}
+
+// ConsistencyReport is the result of a consistency check between the SS and SC
+// backends at a given version.
+type ConsistencyReport struct {
+	Version uint64
+	// Keys is the number of keys of each store in the SC backend.
+	Keys map[string]uint64
+	// Divergences are the keys whose values differ between the backends.
+	Divergences []Divergence
+	// Truncated is true if the check stopped after reaching the maximum number
+	// of divergences, in which case the other stores were not checked.
+	Truncated bool
+}
+
+// Consistent returns true if no divergence was found.
+func (r *ConsistencyReport) Consistent() bool {
+	return len(r.Divergences) == 0
+}
+
+// CheckConsistency verifies that every key/value pair of the stores of the commit
+// info of the given version is the same in the SS and SC backends of the root
+// store. It stops after finding maxDivergences divergences, 0 meaning no limit.
+//
+// The check only reads the given version, so it can run while new versions are
+// committed as long as the version is not pruned. The SC backend must implement
+// StoreIterator.
+func CheckConsistency(ctx context.Context, rs store.RootStore, version uint64, maxDivergences int) (*ConsistencyReport, error) {
+	ss := rs.GetStateStorage()
+	sc := rs.GetStateCommitment()
+	iterator, ok := sc.(StoreIterator)
+	if !ok {
+		return nil, errors.New("SC store does not support iterating over stores")
+	}
+
+	commitInfo, err := sc.GetCommitInfo(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info for version %d: %w", version, err)
+	}
+	if commitInfo == nil {
+		return nil, fmt.Errorf("commit info not found for version %d", version)
+	}
+
+	report := &ConsistencyReport{
+		Version: version,
+		Keys:    make(map[string]uint64, len(commitInfo.StoreInfos)),
+	}
+	// errTruncated stops the iterations once the maximum number of divergences is reached
+	errTruncated := errors.New("too many divergences")
+	diverge := func(d Divergence) error {
+		report.Divergences = append(report.Divergences, d)
+		if maxDivergences > 0 && len(report.Divergences) >= maxDivergences {
+			report.Truncated = true
+			return errTruncated
+		}
+		return nil
+	}
+
+	for _, storeInfo := range commitInfo.StoreInfos {
+		err := checkStoreConsistency(ctx, ss, sc, iterator, string(storeInfo.Name), version, report, diverge)
+		if errors.Is(err, errTruncated) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to check store %s: %w", storeInfo.Name, err)
+		}
+	}
+
+	return report, nil
+}
+
+// checkStoreConsistency compares the key/value pairs of a store in the SC backend
+// with the SS backend, then counts the keys of the SS backend to find the keys
+// missing from the SC backend.
+func checkStoreConsistency(
+	ctx context.Context,
+	ss store.VersionedDatabase,
+	sc store.Committer,
+	iterator StoreIterator,
+	storeKey string,
+	version uint64,
+	report *ConsistencyReport,
+	diverge func(Divergence) error,
+) error {
+	// missingFromSS counts the keys of the SC backend which are not in the SS backend
+	var scKeys, missingFromSS uint64
+	err := iterator.IterateStore(storeKey, version, func(key, value []byte) error {
+		scKeys++
+		if scKeys%checkContextInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		ssValue, err := ss.Get([]byte(storeKey), version, key)
+		if err != nil {
+			return err
+		}
+		if ssValue == nil {
+			missingFromSS++
+		}
+		if !bytes.Equal(ssValue, value) {
+			return diverge(Divergence{StoreKey: storeKey, Key: bytes.Clone(key), SSValue: ssValue, SCValue: bytes.Clone(value)})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	report.Keys[storeKey] = scKeys
+
+	// the SS backend holds the keys of the SC backend but the missing ones, so it
+	// has extra keys if it has more keys than these
+	var ssKeys uint64
+	err = iterateStateStorage(ctx, ss, storeKey, version, func(_, _ []byte) error {
+		ssKeys++
+		return nil
+	})
+	if err != nil || ssKeys <= scKeys-missingFromSS {
+		return err
+	}
+
+	return iterateStateStorage(ctx, ss, storeKey, version, func(key, value []byte) error {
+		scValue, err := sc.Get([]byte(storeKey), version, key)
+		if err != nil {
+			return err
+		}
+		if scValue == nil {
+			return diverge(Divergence{StoreKey: storeKey, Key: bytes.Clone(key), SSValue: bytes.Clone(value)})
+		}
+		return nil
+	})
+}
+
+// iterateStateStorage calls fn for each key/value pair of a store in the SS backend
+// at the given version.
+func iterateStateStorage(ctx context.Context, ss store.VersionedDatabase, storeKey string, version uint64, fn func(key, value []byte) error) error {
+	itr, err := ss.Iterator([]byte(storeKey), version, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for i := 1; itr.Valid(); i++ {
+		if i%checkContextInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		if err := fn(itr.Key(), itr.Value()); err != nil {
+			return err
+		}
+		itr.Next()
+	}
+
+	return itr.Error()
+}
@@ -0,0 +1,125 @@
+package root
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+func newConsistencyTestStore(t *testing.T) store.RootStore {
+	t.Helper()
+	testLog := log.NewTestLogger(t)
+	nopLog := coretesting.NewNopLogger()
+
+	commitDB := dbm.NewMemDB()
+	multiTrees := make(map[string]commitment.Tree)
+	for _, storeKey := range storeKeys {
+		prefixDB := dbm.NewPrefixDB(commitDB, []byte(storeKey))
+		multiTrees[storeKey] = iavl.NewIavlTree(prefixDB, nopLog, iavl.DefaultConfig())
+	}
+
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(sqliteDB, testLog)
+	sc, err := commitment.NewCommitStore(multiTrees, nil, commitDB, testLog)
+	require.NoError(t, err)
+	pm := pruning.NewManager(sc, ss, nil, nil)
+	rs, err := New(testLog, ss, sc, pm, nil, nil)
+	require.NoError(t, err)
+
+	for version := uint64(1); version <= 5; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			for i := 0; i < 10; i++ {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+			}
+		}
+		_, err = rs.Commit(cs)
+		require.NoError(t, err)
+	}
+
+	return rs
+}
+
+func TestCheckConsistency(t *testing.T) {
+	rs := newConsistencyTestStore(t)
+
+	report, err := CheckConsistency(context.Background(), rs, 5, 0)
+	require.NoError(t, err)
+	require.True(t, report.Consistent())
+	require.False(t, report.Truncated)
+	for _, storeKey := range storeKeys {
+		require.Equal(t, uint64(50), report.Keys[storeKey])
+	}
+
+	report, err = CheckConsistency(context.Background(), rs, 2, 0)
+	require.NoError(t, err)
+	require.True(t, report.Consistent())
+	require.Equal(t, uint64(20), report.Keys[storeKeys[0]])
+
+	_, err = CheckConsistency(context.Background(), rs, 6, 0)
+	require.Error(t, err)
+}
+
+func TestCheckConsistency_Divergences(t *testing.T) {
+	rs := newConsistencyTestStore(t)
+
+	// make the SS backend drift from the SC backend at the latest version
+	cs := corestore.NewChangeset()
+	cs.Add([]byte(storeKeys[0]), []byte("key-3-1"), []byte("drifted"), false)
+	cs.Add([]byte(storeKeys[1]), []byte("key-4-2"), nil, true)
+	cs.Add([]byte(storeKeys[2]), []byte("extra"), []byte("value"), false)
+	require.NoError(t, rs.GetStateStorage().ApplyChangeset(5, cs))
+
+	report, err := CheckConsistency(context.Background(), rs, 5, 0)
+	require.NoError(t, err)
+	require.False(t, report.Consistent())
+	require.False(t, report.Truncated)
+	require.Equal(t, []Divergence{
+		{StoreKey: storeKeys[0], Key: []byte("key-3-1"), SSValue: []byte("drifted"), SCValue: []byte("value-3-1")},
+		{StoreKey: storeKeys[1], Key: []byte("key-4-2"), SCValue: []byte("value-4-2")},
+		{StoreKey: storeKeys[2], Key: []byte("extra"), SSValue: []byte("value")},
+	}, report.Divergences)
+
+	// the previous versions are not affected
+	report, err = CheckConsistency(context.Background(), rs, 4, 0)
+	require.NoError(t, err)
+	require.True(t, report.Consistent())
+
+	// the check stops at the maximum number of divergences
+	report, err = CheckConsistency(context.Background(), rs, 5, 2)
+	require.NoError(t, err)
+	require.True(t, report.Truncated)
+	require.Len(t, report.Divergences, 2)
+}
+
+func TestCheckConsistency_DivergencesBothWays(t *testing.T) {
+	rs := newConsistencyTestStore(t)
+
+	// one key is missing from the SS backend and another one only exists in it,
+	// so that both backends have the same number of keys
+	cs := corestore.NewChangeset()
+	cs.Add([]byte(storeKeys[0]), []byte("key-4-2"), nil, true)
+	cs.Add([]byte(storeKeys[0]), []byte("extra"), []byte("value"), false)
+	require.NoError(t, rs.GetStateStorage().ApplyChangeset(5, cs))
+
+	report, err := CheckConsistency(context.Background(), rs, 5, 0)
+	require.NoError(t, err)
+	require.Equal(t, []Divergence{
+		{StoreKey: storeKeys[0], Key: []byte("key-4-2"), SCValue: []byte("value-4-2")},
+		{StoreKey: storeKeys[0], Key: []byte("extra"), SSValue: []byte("value")},
+	}, report.Divergences)
+}
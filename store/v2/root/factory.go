@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
+	"strconv"
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
@@ -11,34 +13,90 @@ import (
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
 	"cosmossdk.io/store/v2/commitment/mem"
+	"cosmossdk.io/store/v2/commitment/smt"
 	"cosmossdk.io/store/v2/db"
 	"cosmossdk.io/store/v2/internal"
 	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/archive"
 	"cosmossdk.io/store/v2/storage/pebbledb"
+	"cosmossdk.io/store/v2/storage/rocksdb"
 	"cosmossdk.io/store/v2/storage/sqlite"
 )
 
 type (
-	SSType int
+	// SSType is the name of a state storage backend registered with
+	// storage.RegisterDatabase.
+	SSType string
 	SCType int
 )
 
 const (
-	SSTypeSQLite SSType = 0
-	SSTypePebble SSType = 1
-	SSTypeRocks  SSType = 2
+	SSTypeSQLite SSType = sqlite.BackendName
+	SSTypePebble SSType = pebbledb.BackendName
+	SSTypeRocks  SSType = rocksdb.BackendName
 	SCTypeIavl   SCType = 0
 	SCTypeIavlV2 SCType = 1
+	SCTypeSmt    SCType = 2
 )
 
+// legacySSTypes are the state storage backends of the integer values of the ss-type option, which
+// was the index of a built-in backend before it became the name of a registered backend.
+var legacySSTypes = map[int64]SSType{
+	0: SSTypeSQLite,
+	1: SSTypePebble,
+	2: SSTypeRocks,
+}
+
+// SSTypeDecodeHook is a mapstructure decode hook converting the legacy integer values of the ss-type
+// option, 0 for sqlite, 1 for pebble and 2 for rocksdb, to the names of these backends, so that the
+// app.toml files written before the option became a backend name keep working.
+func SSTypeDecodeHook(_, to reflect.Type, data any) (any, error) {
+	if to != reflect.TypeOf(SSType("")) {
+		return data, nil
+	}
+
+	var legacy int64
+	switch v := reflect.ValueOf(data); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		legacy = v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		legacy = int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		legacy = int64(v.Float())
+		if float64(legacy) != v.Float() {
+			return nil, fmt.Errorf("invalid state storage type %v", data)
+		}
+	case reflect.String:
+		// environment variables and flags are strings
+		var err error
+		legacy, err = strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return data, nil
+		}
+	default:
+		return data, nil
+	}
+
+	ssType, ok := legacySSTypes[legacy]
+	if !ok {
+		return nil, fmt.Errorf("unknown legacy state storage type %d", legacy)
+	}
+
+	return string(ssType), nil
+}
+
 // app.toml config options
 type Options struct {
-	SSType          SSType               `mapstructure:"ss-type" toml:"ss-type" comment:"State storage database type. Currently we support: 0 for SQLite, 1 for Pebble"`
-	SCType          SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support:0 for iavl, 1 for iavl v2"`
-	SSPruningOption *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
-	SCPruningOption *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
-	IavlConfig      *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
+	SSType           SSType               `mapstructure:"ss-type" toml:"ss-type" comment:"State storage database type, the name of a registered backend. Built-in backends: sqlite, pebble and rocksdb (requires building with -tags rocksdb)"`
+	SCType           SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support:0 for iavl, 1 for iavl v2, 2 for sparse merkle tree"`
+	SSPruningOption  *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
+	SCPruningOption  *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
+	IavlConfig       *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
+	SSArchiveConfig  *archive.Config      `mapstructure:"ss-archive-config" toml:"ss-archive-config" comment:"Cold archive options for state storage"`
+	SCKeepCommitInfo bool                 `mapstructure:"sc-keep-commit-info" toml:"sc-keep-commit-info" comment:"Keep the commit info of the pruned versions of the state commitment, which is returned by the proof queries of these versions as they can no longer be proven"`
+	SCMaxConcurrency int                  `mapstructure:"sc-max-concurrency" toml:"sc-max-concurrency" comment:"Maximum number of state commitment trees written, hashed or committed concurrently, 0 for the number of CPUs"`
+	DisableWAL       bool                 `mapstructure:"disable-wal" toml:"disable-wal" comment:"Disable the write-ahead log of the changesets being committed, which is synced to disk at every commit and lets the state storage and commitment recover from a crash between their commits"`
 }
 
 type FactoryOptions struct {
@@ -51,7 +109,7 @@ type FactoryOptions struct {
 
 func DefaultStoreOptions() Options {
 	return Options{
-		SSType: 0,
+		SSType: SSTypeSQLite,
 		SCType: 0,
 		SCPruningOption: &store.PruningOption{
 			KeepRecent: 2,
@@ -65,6 +123,7 @@ func DefaultStoreOptions() Options {
 			CacheSize:              100_000,
 			SkipFastStorageUpgrade: true,
 		},
+		SSArchiveConfig: archive.DefaultConfig(),
 	}
 }
 
@@ -87,25 +146,22 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	)
 
 	storeOpts := opts.Options
-	switch storeOpts.SSType {
-	case SSTypeSQLite:
-		dir := fmt.Sprintf("%s/data/ss/sqlite", opts.RootDir)
-		if err = ensureDir(dir); err != nil {
+	dir := fmt.Sprintf("%s/data/ss/%s", opts.RootDir, storeOpts.SSType)
+	if err = ensureDir(dir); err != nil {
+		return nil, err
+	}
+	ssDb, err = storage.NewDatabase(string(storeOpts.SSType), dir)
+	if err != nil {
+		return nil, err
+	}
+	if storeOpts.SSArchiveConfig != nil && storeOpts.SSArchiveConfig.Enable {
+		if err := storeOpts.SSArchiveConfig.ValidatePruning(storeOpts.SSPruningOption); err != nil {
 			return nil, err
 		}
-		ssDb, err = sqlite.New(dir)
-	case SSTypePebble:
-		dir := fmt.Sprintf("%s/data/ss/pebble", opts.RootDir)
-		if err = ensureDir(dir); err != nil {
+		ssDb, err = archive.New(ssDb, fmt.Sprintf("%s/data/ss/archive", opts.RootDir), storeOpts.SSArchiveConfig, opts.Logger)
+		if err != nil {
 			return nil, err
 		}
-		ssDb, err = pebbledb.New(dir)
-	case SSTypeRocks:
-		// TODO: rocksdb requires build tags so is not supported here by default
-		return nil, errors.New("rocksdb not supported")
-	}
-	if err != nil {
-		return nil, err
 	}
 	ss = storage.NewStorageStore(ssDb, opts.Logger)
 
@@ -140,6 +196,8 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 				return iavl.NewIavlTree(db.NewPrefixDB(opts.SCRawDB, []byte(key)), opts.Logger, storeOpts.IavlConfig), nil
 			case SCTypeIavlV2:
 				return nil, errors.New("iavl v2 not supported")
+			case SCTypeSmt:
+				return smt.NewSmtTree(db.NewPrefixDB(opts.SCRawDB, []byte(key)), opts.Logger), nil
 			default:
 				return nil, errors.New("unsupported commitment store type")
 			}
@@ -166,8 +224,18 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	sc.SetKeepCommitInfo(storeOpts.SCKeepCommitInfo)
+	sc.SetMaxConcurrency(storeOpts.SCMaxConcurrency)
 
 	pm := pruning.NewManager(sc, ss, storeOpts.SCPruningOption, storeOpts.SSPruningOption)
 
-	return New(opts.Logger, ss, sc, pm, nil, nil)
+	rs, err := New(opts.Logger, ss, sc, pm, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !storeOpts.DisableWAL {
+		rs.(*Store).SetWAL(NewWAL(db.NewPrefixDB(opts.SCRawDB, walPrefix)))
+	}
+
+	return rs, nil
 }
@@ -1,6 +1,7 @@
 package root
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,4 +27,55 @@ func TestFactory(t *testing.T) {
 	f, err = CreateRootStore(&fop)
 	require.Error(t, err)
 	require.Nil(t, f)
+
+	fop.Options = DefaultStoreOptions()
+	fop.Options.SSType = SSTypePebble
+	f, err = CreateRootStore(&fop)
+	require.NoError(t, err)
+	require.NotNil(t, f)
+
+	fop.Options = DefaultStoreOptions()
+	fop.Options.SCMaxConcurrency = 1
+	f, err = CreateRootStore(&fop)
+	require.NoError(t, err)
+	require.NotNil(t, f.(*Store).wal)
+
+	fop.Options.DisableWAL = true
+	f, err = CreateRootStore(&fop)
+	require.NoError(t, err)
+	require.Nil(t, f.(*Store).wal)
+
+	fop.Options.SSType = "unknown"
+	f, err = CreateRootStore(&fop)
+	require.ErrorContains(t, err, `state storage backend "unknown" is not registered`)
+	require.Nil(t, f)
+}
+
+func TestSSTypeDecodeHook(t *testing.T) {
+	ssType := reflect.TypeOf(SSType(""))
+	for data, expected := range map[any]any{
+		int64(0):    "sqlite",
+		int(1):      "pebble",
+		uint8(2):    "rocksdb",
+		float64(1):  "pebble",
+		"0":         "sqlite",
+		"pebble":    "pebble",
+		"sqlite":    "sqlite",
+		true:        true,
+		"unknown-x": "unknown-x",
+	} {
+		got, err := SSTypeDecodeHook(reflect.TypeOf(data), ssType, data)
+		require.NoError(t, err)
+		require.Equal(t, expected, got, "data %v", data)
+	}
+
+	for _, data := range []any{int64(3), int(-1), float64(1.5), "7"} {
+		_, err := SSTypeDecodeHook(reflect.TypeOf(data), ssType, data)
+		require.Error(t, err, "data %v", data)
+	}
+
+	// other types are not converted
+	got, err := SSTypeDecodeHook(reflect.TypeOf(int64(0)), reflect.TypeOf(SCType(0)), int64(0))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), got)
 }
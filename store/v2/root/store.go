@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	corelog "cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/migration"
 	"cosmossdk.io/store/v2/proof"
@@ -46,6 +48,18 @@ type Store struct {
 	// lastCommitInfo reflects the last version/hash that has been committed
 	lastCommitInfo *proof.CommitInfo
 
+	// pendingUpgrade reflects the changes of the renamed and moved stores of the
+	// last upgrade, which are committed along with the next changeset
+	pendingUpgrade *corestore.Changeset
+	// removedStoreKeys reflects the store keys deleted or renamed by the last
+	// upgrade at upgradeVersion, which are only pruned from the SS backend once
+	// the upgrade is committed so that their data survives a restart before the commit
+	removedStoreKeys []string
+	upgradeVersion   uint64
+
+	// wal reflects the write-ahead log of the changeset being committed (if any)
+	wal *WAL
+
 	// telemetry reflects a telemetry agent responsible for emitting metrics (if any)
 	telemetry metrics.StoreMetrics
 
@@ -105,6 +119,12 @@ func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
 }
 
+// SetWAL sets the write-ahead log of the changesets being committed, which is
+// replayed by LoadLatestVersion after a crash between the SS and SC commits.
+func (s *Store) SetWAL(wal *WAL) {
+	s.wal = wal
+}
+
 func (s *Store) SetInitialVersion(v uint64) error {
 	s.initialVersion = v
 
@@ -209,6 +229,13 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 
 	if prove {
 		result.ProofOps, err = s.stateCommitment.GetProof(storeKey, version, key)
+		var prunedErr storeerrors.ErrVersionPruned
+		if errors.As(err, &prunedErr) {
+			// the version is pruned from the SC backend, so return the commit info
+			// of the version, if kept, instead of a proof
+			result.Unprovable = true
+			result.CommitInfo, err = s.stateCommitment.GetCommitInfo(version)
+		}
 		if err != nil {
 			return store.QueryResult{}, fmt.Errorf("failed to get SC store proof: %w", err)
 		}
@@ -223,6 +250,10 @@ func (s *Store) LoadLatestVersion() error {
 		defer s.telemetry.MeasureSince(now, "root_store", "load_latest_version")
 	}
 
+	if err := s.recoverCommit(); err != nil {
+		return fmt.Errorf("failed to recover the last commit: %w", err)
+	}
+
 	lv, err := s.GetLatestVersion()
 	if err != nil {
 		return err
@@ -256,18 +287,29 @@ func (s *Store) LoadVersionAndUpgrade(version uint64, upgrades *corestore.StoreU
 		return errors.New("cannot upgrade while migrating")
 	}
 
+	if err := validateUpgrades(upgrades); err != nil {
+		return err
+	}
+
+	// read the data of the renamed and moved stores before the old store keys
+	// are removed
+	pendingUpgrade, err := s.upgradeChangeset(version, upgrades)
+	if err != nil {
+		return err
+	}
+
 	if err := s.loadVersion(version, upgrades); err != nil {
 		return err
 	}
 
-	// if the state storage implements the UpgradableDatabase interface, prune the
-	// deleted store keys
-	upgradableDatabase, ok := s.stateStorage.(store.UpgradableDatabase)
-	if ok {
-		if err := upgradableDatabase.PruneStoreKeys(upgrades.Deleted, version); err != nil {
-			return fmt.Errorf("failed to prune store keys %v: %w", upgrades.Deleted, err)
-		}
+	if len(pendingUpgrade.Changes) > 0 {
+		s.pendingUpgrade = pendingUpgrade
+	}
+	s.removedStoreKeys = slices.Clone(upgrades.Deleted)
+	for _, rename := range upgrades.Renamed {
+		s.removedStoreKeys = append(s.removedStoreKeys, rename.OldKey)
 	}
+	s.upgradeVersion = version
 
 	return nil
 }
@@ -291,6 +333,8 @@ func (s *Store) loadVersion(v uint64, upgrades *corestore.StoreUpgrades) error {
 	}
 
 	s.commitHeader = nil
+	s.pendingUpgrade = nil
+	s.removedStoreKeys = nil
 
 	// set lastCommitInfo explicitly s.t. Commit commits the correct version, i.e. v+1
 	var err error
@@ -355,6 +399,9 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		defer s.telemetry.MeasureSince(now, "root_store", "commit")
 	}
 
+	// the changes of the last upgrade are committed along with the changeset
+	cs = s.withUpgradeChangeset(cs)
+
 	// write the changeset to the SC tree and update lastCommitInfo
 	if err := s.writeSC(cs); err != nil {
 		return nil, err
@@ -373,6 +420,18 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		s.logger.Error("failed to signal commit to pruning manager", "err", err)
 	}
 
+	if s.wal != nil {
+		if err := s.wal.write(version, cs); err != nil {
+			return nil, fmt.Errorf("failed to write the WAL: %w", err)
+		}
+	}
+
+	// the store keys removed by the upgrade are pruned from the SS backend once
+	// the upgrade changeset is about to be committed
+	if err := s.pruneRemovedStoreKeys(); err != nil {
+		return nil, err
+	}
+
 	eg := new(errgroup.Group)
 
 	// if we're migrating, we don't want to commit to the state storage to avoid
@@ -401,11 +460,20 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		return nil, err
 	}
 
+	if s.wal != nil {
+		if err := s.wal.clear(); err != nil {
+			return nil, fmt.Errorf("failed to clear the WAL: %w", err)
+		}
+	}
+
 	// signal to the pruning manager that the commit is done
 	if err := s.pruningManager.SignalCommit(false, version); err != nil {
 		s.logger.Error("failed to signal commit done to pruning manager", "err", err)
 	}
 
+	s.pendingUpgrade = nil
+	s.removedStoreKeys = nil
+
 	if s.commitHeader != nil {
 		s.lastCommitInfo.Timestamp = s.commitHeader.Time
 	}
@@ -514,6 +582,56 @@ func (s *Store) commitSC() error {
 	return nil
 }
 
+// recoverCommit replays the pending changeset of the WAL, if any, to the SS or
+// SC backend which did not commit it, so that both backends are at the version
+// of the changeset.
+func (s *Store) recoverCommit() error {
+	if s.wal == nil {
+		return nil
+	}
+
+	version, cs, err := s.wal.pending()
+	if err != nil {
+		return fmt.Errorf("failed to read the WAL: %w", err)
+	}
+	if cs == nil {
+		return nil
+	}
+
+	scVersion, err := s.stateCommitment.GetLatestVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get SC version: %w", err)
+	}
+	if scVersion < version {
+		s.logger.Info("replaying the WAL to the SC store", "version", version, "sc_version", scVersion)
+		if err := s.stateCommitment.LoadVersion(scVersion); err != nil {
+			return fmt.Errorf("failed to load SC version %d: %w", scVersion, err)
+		}
+		if err := s.stateCommitment.WriteChangeset(cs); err != nil {
+			return fmt.Errorf("failed to write batch to SC store: %w", err)
+		}
+		if _, err := s.stateCommitment.Commit(version); err != nil {
+			return fmt.Errorf("failed to commit SC store: %w", err)
+		}
+	}
+
+	// the SS backend is not written while migrating
+	if !s.isMigrating {
+		ssVersion, err := s.stateStorage.GetLatestVersion()
+		if err != nil {
+			return fmt.Errorf("failed to get SS version: %w", err)
+		}
+		if ssVersion < version {
+			s.logger.Info("replaying the WAL to the SS store", "version", version, "ss_version", ssVersion)
+			if err := s.stateStorage.ApplyChangeset(version, cs); err != nil {
+				return fmt.Errorf("failed to commit SS: %w", err)
+			}
+		}
+	}
+
+	return s.wal.clear()
+}
+
 func (s *Store) Prune(version uint64) error {
 	return s.pruningManager.Prune(version)
 }
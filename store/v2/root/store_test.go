@@ -231,6 +231,35 @@ func (s *RootStoreTestSuite) TestQueryProof() {
 	s.Require().Equal(expRoots[0], cInfo.Hash())
 }
 
+func (s *RootStoreTestSuite) TestQueryProof_Pruned() {
+	sc := s.rootStore.GetStateCommitment().(*commitment.CommitStore)
+	sc.SetKeepCommitInfo(true)
+
+	for version := uint64(1); version <= 3; version++ {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("key1"), []byte(fmt.Sprintf("value%d", version)), false)
+		_, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(sc.Prune(1))
+
+	// the pruned version is unprovable, but its commit info is returned
+	result, err := s.rootStore.Query(testStoreKeyBytes, 1, []byte("key1"), true)
+	s.Require().NoError(err)
+	s.Require().True(result.Unprovable)
+	s.Require().Nil(result.ProofOps)
+	s.Require().Equal([]byte("value1"), result.Value)
+	s.Require().NotNil(result.CommitInfo)
+	s.Require().Equal(uint64(1), result.CommitInfo.Version)
+
+	// the retained versions are still provable
+	result, err = s.rootStore.Query(testStoreKeyBytes, 2, []byte("key1"), true)
+	s.Require().NoError(err)
+	s.Require().False(result.Unprovable)
+	s.Require().Len(result.ProofOps, 2)
+	s.Require().Nil(result.CommitInfo)
+}
+
 func (s *RootStoreTestSuite) TestLoadVersion() {
 	// write and commit a few changesets
 	for v := 1; v <= 5; v++ {
@@ -0,0 +1,122 @@
+package root
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage/util"
+)
+
+// validateUpgrades checks that the renamed and moved store keys of the upgrades
+// are consistent with each other.
+func validateUpgrades(upgrades *corestore.StoreUpgrades) error {
+	for _, rename := range upgrades.Renamed {
+		if rename.OldKey == "" || rename.NewKey == "" {
+			return errors.New("renamed store keys cannot be empty")
+		}
+		if rename.OldKey == rename.NewKey {
+			return fmt.Errorf("store key %s cannot be renamed to itself", rename.OldKey)
+		}
+		if upgrades.IsAdded(rename.NewKey) || upgrades.IsDeleted(rename.OldKey) {
+			return fmt.Errorf("renamed store key %s cannot be added or deleted", rename.OldKey)
+		}
+	}
+
+	for _, move := range upgrades.Moved {
+		if move.FromKey == move.ToKey {
+			return fmt.Errorf("prefix %X cannot be moved within store %s", move.Prefix, move.FromKey)
+		}
+		for _, storeKey := range []string{move.FromKey, move.ToKey} {
+			if upgrades.IsDeleted(storeKey) || upgrades.IsRenamed(storeKey) {
+				return fmt.Errorf("store key %s of a moved prefix cannot be deleted or renamed", storeKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+// upgradeChangeset reads the data of the renamed and moved stores at the given
+// version from the SS backend, and returns the changeset which writes it under
+// the new store keys and removes the moved data from the original stores.
+func (s *Store) upgradeChangeset(version uint64, upgrades *corestore.StoreUpgrades) (*corestore.Changeset, error) {
+	cs := corestore.NewChangeset()
+	for _, rename := range upgrades.Renamed {
+		err := s.iterateStorage([]byte(rename.OldKey), version, nil, func(key, value []byte) {
+			cs.Add([]byte(rename.NewKey), key, value, false)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read renamed store %s: %w", rename.OldKey, err)
+		}
+	}
+
+	for _, move := range upgrades.Moved {
+		err := s.iterateStorage([]byte(move.FromKey), version, move.Prefix, func(key, value []byte) {
+			cs.Add([]byte(move.FromKey), key, nil, true)
+			newKey := append(bytes.Clone(move.NewPrefix), key[len(move.Prefix):]...)
+			cs.Add([]byte(move.ToKey), newKey, value, false)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read prefix %X of store %s: %w", move.Prefix, move.FromKey, err)
+		}
+	}
+
+	return cs, nil
+}
+
+// iterateStorage calls fn for each key/value pair under the given prefix of a
+// store in the SS backend at the given version.
+func (s *Store) iterateStorage(storeKey []byte, version uint64, prefix []byte, fn func(key, value []byte)) error {
+	var start, end []byte
+	if len(prefix) > 0 {
+		start, end = prefix, util.CopyIncr(prefix)
+	}
+
+	itr, err := s.stateStorage.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		fn(bytes.Clone(itr.Key()), bytes.Clone(itr.Value()))
+	}
+
+	return itr.Error()
+}
+
+// withUpgradeChangeset returns the changeset to commit, which is prefixed with
+// the pending upgrade changeset if any, so that the data of the renamed and
+// moved stores is committed atomically along with the upgrade height.
+func (s *Store) withUpgradeChangeset(cs *corestore.Changeset) *corestore.Changeset {
+	if s.pendingUpgrade == nil {
+		return cs
+	}
+
+	merged := corestore.NewChangeset()
+	merged.Changes = append(merged.Changes, s.pendingUpgrade.Changes...)
+	merged.Changes = append(merged.Changes, cs.Changes...)
+
+	return merged
+}
+
+// pruneRemovedStoreKeys prunes the store keys removed by the last upgrade from
+// the SS backend, if it implements the UpgradableDatabase interface.
+func (s *Store) pruneRemovedStoreKeys() error {
+	if len(s.removedStoreKeys) == 0 {
+		return nil
+	}
+
+	upgradableDatabase, ok := s.stateStorage.(store.UpgradableDatabase)
+	if !ok {
+		return nil
+	}
+	if err := upgradableDatabase.PruneStoreKeys(s.removedStoreKeys, s.upgradeVersion); err != nil {
+		return fmt.Errorf("failed to prune store keys %v: %w", s.removedStoreKeys, err)
+	}
+
+	return nil
+}
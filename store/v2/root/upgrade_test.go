@@ -88,6 +88,10 @@ func (s *UpgradeStoreTestSuite) loadWithUpgrades(upgrades *corestore.StoreUpgrad
 	for _, deleted := range upgrades.Deleted {
 		oldTrees[deleted], _ = newTreeFn(deleted)
 	}
+	for _, renamed := range upgrades.Renamed {
+		multiTrees[renamed.NewKey], _ = newTreeFn(renamed.NewKey)
+		oldTrees[renamed.OldKey], _ = newTreeFn(renamed.OldKey)
+	}
 
 	sc, err := commitment.NewCommitStore(multiTrees, oldTrees, s.commitDB, testLog)
 	s.Require().NoError(err)
@@ -155,3 +159,120 @@ func (s *UpgradeStoreTestSuite) TestLoadVersionAndUpgrade() {
 		}
 	}
 }
+
+func (s *UpgradeStoreTestSuite) TestLoadVersionAndUpgrade_RenameAndMove() {
+	upgrades := &corestore.StoreUpgrades{
+		Added:   []string{"newStore"},
+		Renamed: []corestore.StoreRename{{OldKey: "store1", NewKey: "renamedStore1"}},
+		Moved:   []corestore.StoreMove{{FromKey: "store2", ToKey: "newStore", Prefix: []byte("key-1-"), NewPrefix: []byte("moved-")}},
+	}
+	s.loadWithUpgrades(upgrades)
+
+	v, err := s.rootStore.GetLatestVersion()
+	s.Require().NoError(err)
+	err = s.rootStore.(store.UpgradeableStore).LoadVersionAndUpgrade(v, upgrades)
+	s.Require().NoError(err)
+
+	// the upgrade changes are committed along with the next changeset
+	cs := corestore.NewChangeset()
+	cs.Add([]byte("renamedStore1"), []byte("key-21-0"), []byte("value-21-0"), false)
+	_, err = s.rootStore.Commit(cs)
+	s.Require().NoError(err)
+	upgradeVersion := v + 1
+
+	get := func(storeKey string, version uint64, key string) []byte {
+		res, err := s.rootStore.Query([]byte(storeKey), version, []byte(key), false)
+		s.Require().NoError(err)
+		scValue, err := s.rootStore.GetStateCommitment().Get([]byte(storeKey), version, []byte(key))
+		s.Require().NoError(err)
+		s.Require().Equal(res.Value, scValue, "store %s key %s", storeKey, key)
+		return res.Value
+	}
+
+	keyCount := 10
+	for version := uint64(1); version <= v; version++ {
+		for i := 0; i < keyCount; i++ {
+			key := fmt.Sprintf("key-%d-%d", version, i)
+			value := []byte(fmt.Sprintf("value-%d-%d", version, i))
+			// the renamed store has all the data of the old one
+			s.Require().Equal(value, get("renamedStore1", upgradeVersion, key))
+			// the old store is still queryable at the previous versions
+			res, err := s.rootStore.Query([]byte("store1"), v, []byte(key), true)
+			s.Require().NoError(err)
+			s.Require().Equal(value, res.Value)
+			s.Require().NotNil(res.ProofOps)
+
+			// the moved prefix is only in the new store
+			if version == 1 {
+				s.Require().Nil(get("store2", upgradeVersion, key))
+				s.Require().Equal(value, get("newStore", upgradeVersion, fmt.Sprintf("moved-%d", i)))
+				s.Require().Equal(value, get("store2", v, key))
+			} else {
+				s.Require().Equal(value, get("store2", upgradeVersion, key))
+			}
+		}
+	}
+	s.Require().Equal([]byte("value-21-0"), get("renamedStore1", upgradeVersion, "key-21-0"))
+
+	// the old store is removed from the commit info
+	commitInfo, err := s.rootStore.GetStateCommitment().GetCommitInfo(upgradeVersion)
+	s.Require().NoError(err)
+	var names []string
+	for _, si := range commitInfo.StoreInfos {
+		names = append(names, string(si.Name))
+	}
+	s.Require().Equal([]string{"newStore", "renamedStore1", "store2", "store3"}, names)
+}
+
+func (s *UpgradeStoreTestSuite) TestLoadVersionAndUpgrade_InvalidMove() {
+	upgrades := &corestore.StoreUpgrades{
+		Deleted: []string{"store3"},
+		Moved:   []corestore.StoreMove{{FromKey: "store2", ToKey: "store3", Prefix: []byte("key-1-")}},
+	}
+	s.loadWithUpgrades(upgrades)
+
+	v, err := s.rootStore.GetLatestVersion()
+	s.Require().NoError(err)
+	err = s.rootStore.(store.UpgradeableStore).LoadVersionAndUpgrade(v, upgrades)
+	s.Require().ErrorContains(err, "cannot be deleted or renamed")
+}
+
+func (s *UpgradeStoreTestSuite) TestLoadVersionAndUpgrade_RestartBeforeCommit() {
+	upgrades := &corestore.StoreUpgrades{
+		Renamed: []corestore.StoreRename{{OldKey: "store1", NewKey: "renamedStore1"}},
+		Deleted: []string{"store3"},
+	}
+	s.loadWithUpgrades(upgrades)
+
+	v, err := s.rootStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().NoError(s.rootStore.(store.UpgradeableStore).LoadVersionAndUpgrade(v, upgrades))
+
+	// the node restarts before committing the upgrade, the old stores are not
+	// removed yet
+	metadata := commitment.NewMetadataStore(s.commitDB)
+	removedStoreKeys, err := metadata.GetRemovedStoreKeys(v)
+	s.Require().NoError(err)
+	s.Require().Empty(removedStoreKeys)
+
+	s.loadWithUpgrades(upgrades)
+	s.Require().NoError(s.rootStore.(store.UpgradeableStore).LoadVersionAndUpgrade(v, upgrades))
+	_, err = s.rootStore.Commit(corestore.NewChangeset())
+	s.Require().NoError(err)
+	upgradeVersion := v + 1
+
+	removedStoreKeys, err = metadata.GetRemovedStoreKeys(v)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([][]byte{[]byte("store1"), []byte("store3")}, removedStoreKeys)
+
+	// the renamed store has all the data of the old one
+	keyCount := 10
+	for version := uint64(1); version <= v; version++ {
+		for i := 0; i < keyCount; i++ {
+			key := []byte(fmt.Sprintf("key-%d-%d", version, i))
+			res, err := s.rootStore.Query([]byte("renamedStore1"), upgradeVersion, key, false)
+			s.Require().NoError(err)
+			s.Require().Equal([]byte(fmt.Sprintf("value-%d-%d", version, i)), res.Value)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package root
+
+import (
+	"bytes"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+var (
+	// walPrefix is the prefix of the WAL in the SC raw database.
+	walPrefix = []byte("w/")
+	// walPendingKey is the key of the pending changeset in the WAL.
+	walPendingKey = []byte("pending")
+)
+
+// WAL is a write-ahead log of the changeset being committed by the root store.
+// The SS and SC backends commit the changeset separately, so a crash between
+// their commits leaves them at different versions. The changeset is written to
+// the WAL before the commits and removed after them, and a changeset left in
+// the WAL is replayed by LoadLatestVersion to the backend which did not commit
+// it.
+type WAL struct {
+	db corestore.KVStoreWithBatch
+}
+
+// NewWAL creates a new WAL stored in the given database.
+func NewWAL(db corestore.KVStoreWithBatch) *WAL {
+	return &WAL{db: db}
+}
+
+// write persists the changeset of the given version, replacing the pending one.
+// NOTE: The pending changeset is encoded as follows:
+// - version (uvarint)
+// - changeset (encoding.MarshalChangeset)
+func (w *WAL) write(version uint64, cs *corestore.Changeset) (err error) {
+	bz, err := encoding.MarshalChangeset(cs)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	buf.Grow(encoding.EncodeUvarintSize(version) + len(bz))
+	if err := encoding.EncodeUvarint(&buf, version); err != nil {
+		return err
+	}
+	buf.Write(bz)
+
+	batch := w.db.NewBatch()
+	defer func() {
+		cErr := batch.Close()
+		if err == nil {
+			err = cErr
+		}
+	}()
+	if err := batch.Set(walPendingKey, buf.Bytes()); err != nil {
+		return err
+	}
+
+	// the changeset must be on disk before the backends start committing it
+	return batch.WriteSync()
+}
+
+// pending returns the pending changeset and its version, or a nil changeset if
+// there is none.
+func (w *WAL) pending() (uint64, *corestore.Changeset, error) {
+	bz, err := w.db.Get(walPendingKey)
+	if err != nil || bz == nil {
+		return 0, nil, err
+	}
+
+	version, n, err := encoding.DecodeUvarint(bz)
+	if err != nil {
+		return 0, nil, err
+	}
+	cs := corestore.NewChangeset()
+	if err := encoding.UnmarshalChangeset(cs, bz[n:]); err != nil {
+		return 0, nil, fmt.Errorf("invalid changeset of version %d: %w", version, err)
+	}
+
+	return version, cs, nil
+}
+
+// clear removes the pending changeset once both backends committed it.
+func (w *WAL) clear() error {
+	return w.db.Delete(walPendingKey)
+}
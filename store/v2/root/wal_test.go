@@ -0,0 +1,246 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+var errInjected = errors.New("injected failure")
+
+// failingStorage is a SS backend failing to apply the changesets, as if the
+// node crashed before the SS commit.
+type failingStorage struct {
+	store.VersionedDatabase
+}
+
+func (failingStorage) ApplyChangeset(uint64, *corestore.Changeset) error {
+	return errInjected
+}
+
+// failingCommitter is a SC backend failing to commit, as if the node crashed
+// before the SC commit.
+type failingCommitter struct {
+	store.Committer
+}
+
+func (failingCommitter) Commit(uint64) (*proof.CommitInfo, error) {
+	return nil, errInjected
+}
+
+type WALTestSuite struct {
+	suite.Suite
+
+	commitDB corestore.KVStoreWithBatch
+	ss       *storage.StorageStore
+}
+
+func TestWALTestSuite(t *testing.T) {
+	suite.Run(t, &WALTestSuite{})
+}
+
+func (s *WALTestSuite) SetupTest() {
+	s.commitDB = dbm.NewMemDB()
+	sqliteDB, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+	s.ss = storage.NewStorageStore(sqliteDB, coretesting.NewNopLogger())
+}
+
+// newStore opens the root store on the databases of the suite, as when the node
+// restarts, with the SS and SC backends optionally wrapped to inject failures.
+func (s *WALTestSuite) newStore(failSS, failSC bool) *Store {
+	nopLog := coretesting.NewNopLogger()
+
+	multiTrees := make(map[string]commitment.Tree)
+	for _, storeKey := range storeKeys {
+		prefixDB := dbm.NewPrefixDB(s.commitDB, []byte(storeKey))
+		multiTrees[storeKey] = iavl.NewIavlTree(prefixDB, nopLog, iavl.DefaultConfig())
+	}
+	sc, err := commitment.NewCommitStore(multiTrees, nil, s.commitDB, nopLog)
+	s.Require().NoError(err)
+	pm := pruning.NewManager(sc, s.ss, nil, nil)
+
+	var (
+		ss        store.VersionedDatabase = s.ss
+		committer store.Committer         = sc
+	)
+	if failSS {
+		ss = failingStorage{s.ss}
+	}
+	if failSC {
+		committer = failingCommitter{sc}
+	}
+	rs, err := New(nopLog, ss, committer, pm, nil, nil)
+	s.Require().NoError(err)
+	rs.(*Store).SetWAL(NewWAL(dbm.NewPrefixDB(s.commitDB, walPrefix)))
+	s.Require().NoError(rs.LoadLatestVersion())
+
+	return rs.(*Store)
+}
+
+func changesetForVersion(version uint64) *corestore.Changeset {
+	cs := corestore.NewChangeset()
+	for _, storeKey := range storeKeys {
+		for i := 0; i < 10; i++ {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+		}
+	}
+	return cs
+}
+
+func (s *WALTestSuite) commit(rs *Store, from, to uint64) {
+	for version := from; version <= to; version++ {
+		_, err := rs.Commit(changesetForVersion(version))
+		s.Require().NoError(err)
+	}
+}
+
+func (s *WALTestSuite) requireVersions(rs *Store, ssVersion, scVersion uint64) {
+	v, err := rs.GetStateStorage().GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(ssVersion, v)
+	v, err = rs.GetStateCommitment().GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(scVersion, v)
+}
+
+func (s *WALTestSuite) requireRecovered(rs *Store, version uint64) {
+	s.requireVersions(rs, version, version)
+
+	// the WAL is cleared
+	_, cs, err := rs.wal.pending()
+	s.Require().NoError(err)
+	s.Require().Nil(cs)
+
+	// the changeset of the version is queryable from both backends
+	for _, storeKey := range storeKeys {
+		key := []byte(fmt.Sprintf("key-%d-%d", version, 0))
+		value := []byte(fmt.Sprintf("value-%d-%d", version, 0))
+		ssValue, err := rs.GetStateStorage().Get([]byte(storeKey), version, key)
+		s.Require().NoError(err)
+		s.Require().Equal(value, ssValue)
+		result, err := rs.Query([]byte(storeKey), version, key, true)
+		s.Require().NoError(err)
+		s.Require().Equal(value, result.Value)
+		s.Require().NotEmpty(result.ProofOps)
+	}
+
+	// the store keeps committing
+	s.commit(rs, version+1, version+2)
+	s.requireVersions(rs, version+2, version+2)
+}
+
+func (s *WALTestSuite) TestCommit_ClearsWAL() {
+	rs := s.newStore(false, false)
+	s.commit(rs, 1, 3)
+
+	_, cs, err := rs.wal.pending()
+	s.Require().NoError(err)
+	s.Require().Nil(cs)
+}
+
+func (s *WALTestSuite) TestRecover_SSFailure() {
+	rs := s.newStore(false, false)
+	s.commit(rs, 1, 3)
+
+	// the SC commits the version but the SS does not
+	rs = s.newStore(true, false)
+	_, err := rs.Commit(changesetForVersion(4))
+	s.Require().ErrorIs(err, errInjected)
+	s.requireVersions(rs, 3, 4)
+
+	rs = s.newStore(false, false)
+	s.requireRecovered(rs, 4)
+}
+
+func (s *WALTestSuite) TestRecover_SCFailure() {
+	rs := s.newStore(false, false)
+	s.commit(rs, 1, 3)
+
+	// the SS commits the version but the SC does not
+	rs = s.newStore(false, true)
+	_, err := rs.Commit(changesetForVersion(4))
+	s.Require().ErrorIs(err, errInjected)
+	s.requireVersions(rs, 4, 3)
+
+	rs = s.newStore(false, false)
+	s.requireRecovered(rs, 4)
+
+	// the replayed version has the same hash as when committed without failure
+	s.SetupTest()
+	reference := s.newStore(false, false)
+	s.commit(reference, 1, 4)
+	expected, err := reference.GetStateCommitment().GetCommitInfo(4)
+	s.Require().NoError(err)
+	actual, err := rs.GetStateCommitment().GetCommitInfo(4)
+	s.Require().NoError(err)
+	s.Require().Equal(expected.Hash(), actual.Hash())
+}
+
+func (s *WALTestSuite) TestRecover_BothFailures() {
+	rs := s.newStore(false, false)
+	s.commit(rs, 1, 3)
+
+	// neither backend commits the version, which is replayed to both
+	rs = s.newStore(true, true)
+	_, err := rs.Commit(changesetForVersion(4))
+	s.Require().ErrorIs(err, errInjected)
+	s.requireVersions(rs, 3, 3)
+
+	rs = s.newStore(false, false)
+	s.requireRecovered(rs, 4)
+}
+
+// BenchmarkCommit measures the latency of the commits of the root store with
+// and without the WAL, whose changeset is synced to disk at every commit.
+func BenchmarkCommit(b *testing.B) {
+	for _, disableWAL := range []bool{false, true} {
+		b.Run(fmt.Sprintf("disable-wal=%t", disableWAL), func(b *testing.B) {
+			dir := b.TempDir()
+			scRawDB, err := dbm.NewGoLevelDB("application", dir, nil)
+			require.NoError(b, err)
+			defer scRawDB.Close()
+
+			// pruning is disabled, as closing an IAVL tree being pruned may block
+			opts := DefaultStoreOptions()
+			opts.SCPruningOption = store.NewPruningOption(store.PruningNothing)
+			opts.SSPruningOption = store.NewPruningOption(store.PruningNothing)
+			opts.DisableWAL = disableWAL
+			rs, err := CreateRootStore(&FactoryOptions{
+				Logger:    coretesting.NewNopLogger(),
+				RootDir:   dir,
+				Options:   opts,
+				StoreKeys: storeKeys,
+				SCRawDB:   scRawDB,
+			})
+			require.NoError(b, err)
+			defer rs.Close()
+
+			changesets := make([]*corestore.Changeset, b.N)
+			for i := range changesets {
+				changesets[i] = changesetForVersion(uint64(i + 1))
+			}
+
+			b.ResetTimer()
+			for _, cs := range changesets {
+				if _, err := rs.Commit(cs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
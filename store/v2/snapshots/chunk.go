@@ -170,7 +170,7 @@ func DrainChunks(chunks <-chan io.ReadCloser) {
 
 // ValidRestoreHeight will check height is valid for snapshot restore or not
 func ValidRestoreHeight(format uint32, height uint64) error {
-	if format != snapshotstypes.CurrentFormat {
+	if format != snapshotstypes.CurrentFormat && format != snapshotstypes.IndexedFormat {
 		return fmt.Errorf("format %v: %w", format, snapshotstypes.ErrUnknownFormat)
 	}
 
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -16,6 +18,8 @@ import (
 
 	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
@@ -169,6 +173,77 @@ func (m *mockCommitSnapshotter) SupportedFormats() []uint32 {
 	return []uint32{snapshotstypes.CurrentFormat}
 }
 
+// mockStoreSnapshotter is a mockCommitSnapshotter which snapshots each store
+// independently, of which the hash is the hash of its items.
+type mockStoreSnapshotter struct {
+	mockCommitSnapshotter
+
+	mtx    sync.Mutex
+	stores map[string][][]byte
+	// corrupt makes the restored hashes invalid.
+	corrupt    bool
+	commitInfo *proof.CommitInfo
+}
+
+var _ snapshots.StoreSnapshotter = (*mockStoreSnapshotter)(nil)
+
+func (m *mockStoreSnapshotter) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
+	commitInfo := &proof.CommitInfo{Version: version}
+	for name, items := range m.stores {
+		commitInfo.StoreInfos = append(commitInfo.StoreInfos, proof.StoreInfo{
+			Name:     []byte(name),
+			CommitID: proof.CommitID{Version: version, Hash: hash(items)},
+		})
+	}
+	sort.Slice(commitInfo.StoreInfos, func(i, j int) bool {
+		return bytes.Compare(commitInfo.StoreInfos[i].Name, commitInfo.StoreInfos[j].Name) < 0
+	})
+	return commitInfo, nil
+}
+
+func (m *mockStoreSnapshotter) SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	for _, item := range m.stores[storeKey] {
+		if err := snapshotstypes.WriteExtensionPayload(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockStoreSnapshotter) RestoreStore(
+	version uint64, storeKey string, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) ([]byte, error) {
+	items := [][]byte{}
+	for {
+		var item snapshotstypes.SnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		payload := item.GetExtensionPayload().Payload
+		items = append(items, payload)
+		chStorage <- &corestore.StateChanges{
+			Actor:        []byte(storeKey),
+			StateChanges: []corestore.KVPair{{Key: []byte(fmt.Sprintf("%s-%d", storeKey, len(items)-1)), Value: payload}},
+		}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.stores[storeKey] = items
+	if m.corrupt {
+		return []byte("corrupt"), nil
+	}
+	return hash(items), nil
+}
+
+func (m *mockStoreSnapshotter) FinalizeRestore(version uint64, commitInfo *proof.CommitInfo) error {
+	m.commitInfo = commitInfo
+	return nil
+}
+
 type mockStorageSnapshotter struct {
 	items map[string][]byte
 }
@@ -185,6 +260,88 @@ func (m *mockStorageSnapshotter) Restore(version uint64, chStorage <-chan *cores
 	return nil
 }
 
+// mockDeltaCommitter is a mockCommitSnapshotter which records the changesets
+// committed on top of the restored version.
+type mockDeltaCommitter struct {
+	mockCommitSnapshotter
+	store.Committer
+
+	version    uint64
+	pending    *corestore.Changeset
+	changesets map[uint64]*corestore.Changeset
+}
+
+func (m *mockDeltaCommitter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) (snapshotstypes.SnapshotItem, error) {
+	m.version = height
+	return m.mockCommitSnapshotter.Restore(height, format, protoReader, chStorage)
+}
+
+func (m *mockDeltaCommitter) GetLatestVersion() (uint64, error) {
+	return m.version, nil
+}
+
+func (m *mockDeltaCommitter) WriteChangeset(cs *corestore.Changeset) error {
+	m.pending = cs
+	return nil
+}
+
+func (m *mockDeltaCommitter) Commit(version uint64) (*proof.CommitInfo, error) {
+	if version != m.version+1 {
+		return nil, fmt.Errorf("commit version %d does not follow version %d", version, m.version)
+	}
+	if m.changesets == nil {
+		m.changesets = map[uint64]*corestore.Changeset{}
+	}
+	m.changesets[version] = m.pending
+	m.version = version
+	return &proof.CommitInfo{Version: version}, nil
+}
+
+// mockChangesetStorage is a mockStorageSnapshotter which reads its changesets
+// back and records the applied ones.
+type mockChangesetStorage struct {
+	mockStorageSnapshotter
+	store.VersionedDatabase
+
+	version    uint64
+	changesets map[uint64]*corestore.Changeset
+}
+
+var _ snapshots.ChangesetReader = (*mockChangesetStorage)(nil)
+
+func (m *mockChangesetStorage) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	m.version = version
+	return m.mockStorageSnapshotter.Restore(version, chStorage)
+}
+
+func (m *mockChangesetStorage) IterateChangesets(from, to uint64, fn func(version uint64, cs *corestore.Changeset) error) error {
+	for version := from + 1; version <= to; version++ {
+		cs, ok := m.changesets[version]
+		if !ok {
+			cs = corestore.NewChangeset()
+		}
+		if err := fn(version, cs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockChangesetStorage) GetLatestVersion() (uint64, error) {
+	return m.version, nil
+}
+
+func (m *mockChangesetStorage) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	if m.changesets == nil {
+		m.changesets = map[uint64]*corestore.Changeset{}
+	}
+	m.changesets[version] = cs
+	m.version = version
+	return nil
+}
+
 type mockErrorCommitSnapshotter struct{}
 
 var _ snapshots.CommitSnapshotter = (*mockErrorCommitSnapshotter)(nil)
@@ -0,0 +1,130 @@
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/proof"
+)
+
+// snapshotIndex is the first chunk of a snapshot in the IndexedFormat. The chunks
+// following it are split in sections, one for each store in the order of the
+// index and a last one for the extensions, each section being an independent
+// snapshot stream.
+type snapshotIndex struct {
+	// commitInfo is the commit info of the snapshot version, which the restored
+	// store hashes are verified against.
+	commitInfo *proof.CommitInfo
+	stores     []indexedStore
+	// extensionChunks is the number of chunks of the extensions section.
+	extensionChunks uint32
+}
+
+// indexedStore is the section of a store in a snapshotIndex.
+type indexedStore struct {
+	name   string
+	chunks uint32
+}
+
+// chunks returns the total number of chunks of the snapshot, including the index.
+func (idx *snapshotIndex) chunks() uint64 {
+	total := uint64(1) + uint64(idx.extensionChunks)
+	for _, store := range idx.stores {
+		total += uint64(store.chunks)
+	}
+	return total
+}
+
+// Marshal returns the encoded byte representation of the index.
+// NOTE: the index is encoded as follows:
+// - commit info (bytes)
+// - number of stores (uvarint)
+// - for each store:
+//   - store name (bytes)
+//   - number of chunks (uvarint)
+//
+// - number of chunks of the extensions (uvarint)
+func (idx *snapshotIndex) Marshal() ([]byte, error) {
+	commitInfo, err := idx.commitInfo.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := encoding.EncodeBytes(&buf, commitInfo); err != nil {
+		return nil, err
+	}
+	if err := encoding.EncodeUvarint(&buf, uint64(len(idx.stores))); err != nil {
+		return nil, err
+	}
+	for _, store := range idx.stores {
+		if err := encoding.EncodeBytes(&buf, []byte(store.name)); err != nil {
+			return nil, err
+		}
+		if err := encoding.EncodeUvarint(&buf, uint64(store.chunks)); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoding.EncodeUvarint(&buf, uint64(idx.extensionChunks)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal unmarshals the encoded byte representation of the index.
+func (idx *snapshotIndex) Unmarshal(buf []byte) error {
+	commitInfo, n, err := encoding.DecodeBytes(buf)
+	if err != nil {
+		return err
+	}
+	buf = buf[n:]
+	idx.commitInfo = &proof.CommitInfo{}
+	if err := idx.commitInfo.Unmarshal(commitInfo); err != nil {
+		return fmt.Errorf("invalid commit info: %w", err)
+	}
+
+	storesLen, n, err := encoding.DecodeUvarint(buf)
+	if err != nil {
+		return err
+	}
+	buf = buf[n:]
+	// each store takes at least two bytes
+	if storesLen > uint64(len(buf)/2) {
+		return fmt.Errorf("invalid number of stores %d", storesLen)
+	}
+	idx.stores = make([]indexedStore, storesLen)
+	for i := range idx.stores {
+		name, n, err := encoding.DecodeBytes(buf)
+		if err != nil {
+			return err
+		}
+		buf = buf[n:]
+		chunks, n, err := encoding.DecodeUvarint(buf)
+		if err != nil {
+			return err
+		}
+		buf = buf[n:]
+		if chunks > math.MaxUint32 {
+			return fmt.Errorf("invalid number of chunks %d for store %s", chunks, name)
+		}
+		idx.stores[i] = indexedStore{name: string(name), chunks: uint32(chunks)}
+	}
+
+	extensionChunks, n, err := encoding.DecodeUvarint(buf)
+	if err != nil {
+		return err
+	}
+	if extensionChunks > math.MaxUint32 {
+		return fmt.Errorf("invalid number of extension chunks %d", extensionChunks)
+	}
+	idx.extensionChunks = uint32(extensionChunks)
+	if n != len(buf) {
+		return errors.New("unexpected bytes after the snapshot index")
+	}
+
+	return nil
+}
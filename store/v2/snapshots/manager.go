@@ -2,18 +2,25 @@ package snapshots
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"os"
+	"path/filepath"
+	"runtime"
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+	"golang.org/x/sync/errgroup"
+
 	corelog "cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors/v2"
+	"cosmossdk.io/store/v2"
 	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/snapshots/types"
 )
@@ -187,11 +194,111 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
+	if snapshotter, ok := m.commitSnapshotter.(StoreSnapshotter); ok && m.opts.Indexed {
+		go m.createIndexedSnapshot(height, snapshotter, ch)
+		return m.store.Save(height, types.IndexedFormat, ch)
+	}
 	go m.createSnapshot(height, ch)
 
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// CreateDelta creates an incremental snapshot in the types.DeltaFormat on top of the latest
+// snapshot and returns its metadata. The changesets since the latest snapshot are read from
+// the storage state, so the StorageSnapshotter must implement ChangesetReader.
+func (m *Manager) CreateDelta(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "Snapshot Manager is nil")
+	}
+
+	reader, ok := m.storageSnapshotter.(ChangesetReader)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrUnknownFormat, "storage snapshotter doesn't support delta snapshots")
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if base == nil {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "no snapshot to take a delta snapshot on")
+	}
+	if base.Height >= height {
+		return nil, errorsmod.Wrapf(storeerrors.ErrConflict,
+			"a more recent snapshot already exists at height %v", base.Height)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createDeltaSnapshot(reader, base, height, ch)
+
+	return m.store.Save(height, types.DeltaFormat, ch)
+}
+
+// createDeltaSnapshot writes the chunks of a delta snapshot to the channel, the first one
+// being the header referencing the base snapshot.
+func (m *Manager) createDeltaSnapshot(reader ChangesetReader, base *types.Snapshot, height uint64, ch chan<- io.ReadCloser) {
+	ch <- io.NopCloser(bytes.NewReader(encodeDeltaHeader(base.Height, base.Format)))
+
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	err := reader.IterateChangesets(base.Height, height, func(version uint64, cs *corestore.Changeset) error {
+		return writeChangeset(streamWriter, version, cs)
+	})
+	if err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// writeChangeset writes the changeset of a version to the stream of a delta snapshot.
+func writeChangeset(protoWriter protoio.Writer, version uint64, cs *corestore.Changeset) error {
+	for _, pairs := range cs.Changes {
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Store{
+				Store: &types.SnapshotStoreItem{Name: string(pairs.Actor)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		for _, kv := range pairs.StateChanges {
+			item := &types.SnapshotIAVLItem{
+				Key:     kv.Key,
+				Value:   kv.Value,
+				Version: int64(version),
+			}
+			if kv.Remove {
+				item.Height = -1
+			}
+			if err := protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_IAVL{IAVL: item},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
@@ -209,10 +316,18 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// writeExtensions writes the snapshots of the extensions to the stream.
+func (m *Manager) writeExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -221,17 +336,160 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+			return types.WriteExtensionPayload(protoWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createIndexedSnapshot creates a snapshot in the IndexedFormat. The section of
+// each store is written concurrently to temporary chunk files, which are then
+// written to the channel after the index.
+func (m *Manager) createIndexedSnapshot(height uint64, snapshotter StoreSnapshotter, ch chan<- io.ReadCloser) {
+	dir, err := os.MkdirTemp(m.store.dir, fmt.Sprintf("sections-%d-", height))
+	if err != nil {
+		NewChunkWriter(ch, 0).CloseWithError(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	idx, err := m.writeSections(height, snapshotter, dir)
+	if err != nil {
+		NewChunkWriter(ch, 0).CloseWithError(err)
+		return
+	}
+	bz, err := idx.Marshal()
+	if err != nil {
+		NewChunkWriter(ch, 0).CloseWithError(err)
+		return
+	}
+
+	ch <- io.NopCloser(bytes.NewReader(bz))
+	sectionChunks := make([]uint32, 0, len(idx.stores)+1)
+	for _, store := range idx.stores {
+		sectionChunks = append(sectionChunks, store.chunks)
+	}
+	sectionChunks = append(sectionChunks, idx.extensionChunks)
+	for section, chunks := range sectionChunks {
+		for chunk := uint32(0); chunk < chunks; chunk++ {
+			f, err := os.Open(sectionChunkPath(dir, section, chunk))
+			if err != nil {
+				NewChunkWriter(ch, 0).CloseWithError(err)
+				return
+			}
+			// copy the chunk through a pipe, so that the files are only removed
+			// once they are all read
+			pr, pw := io.Pipe()
+			ch <- pr
+			_, err = io.Copy(pw, f)
+			_ = f.Close()
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				close(ch)
+				return
+			}
+			_ = pw.Close()
+		}
+	}
+	close(ch)
+}
+
+// writeSections writes the sections of the stores and the extensions to chunk
+// files in dir, and returns the index of the snapshot.
+func (m *Manager) writeSections(height uint64, snapshotter StoreSnapshotter, dir string) (*snapshotIndex, error) {
+	commitInfo, err := snapshotter.GetCommitInfo(height)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo == nil {
+		return nil, fmt.Errorf("commit info of version %d not found", height)
+	}
+
+	idx := &snapshotIndex{
+		commitInfo: commitInfo,
+		stores:     make([]indexedStore, len(commitInfo.StoreInfos)),
+	}
+	eg := new(errgroup.Group)
+	eg.SetLimit(runtime.NumCPU())
+	for i, storeInfo := range commitInfo.StoreInfos {
+		name := string(storeInfo.Name)
+		eg.Go(func() error {
+			chunks, err := writeSection(dir, i, func(protoWriter protoio.Writer) error {
+				return snapshotter.SnapshotStore(height, name, protoWriter)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to snapshot store %s: %w", name, err)
+			}
+			idx.stores[i] = indexedStore{name: name, chunks: chunks}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	idx.extensionChunks, err = writeSection(dir, len(idx.stores), func(protoWriter protoio.Writer) error {
+		return m.writeExtensions(height, protoWriter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return idx, nil
+}
+
+// writeSection writes the snapshot stream written by fn to the chunk files of the
+// section in dir, and returns the number of chunks.
+func writeSection(dir string, section int, fn func(protoWriter protoio.Writer) error) (uint32, error) {
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := NewStreamWriter(ch)
+		if streamWriter == nil {
+			return
+		}
+		if err := fn(streamWriter); err != nil {
 			streamWriter.CloseWithError(err)
 			return
 		}
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+	defer DrainChunks(ch)
+
+	chunks := uint32(0)
+	for chunk := range ch {
+		err := func() error {
+			defer chunk.Close()
+			f, err := os.Create(sectionChunkPath(dir, section, chunks))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, chunk); err != nil {
+				_ = f.Close()
+				return err
+			}
+			return f.Close()
+		}()
+		if err != nil {
+			return 0, err
+		}
+		chunks++
 	}
+
+	return chunks, nil
+}
+
+// sectionChunkPath returns the path of a temporary chunk file of a section.
+func sectionChunkPath(dir string, section int, chunk uint32) string {
+	return filepath.Join(dir, fmt.Sprintf("%d-%d", section, chunk))
 }
 
 // CreateMigration creates a migration snapshot and writes it to the given writer.
@@ -266,8 +524,20 @@ func (m *Manager) EndMigration(commitSnapshotter CommitSnapshotter) {
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+// Delta snapshots are not listed, as they can't be restored through state sync.
 func (m *Manager) List() ([]*types.Snapshot, error) {
-	return m.store.List()
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	listed := snapshots[:0]
+	for _, snapshot := range snapshots {
+		if snapshot.Format != types.DeltaFormat {
+			listed = append(listed, snapshot)
+		}
+	}
+	return listed, nil
 }
 
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
@@ -310,7 +580,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !m.isFormatSupported(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -378,43 +648,200 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	var nextItem types.SnapshotItem
+	switch snapshot.Format {
+	case types.IndexedFormat:
+		return m.doRestoreIndexedSnapshot(snapshot, chChunks)
+	case types.DeltaFormat:
+		return m.doRestoreDeltaSnapshot(snapshot, chChunks)
+	}
+
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
-	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
-	payloadReader := func() ([]byte, error) {
-		nextItem.Reset()
-		if err := streamReader.ReadMsg(&nextItem); err != nil {
-			return nil, err
+	chStorage, storageErrs := m.restoreStorage(snapshot.Height)
+
+	nextItem, err := m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+	close(chStorage)
+
+	if err := m.restoreExtensions(snapshot.Height, streamReader, nextItem); err != nil {
+		return err
+	}
+
+	// wait for storage snapshotter to complete
+	if err := <-storageErrs; err != nil {
+		return errorsmod.Wrap(err, "storage snapshotter")
+	}
+
+	return nil
+}
+
+// doRestoreDeltaSnapshot restores a snapshot in the types.DeltaFormat on top of the state of its
+// base snapshot, by applying and committing the changeset of each version to both the commitment
+// and the storage state, so that the commitment state ends up with the same hashes.
+func (m *Manager) doRestoreDeltaSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	defer DrainChunks(chChunks)
+
+	committer, ok := m.commitSnapshotter.(store.Committer)
+	if !ok {
+		return errorsmod.Wrap(types.ErrUnknownFormat, "commitment snapshotter doesn't support delta snapshots")
+	}
+	db, ok := m.storageSnapshotter.(store.VersionedDatabase)
+	if !ok {
+		return errorsmod.Wrap(types.ErrUnknownFormat, "storage snapshotter doesn't support delta snapshots")
+	}
+
+	header, ok := <-chChunks
+	if !ok {
+		return errorsmod.Wrap(types.ErrInvalidMetadata, "missing delta header")
+	}
+	bz, err := io.ReadAll(io.LimitReader(header, deltaHeaderMaxSize+1))
+	_ = header.Close()
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read delta header")
+	}
+	base, _, err := decodeDeltaHeader(bz)
+	if err != nil {
+		return err
+	}
+	if base >= snapshot.Height {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "delta at height %v has base height %v", snapshot.Height, base)
+	}
+
+	commitVersion, err := committer.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	storageVersion, err := db.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if commitVersion != base || storageVersion != base {
+		return fmt.Errorf("state is at version %d (commitment) and %d (storage), but the delta snapshot is based on version %d",
+			commitVersion, storageVersion, base)
+	}
+
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	version := base + 1
+	cs := corestore.NewChangeset()
+	// commit commits the changeset of the current version and moves to the next one.
+	commit := func() error {
+		if err := committer.WriteChangeset(cs); err != nil {
+			return err
 		}
-		payload := nextItem.GetExtensionPayload()
-		if payload == nil {
-			return nil, io.EOF
+		if _, err := committer.Commit(version); err != nil {
+			return err
+		}
+		if err := db.ApplyChangeset(version, cs); err != nil {
+			return err
+		}
+		version++
+		cs = corestore.NewChangeset()
+		return nil
+	}
+
+	var (
+		storeKey []byte
+		nextItem types.SnapshotItem
+	)
+loop:
+	for {
+		nextItem = types.SnapshotItem{}
+		err := streamReader.ReadMsg(&nextItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := nextItem.Item.(type) {
+		case *types.SnapshotItem_Store:
+			storeKey = []byte(item.Store.Name)
+		case *types.SnapshotItem_IAVL:
+			if storeKey == nil {
+				return errorsmod.Wrap(storeerrors.ErrLogic, "received IAVL node item before store item")
+			}
+			if item.IAVL.Version < int64(version) || uint64(item.IAVL.Version) > snapshot.Height {
+				return errorsmod.Wrapf(storeerrors.ErrLogic, "unexpected change at version %d", item.IAVL.Version)
+			}
+			for version < uint64(item.IAVL.Version) {
+				if err := commit(); err != nil {
+					return err
+				}
+			}
+			switch item.IAVL.Height {
+			case 0:
+				if item.IAVL.Value == nil {
+					item.IAVL.Value = []byte{}
+				}
+				cs.Add(storeKey, item.IAVL.Key, item.IAVL.Value, false)
+			case -1:
+				cs.Add(storeKey, item.IAVL.Key, nil, true)
+			default:
+				return errorsmod.Wrapf(storeerrors.ErrLogic, "unexpected change height %d", item.IAVL.Height)
+			}
+		default:
+			break loop
 		}
-		return payload.Payload, nil
 	}
 
+	// the versions without changes are committed as well
+	for version <= snapshot.Height {
+		if err := commit(); err != nil {
+			return err
+		}
+	}
+
+	return m.restoreExtensions(snapshot.Height, streamReader, nextItem)
+}
+
+// restoreStorage starts the restoration of the storage state from the returned
+// channel, which must be closed once all the state is sent. The error of the
+// restoration is sent to the returned error channel.
+func (m *Manager) restoreStorage(height uint64) (chan<- *corestore.StateChanges, <-chan error) {
 	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
 	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
 
 	storageErrs := make(chan error, 1)
 	go func() {
 		defer close(storageErrs)
-		err := m.storageSnapshotter.Restore(snapshot.Height, chStorage)
+		err := m.storageSnapshotter.Restore(height, chStorage)
 		if err != nil {
 			storageErrs <- err
+			// unblock the commitment restoration still sending state
+			for range chStorage {
+			}
 		}
 	}()
 
-	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
-	if err != nil {
-		return errorsmod.Wrap(err, "multistore restore")
+	return chStorage, storageErrs
+}
+
+// restoreExtensions restores the extension snapshots from the stream, of which
+// nextItem is the first item.
+func (m *Manager) restoreExtensions(height uint64, streamReader protoio.Reader, nextItem types.SnapshotItem) error {
+	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
+	payloadReader := func() ([]byte, error) {
+		nextItem.Reset()
+		if err := streamReader.ReadMsg(&nextItem); err != nil {
+			return nil, err
+		}
+		payload := nextItem.GetExtensionPayload()
+		if payload == nil {
+			return nil, io.EOF
+		}
+		return payload.Payload, nil
 	}
-	close(chStorage)
 
 	for {
 		if nextItem.Item == nil {
@@ -433,7 +860,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 			return errorsmod.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
 
-		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
 			return errorsmod.Wrapf(err, "extension %s restore", metadata.Name)
 		}
 
@@ -442,11 +869,169 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		}
 	}
 
+	return nil
+}
+
+// doRestoreIndexedSnapshot restores a snapshot in the IndexedFormat. The stores
+// are restored concurrently as soon as the chunks of their section are received,
+// and their hashes are verified against the commit info of the index before the
+// restoration is finalized.
+func (m *Manager) doRestoreIndexedSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	defer DrainChunks(chChunks)
+
+	snapshotter, ok := m.commitSnapshotter.(StoreSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	idx, err := readIndex(snapshot, chChunks)
+	if err != nil {
+		return err
+	}
+
+	chStorage, storageErrs := m.restoreStorage(snapshot.Height)
+
+	err = m.restoreSections(snapshot.Height, snapshotter, idx, chChunks, chStorage)
+	close(chStorage)
+	if err != nil {
+		return err
+	}
+
 	// wait for storage snapshotter to complete
 	if err := <-storageErrs; err != nil {
 		return errorsmod.Wrap(err, "storage snapshotter")
 	}
 
+	return snapshotter.FinalizeRestore(snapshot.Height, idx.commitInfo)
+}
+
+// readIndex reads the index from the first chunk and validates it against the
+// snapshot metadata.
+func readIndex(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) (*snapshotIndex, error) {
+	chunk, ok := <-chChunks
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrInvalidMetadata, "missing snapshot index")
+	}
+	bz, err := io.ReadAll(chunk)
+	_ = chunk.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &snapshotIndex{}
+	if err := idx.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "invalid snapshot index: %v", err)
+	}
+	if idx.chunks() != uint64(snapshot.Chunks) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot index has %d chunks, but the snapshot has %d chunks",
+			idx.chunks(), snapshot.Chunks)
+	}
+	if idx.commitInfo.Version != snapshot.Height {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot index has commit info of version %d, but the snapshot height is %d",
+			idx.commitInfo.Version, snapshot.Height)
+	}
+	// every store of the commit info is restored, so that all the hashes are verified
+	if len(idx.stores) != len(idx.commitInfo.StoreInfos) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot index has %d stores, but the commit info has %d stores",
+			len(idx.stores), len(idx.commitInfo.StoreInfos))
+	}
+	for i, store := range idx.stores {
+		if store.name != string(idx.commitInfo.StoreInfos[i].Name) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot index has store %s, but the commit info has store %s",
+				store.name, idx.commitInfo.StoreInfos[i].Name)
+		}
+	}
+
+	return idx, nil
+}
+
+// restoreSections dispatches the chunks of each store section to a concurrent
+// restoration of the store, then restores the extensions section.
+func (m *Manager) restoreSections(
+	height uint64,
+	snapshotter StoreSnapshotter,
+	idx *snapshotIndex,
+	chChunks <-chan io.ReadCloser,
+	chStorage chan<- *corestore.StateChanges,
+) error {
+	eg, ctx := errgroup.WithContext(context.Background())
+	eg.SetLimit(runtime.NumCPU())
+	for i, store := range idx.stores {
+		chSection := make(chan io.ReadCloser, chunkBufferSize)
+		expectedHash := idx.commitInfo.StoreInfos[i].GetHash()
+		eg.Go(func() error {
+			defer DrainChunks(chSection)
+			streamReader, err := NewStreamReader(chSection)
+			if err != nil {
+				return err
+			}
+			defer streamReader.Close()
+
+			hash, err := snapshotter.RestoreStore(height, store.name, streamReader, chStorage)
+			if err != nil {
+				return errorsmod.Wrapf(err, "store %s restore", store.name)
+			}
+			if !bytes.Equal(hash, expectedHash) {
+				return errorsmod.Wrapf(types.ErrStoreHashMismatch, "store %s: expected %X, got %X", store.name, expectedHash, hash)
+			}
+			return nil
+		})
+
+		err := forwardChunks(ctx, chChunks, chSection, store.chunks)
+		close(chSection)
+		if err != nil {
+			if waitErr := eg.Wait(); waitErr != nil {
+				return waitErr
+			}
+			return err
+		}
+	}
+
+	if idx.extensionChunks > 0 {
+		err := func() error {
+			streamReader, err := NewStreamReader(chChunks)
+			if err != nil {
+				return err
+			}
+			defer streamReader.Close()
+
+			var nextItem types.SnapshotItem
+			if err := streamReader.ReadMsg(&nextItem); err != nil && !errors.Is(err, io.EOF) {
+				return err
+			}
+			return m.restoreExtensions(height, streamReader, nextItem)
+		}()
+		if err != nil {
+			if waitErr := eg.Wait(); waitErr != nil {
+				return waitErr
+			}
+			return err
+		}
+	}
+
+	return eg.Wait()
+}
+
+// forwardChunks forwards the given number of chunks from one channel to another,
+// it stops when the context is canceled.
+func forwardChunks(ctx context.Context, from <-chan io.ReadCloser, to chan<- io.ReadCloser, chunks uint32) error {
+	for i := uint32(0); i < chunks; i++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case chunk, ok := <-from:
+			if !ok {
+				return errorsmod.Wrap(types.ErrInvalidMetadata, "missing snapshot chunks")
+			}
+			select {
+			case <-ctx.Done():
+				_ = chunk.Close()
+				return ctx.Err()
+			case to <- chunk:
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -514,14 +1099,15 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A delta snapshot is restored
+// by restoring its chain of snapshots in order, starting with the full snapshot.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	chain, err := m.store.GetChain(height, format)
 	if err != nil {
 		return err
 	}
 
-	if snapshot == nil {
+	if chain == nil {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
 
@@ -534,7 +1120,18 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, snapshot := range chain {
+		_, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+		if err != nil {
+			return err
+		}
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			DrainChunks(ch)
+			return errorsmod.Wrapf(err, "failed to restore snapshot at height %d format %d", snapshot.Height, snapshot.Format)
+		}
+	}
+
+	return nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -558,6 +1155,17 @@ func IsFormatSupported(snapshotter ExtensionSnapshotter, format uint32) bool {
 	return false
 }
 
+// isFormatSupported returns whether the manager can restore snapshots of the given format
+// from peers. Delta snapshots are only restored locally, since they require the state of
+// their base snapshot.
+func (m *Manager) isFormatSupported(format uint32) bool {
+	if format == types.IndexedFormat {
+		_, ok := m.commitSnapshotter.(StoreSnapshotter)
+		return ok
+	}
+	return format == types.CurrentFormat
+}
+
 // SnapshotIfApplicable takes a snapshot of the current state if we are on a snapshot height.
 // It also prunes any old snapshots.
 func (m *Manager) SnapshotIfApplicable(height int64) {
@@ -577,6 +1185,27 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// shouldTakeDelta returns true if the next snapshot should be a delta of the latest one, which
+// is the case until the latest one has MaxDeltas deltas since the last full snapshot.
+func (m *Manager) shouldTakeDelta() bool {
+	if m.opts.MaxDeltas == 0 {
+		return false
+	}
+	if _, ok := m.storageSnapshotter.(ChangesetReader); !ok {
+		return false
+	}
+	latest, err := m.store.GetLatest()
+	if err != nil || latest == nil {
+		return false
+	}
+	chain, err := m.store.GetChain(latest.Height, latest.Format)
+	if err != nil {
+		m.logger.Error("failed to load the chain of the latest snapshot", "height", latest.Height, "err", err)
+		return false
+	}
+	return uint32(len(chain)-1) < m.opts.MaxDeltas
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
@@ -585,10 +1214,22 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
-	if err != nil {
-		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
-		return
+	var (
+		snapshot *types.Snapshot
+		err      error
+	)
+	if m.shouldTakeDelta() {
+		snapshot, err = m.CreateDelta(uint64(height))
+		if err != nil {
+			m.logger.Error("failed to create delta state snapshot, creating a full one", "height", height, "err", err)
+		}
+	}
+	if snapshot == nil {
+		snapshot, err = m.Create(uint64(height))
+		if err != nil {
+			m.logger.Error("failed to create state snapshot", "height", height, "err", err)
+			return
+		}
 	}
 
 	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
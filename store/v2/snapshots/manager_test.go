@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/store/v2/snapshots"
 	"cosmossdk.io/store/v2/snapshots/types"
@@ -256,6 +257,149 @@ func TestManager_Restore(t *testing.T) {
 	}
 }
 
+func TestManager_IndexedSnapshot(t *testing.T) {
+	store := setupStore(t)
+	stores := map[string][][]byte{
+		"store1": {{1, 2, 3}, {4, 5, 6}},
+		"store2": {},
+		"store3": {{7, 8, 9}},
+	}
+	source := &mockStoreSnapshotter{stores: stores}
+
+	// the snapshots are taken in the current format unless the indexed format is enabled
+	manager := snapshots.NewManager(store, opts, source, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+	snapshot, err := manager.Create(4)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentFormat, snapshot.Format)
+
+	indexedOpts := opts
+	indexedOpts.Indexed = true
+	manager = snapshots.NewManager(store, indexedOpts, source, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	// the index is followed by a section for each store and one for the extensions
+	snapshot, err = manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.IndexedFormat, snapshot.Format)
+	require.Equal(t, uint32(5), snapshot.Chunks)
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	snapshotChunks := readChunks(chunks)
+
+	restore := func(target *mockStoreSnapshotter, storageSnapshotter *mockStorageSnapshotter, extSnapshotter *extSnapshotter) error {
+		targetStore, err := snapshots.NewStore(t.TempDir())
+		require.NoError(t, err)
+		manager := snapshots.NewManager(targetStore, opts, target, storageSnapshotter, nil, coretesting.NewNopLogger())
+		require.NoError(t, manager.RegisterExtensions(extSnapshotter))
+
+		require.NoError(t, manager.Restore(*snapshot))
+		for i, chunk := range snapshotChunks {
+			done, err := manager.RestoreChunk(chunk)
+			if err != nil {
+				return err
+			}
+			require.Equal(t, i == len(snapshotChunks)-1, done)
+		}
+		return nil
+	}
+
+	target := &mockStoreSnapshotter{stores: map[string][][]byte{}}
+	storageSnapshotter := &mockStorageSnapshotter{items: map[string][]byte{}}
+	extSnapshotter := newExtSnapshotter(0)
+	require.NoError(t, restore(target, storageSnapshotter, extSnapshotter))
+	require.Equal(t, stores, target.stores)
+	require.Len(t, storageSnapshotter.items, 3)
+	require.Equal(t, []byte{4, 5, 6}, storageSnapshotter.items["store1-1"])
+	require.Len(t, extSnapshotter.state, 10)
+	expectedCommitInfo, err := source.GetCommitInfo(5)
+	require.NoError(t, err)
+	require.Equal(t, expectedCommitInfo.Hash(), target.commitInfo.Hash())
+
+	// the restored hashes are verified against the commit info
+	target = &mockStoreSnapshotter{stores: map[string][][]byte{}, corrupt: true}
+	err = restore(target, &mockStorageSnapshotter{items: map[string][]byte{}}, newExtSnapshotter(0))
+	require.ErrorIs(t, err, types.ErrStoreHashMismatch)
+	require.Nil(t, target.commitInfo)
+
+	// the format is not supported without a StoreSnapshotter
+	manager = snapshots.NewManager(store, opts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	err = manager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+}
+
+func TestManager_DeltaSnapshot(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	changesets := map[uint64]*corestore.Changeset{
+		3: corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"store1": {{Key: []byte("key1"), Value: []byte("value1")}, {Key: []byte("key2"), Remove: true}},
+			"store2": {{Key: []byte("key3"), Value: []byte("value3")}},
+		}),
+		// version 4 has no changes
+		5: corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"store1": {{Key: []byte("key1"), Remove: true}},
+		}),
+	}
+	source := &mockChangesetStorage{changesets: changesets}
+	manager := snapshots.NewManager(store, opts, &mockCommitSnapshotter{items: [][]byte{{1, 2, 3}}}, source, nil, coretesting.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	// a delta snapshot requires a base snapshot
+	_, err = manager.CreateDelta(5)
+	require.Error(t, err)
+
+	_, err = manager.Create(2)
+	require.NoError(t, err)
+	snapshot, err := manager.CreateDelta(5)
+	require.NoError(t, err)
+	require.Equal(t, types.DeltaFormat, snapshot.Format)
+	baseHeight, baseFormat, err := store.GetDeltaBase(5, types.DeltaFormat)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), baseHeight)
+	require.Equal(t, types.CurrentFormat, baseFormat)
+	chain, err := store.GetChain(5, types.DeltaFormat)
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	require.Equal(t, uint64(2), chain[0].Height)
+
+	// delta snapshots are not listed over ABCI, as they can't be restored from peers
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, types.CurrentFormat, list[0].Format)
+
+	target := snapshots.NewManager(store, opts, &mockDeltaCommitter{}, &mockChangesetStorage{}, nil, coretesting.NewNopLogger())
+	err = target.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	// the chain is restored locally, committing every version of the delta
+	committer := &mockDeltaCommitter{}
+	storage := &mockChangesetStorage{mockStorageSnapshotter: mockStorageSnapshotter{items: map[string][]byte{}}}
+	extSnapshotter := newExtSnapshotter(0)
+	target = snapshots.NewManager(store, opts, committer, storage, nil, coretesting.NewNopLogger())
+	require.NoError(t, target.RegisterExtensions(extSnapshotter))
+	require.NoError(t, target.RestoreLocalSnapshot(5, types.DeltaFormat))
+	require.Equal(t, [][]byte{{1, 2, 3}}, committer.items)
+	require.Equal(t, uint64(5), committer.version)
+	require.Equal(t, uint64(5), storage.version)
+	// the extensions are restored with each snapshot of the chain
+	require.Len(t, extSnapshotter.state, 20)
+	expected := map[uint64]*corestore.Changeset{3: changesets[3], 4: corestore.NewChangeset(), 5: changesets[5]}
+	require.Equal(t, expected, committer.changesets)
+	require.Equal(t, expected, storage.changesets)
+
+	// the base of a retained delta snapshot is not pruned
+	pruned, err := manager.Prune(1)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+	_, err = manager.Create(6)
+	require.NoError(t, err)
+	pruned, err = manager.Prune(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pruned)
+}
+
 func TestManager_TakeError(t *testing.T) {
 	snapshotter := &mockErrorCommitSnapshotter{}
 	store, err := snapshots.NewStore(t.TempDir())
@@ -8,6 +8,18 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// MaxDeltas defines how many incremental snapshots are taken on top of each full
+	// snapshot, each one containing the changesets since the previous snapshot. The
+	// versions since the previous snapshot must not be pruned from the storage state
+	// for it to be taken, otherwise a full snapshot is taken instead. 0 disables
+	// incremental snapshots.
+	MaxDeltas uint32
+
+	// Indexed takes the full snapshots in the types.IndexedFormat, whose stores are
+	// restored in parallel, when the commitment snapshotter supports it. The nodes
+	// restoring the snapshots must support the format, so it is disabled by default.
+	Indexed bool
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -4,6 +4,7 @@ import (
 	protoio "github.com/cosmos/gogoproto/io"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -17,12 +18,45 @@ type CommitSnapshotter interface {
 	Restore(version uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) (types.SnapshotItem, error)
 }
 
+// StoreSnapshotter is an optional interface of the CommitSnapshotter which snapshots
+// and restores the commitment state of each store independently. The manager takes
+// snapshots in the types.IndexedFormat when the CommitSnapshotter implements it and
+// SnapshotOptions.Indexed is set.
+type StoreSnapshotter interface {
+	// GetCommitInfo returns the commit info of the given version, which lists the
+	// stores to snapshot.
+	GetCommitInfo(version uint64) (*proof.CommitInfo, error)
+
+	// SnapshotStore writes a snapshot of the commitment state of the store at the
+	// given version.
+	SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores the commitment state of the store from the snapshot
+	// reader and returns its hash. It is called concurrently for different stores.
+	RestoreStore(version uint64, storeKey string, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) ([]byte, error)
+
+	// FinalizeRestore completes the restoration once all the stores are restored
+	// and their hashes are verified against the commit info.
+	FinalizeRestore(version uint64, commitInfo *proof.CommitInfo) error
+}
+
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
 	// Restore restores the storage state from the given channel.
 	Restore(version uint64, chStorage <-chan *corestore.StateChanges) error
 }
 
+// ChangesetReader is an optional interface of the StorageSnapshotter which reads the changesets
+// of past versions back from the storage state. The manager requires it to take snapshots in the
+// types.DeltaFormat, whose stream contains, for each version, a SnapshotStoreItem for each changed
+// store followed by its changes, as SnapshotIAVLItem with the version as Version, and a Height
+// of 0 for a set or -1 for a delete.
+type ChangesetReader interface {
+	// IterateChangesets calls fn with the changeset of each version in (from, to], in
+	// ascending order of versions.
+	IterateChangesets(from, to uint64, fn func(version uint64, cs *corestore.Changeset) error) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -186,6 +186,60 @@ func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.R
 	return snapshot, ch, nil
 }
 
+// GetDeltaBase returns the height and format of the base snapshot of a snapshot in the
+// types.DeltaFormat, which are stored in its first chunk.
+func (s *Store) GetDeltaBase(height uint64, format uint32) (uint64, uint32, error) {
+	if format != types.DeltaFormat {
+		return 0, 0, errors.Wrapf(types.ErrUnknownFormat, "snapshot format %v is not a delta", format)
+	}
+	chunk, err := s.loadChunkFile(height, format, 0)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to load delta header for height %v", height)
+	}
+	defer chunk.Close()
+
+	bz, err := io.ReadAll(io.LimitReader(chunk, deltaHeaderMaxSize+1))
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to read delta header for height %v", height)
+	}
+	baseHeight, baseFormat, err := decodeDeltaHeader(bz)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to decode delta header for height %v", height)
+	}
+	if baseHeight >= height {
+		return 0, 0, errors.Wrapf(types.ErrInvalidMetadata, "delta at height %v has base height %v", height, baseHeight)
+	}
+	return baseHeight, baseFormat, nil
+}
+
+// GetChain returns the snapshots to restore in order to restore the given snapshot, starting
+// with a full snapshot and followed by the delta snapshots up to the given one. It returns nil
+// if the snapshot does not exist, and errors if any of its bases is missing.
+func (s *Store) GetChain(height uint64, format uint32) ([]*types.Snapshot, error) {
+	snapshot, err := s.Get(height, format)
+	if snapshot == nil || err != nil {
+		return nil, err
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.Format == types.DeltaFormat {
+		baseHeight, baseFormat, err := s.GetDeltaBase(snapshot.Height, snapshot.Format)
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err = s.Get(baseHeight, baseFormat)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot == nil {
+			return nil, errors.Wrapf(types.ErrInvalidMetadata,
+				"base snapshot for height %v format %v doesn't exist", baseHeight, baseFormat)
+		}
+		chain = append([]*types.Snapshot{snapshot}, chain...)
+	}
+	return chain, nil
+}
+
 // LoadChunk loads a chunk from disk, or returns nil if it does not exist. The caller must call
 // Close() on it when done.
 func (s *Store) LoadChunk(height uint64, format, chunk uint32) (io.ReadCloser, error) {
@@ -203,7 +257,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// as well as the bases of the retained delta snapshots.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	metadata, err := os.ReadDir(s.pathMetadataDir())
 	if err != nil {
@@ -213,14 +268,25 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	bases := make(map[uint64]bool)
 	for i := len(metadata) - 1; i >= 0; i-- {
 		height, format, err := s.parseMetadataFilename(metadata[i].Name())
 		if err != nil {
 			return 0, err
 		}
 
-		if skip[height] || uint32(len(skip)) < retain {
-			skip[height] = true
+		if skip[height] || bases[height] || uint32(len(skip)) < retain {
+			if !bases[height] {
+				skip[height] = true
+			}
+			// snapshots are iterated from the newest, so the bases are always found after their deltas
+			if format == types.DeltaFormat {
+				baseHeight, _, err := s.GetDeltaBase(height, format)
+				if err != nil {
+					return 0, errors.Wrap(err, "failed to prune snapshots")
+				}
+				bases[baseHeight] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -367,6 +433,32 @@ func (s *Store) PathChunk(height uint64, format, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
 }
 
+// deltaHeaderMaxSize is the maximum size of the first chunk of a delta snapshot.
+const deltaHeaderMaxSize = binary.MaxVarintLen64 + binary.MaxVarintLen32
+
+// encodeDeltaHeader encodes the first chunk of a delta snapshot, which holds the height and
+// format of its base snapshot as uvarints.
+func encodeDeltaHeader(baseHeight uint64, baseFormat uint32) []byte {
+	bz := binary.AppendUvarint(nil, baseHeight)
+	return binary.AppendUvarint(bz, uint64(baseFormat))
+}
+
+// decodeDeltaHeader decodes the first chunk of a delta snapshot.
+func decodeDeltaHeader(bz []byte) (uint64, uint32, error) {
+	baseHeight, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return 0, 0, errors.Wrap(types.ErrInvalidMetadata, "invalid base height")
+	}
+	baseFormat, m := binary.Uvarint(bz[n:])
+	if m <= 0 || baseFormat > math.MaxUint32 {
+		return 0, 0, errors.Wrap(types.ErrInvalidMetadata, "invalid base format")
+	}
+	if n+m != len(bz) {
+		return 0, 0, errors.Wrap(types.ErrInvalidMetadata, "unexpected bytes after the delta header")
+	}
+	return baseHeight, uint32(baseFormat), nil
+}
+
 func (s *Store) parseMetadataFilename(filename string) (height uint64, format uint32, err error) {
 	parts := strings.Split(filename, "-")
 	if len(parts) != 2 {
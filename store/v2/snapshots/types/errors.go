@@ -16,4 +16,8 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrStoreHashMismatch is returned when the hash of a restored store does not match
+	// the commit info of the snapshot.
+	ErrStoreHashMismatch = errors.New("store hash verification failed")
 )
@@ -4,3 +4,15 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// IndexedFormat is the format of the snapshots in which the commitment state of each store is
+// chunked independently behind an index chunk, so that the stores are restored in parallel. It is
+// used instead of CurrentFormat when enabled by SnapshotOptions.Indexed and the commitment
+// snapshotter supports snapshotting each store.
+const IndexedFormat uint32 = 4
+
+// DeltaFormat is the format of incremental snapshots, which only contain the changesets of the
+// versions since a base snapshot. The first chunk holds the height and format of the base
+// snapshot, and the following chunks hold the snapshot stream. It is numbered as in
+// cosmossdk.io/store/snapshots/types, so that a format means the same in both.
+const DeltaFormat uint32 = 5
@@ -0,0 +1,68 @@
+package archive
+
+import (
+	"slices"
+
+	"cosmossdk.io/store/v2"
+)
+
+var _ store.Batch = (*batch)(nil)
+
+// batch records the writes of a batch of the hot Database, so that they are
+// archived when the batch is written.
+type batch struct {
+	db      *Database
+	version uint64
+	hot     store.Batch
+	entries []entry
+}
+
+func (b *batch) Set(storeKey, key, value []byte) error {
+	if err := b.hot.Set(storeKey, key, value); err != nil {
+		return err
+	}
+
+	b.entries = append(b.entries, entry{
+		storeKey: slices.Clone(storeKey),
+		key:      slices.Clone(key),
+		value:    slices.Clone(value),
+	})
+	return nil
+}
+
+func (b *batch) Delete(storeKey, key []byte) error {
+	if err := b.hot.Delete(storeKey, key); err != nil {
+		return err
+	}
+
+	b.entries = append(b.entries, entry{
+		storeKey: slices.Clone(storeKey),
+		key:      slices.Clone(key),
+		deleted:  true,
+	})
+	return nil
+}
+
+func (b *batch) Size() int {
+	return b.hot.Size()
+}
+
+// Write archives the writes of the batch before writing them to the hot
+// Database, then compacts the old versions if needed.
+func (b *batch) Write() error {
+	if err := b.db.write(b.version, b.entries); err != nil {
+		return err
+	}
+	if err := b.hot.Write(); err != nil {
+		return err
+	}
+	b.entries = nil
+
+	b.db.maybeCompact(b.version)
+	return nil
+}
+
+func (b *batch) Reset() error {
+	b.entries = nil
+	return b.hot.Reset()
+}
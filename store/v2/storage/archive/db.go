@@ -0,0 +1,837 @@
+package archive
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/storage"
+)
+
+const (
+	pendingDir    = "pending"
+	pendingSuffix = ".log"
+	metadataFile  = "metadata.json"
+
+	// blockCacheSize is the number of decoded blocks cached for the lookups of
+	// keys in the archive files.
+	blockCacheSize = 1024
+)
+
+var (
+	_ storage.Database         = (*Database)(nil)
+	_ store.UpgradableDatabase = (*Database)(nil)
+)
+
+// Config is the configuration of the cold archive of the state storage.
+type Config struct {
+	Enable     bool   `mapstructure:"enable" toml:"enable" comment:"Enable compacting the versions older than keep-recent into immutable archive files, which keep the full history. It must be enabled on an empty state storage, and the state storage pruning must keep at least keep-recent + interval versions."`
+	KeepRecent uint64 `mapstructure:"keep-recent" toml:"keep-recent" comment:"Number of recent versions which are only kept in the state storage database."`
+	Interval   uint64 `mapstructure:"interval" toml:"interval" comment:"Minimum number of versions compacted into each archive file."`
+}
+
+// DefaultConfig returns the default configuration of the archive, which is disabled.
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:     false,
+		KeepRecent: 10_000,
+		Interval:   1_000,
+	}
+}
+
+// ValidatePruning returns an error if the pruning of the state storage could
+// prune versions which may not be archived yet, i.e. if it keeps less versions
+// than keep-recent plus interval.
+func (c *Config) ValidatePruning(opt *store.PruningOption) error {
+	if !c.Enable || opt == nil || opt.Interval == 0 {
+		return nil
+	}
+	if horizon := c.KeepRecent + c.Interval; opt.KeepRecent < horizon {
+		return fmt.Errorf("the state storage pruning keeps %d versions, which is less than the %d versions (keep-recent + interval) which may not be archived yet", opt.KeepRecent, horizon)
+	}
+	return nil
+}
+
+// metadata is the persisted state of the archive.
+type metadata struct {
+	// ArchivedTo is the last version compacted into the archive files, the
+	// versions up to it are read from the archive files.
+	ArchivedTo uint64 `json:"archived_to"`
+	// PrunedTo is the last version pruned, which can't be read anymore.
+	PrunedTo uint64 `json:"pruned_to"`
+	// RemovedStores holds the versions at which the stores were removed.
+	RemovedStores map[string][]uint64 `json:"removed_stores,omitempty"`
+}
+
+// visible returns whether a write of the store at entryVersion is visible at
+// version, i.e. whether the store was not removed in between.
+func (m *metadata) visible(storeKey []byte, entryVersion, version uint64) bool {
+	for _, removedAt := range m.RemovedStores[string(storeKey)] {
+		if entryVersion <= removedAt && removedAt < version {
+			return false
+		}
+	}
+	return true
+}
+
+// Database is a state storage Database which compacts the versions older than
+// a configured number of recent versions into immutable, compressed and sorted
+// archive files, one per range of versions, while the recent versions are kept
+// in an underlying hot Database. Reads are transparently served from the
+// archive files or the hot Database depending on the requested version.
+//
+// The writes of each version are appended to a pending log until the version is
+// compacted, after which the hot Database is pruned up to it. The pending log is
+// synced to disk, so the writes to a hot Database which supports it are not, and
+// the versions missing from the hot Database after a crash are replayed from the
+// pending logs when the Database is opened. The archive must be enabled on an
+// empty hot Database, e.g. before restoring a snapshot, so that the archive
+// holds the whole history.
+type Database struct {
+	hot        storage.Database
+	dir        string
+	keepRecent uint64
+	interval   uint64
+	logger     log.Logger
+	// hotUnsynced is set if the writes to the hot Database are not synced.
+	hotUnsynced bool
+
+	// mtx protects the archive files and the metadata, which are replaced
+	// rather than modified so they can be used after releasing the lock.
+	mtx sync.RWMutex
+	// files are sorted by first version then sequence, and maxTo holds the
+	// greatest last version of the files up to each index, so that the files
+	// holding a version are found without scanning all of them.
+	files   []*archiveFile
+	maxTo   []uint64
+	meta    metadata
+	nextSeq uint64
+	cache   *blockCache
+
+	// logMtx serializes the writes to the pending logs and their compaction.
+	logMtx sync.Mutex
+	// compactFrom and compactTo are the versions being compacted, zero if none.
+	compactFrom, compactTo uint64
+
+	// pruneMtx serializes the pruning of the hot Database.
+	pruneMtx sync.Mutex
+
+	compacting atomic.Bool
+	// syncCompaction runs the compactions synchronously, used by tests.
+	syncCompaction bool
+	wg             sync.WaitGroup
+}
+
+// New returns a Database archiving the old versions of the hot Database in dir.
+func New(hot storage.Database, dir string, cfg *Config, logger log.Logger) (*Database, error) {
+	if cfg.Interval == 0 {
+		return nil, errors.New("the archive interval must be greater than zero")
+	}
+	if err := os.MkdirAll(filepath.Join(dir, pendingDir), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory %s: %w", dir, err)
+	}
+
+	cache, err := newBlockCache(blockCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	db := &Database{
+		hot:        hot,
+		dir:        dir,
+		keepRecent: cfg.KeepRecent,
+		interval:   cfg.Interval,
+		logger:     logger,
+		cache:      cache,
+	}
+
+	found, err := db.loadMetadata()
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		latest, err := hot.GetLatestVersion()
+		if err != nil {
+			return nil, err
+		}
+		if latest > 0 {
+			return nil, fmt.Errorf("cannot enable the archive of a state storage which has versions up to %d, it must be enabled on an empty state storage", latest)
+		}
+		if err := db.saveMetadata(db.meta); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := db.loadFiles(); err != nil {
+		return nil, err
+	}
+	if err := db.cleanPendingLogs(); err != nil {
+		return nil, err
+	}
+	if err := db.replayPendingLogs(); err != nil {
+		return nil, err
+	}
+
+	// the pending logs are synced, so the writes to the hot Database don't need to be
+	if s, ok := hot.(syncSetter); ok {
+		s.SetSync(false)
+		db.hotUnsynced = true
+	}
+
+	return db, nil
+}
+
+// syncSetter is implemented by the hot Databases whose writes can be left
+// unsynced, like pebbledb.
+type syncSetter interface {
+	SetSync(sync bool)
+}
+
+func (db *Database) loadMetadata() (bool, error) {
+	bz, err := os.ReadFile(filepath.Join(db.dir, metadataFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal(bz, &db.meta); err != nil {
+		return false, fmt.Errorf("failed to decode archive metadata: %w", err)
+	}
+	return true, nil
+}
+
+func (db *Database) saveMetadata(meta metadata) error {
+	bz, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filepath.Join(db.dir, metadataFile), bz)
+}
+
+// loadFiles opens the archive files, removing the ones left by an interrupted
+// compaction.
+func (db *Database) loadFiles() error {
+	dirEntries, err := os.ReadDir(db.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		path := filepath.Join(db.dir, name)
+		if strings.HasSuffix(name, ".tmp") {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		if !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+
+		_, to, seq, err := parseFileName(name)
+		if err != nil {
+			return err
+		}
+		db.nextSeq = max(db.nextSeq, seq+1)
+		if to > db.meta.ArchivedTo {
+			// the writes are still in the pending logs
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		af, err := openFile(path)
+		if err != nil {
+			return err
+		}
+		db.files = append(db.files, af)
+	}
+
+	db.files, db.maxTo = indexFiles(db.files)
+	return nil
+}
+
+// indexFiles sorts the files by first version then sequence, and returns them
+// with the greatest last version of the files up to each index.
+func indexFiles(files []*archiveFile) ([]*archiveFile, []uint64) {
+	slices.SortFunc(files, func(a, b *archiveFile) int {
+		return cmp.Or(cmp.Compare(a.from, b.from), cmp.Compare(a.seq, b.seq))
+	})
+
+	maxTo := make([]uint64, len(files))
+	for i, af := range files {
+		maxTo[i] = af.to
+		if i > 0 {
+			maxTo[i] = max(maxTo[i], maxTo[i-1])
+		}
+	}
+	return files, maxTo
+}
+
+// pendingVersions returns the sorted versions which have a pending log.
+func (db *Database) pendingVersions() ([]uint64, error) {
+	dirEntries, err := os.ReadDir(filepath.Join(db.dir, pendingDir))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]uint64, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		version, err := strconv.ParseUint(strings.TrimSuffix(dirEntry.Name(), pendingSuffix), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pending log %s: %w", dirEntry.Name(), err)
+		}
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+
+	return versions, nil
+}
+
+// cleanPendingLogs removes the pending logs of the versions which were compacted.
+func (db *Database) cleanPendingLogs() error {
+	versions, err := db.pendingVersions()
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		if version > db.meta.ArchivedTo {
+			break
+		}
+		if err := os.Remove(db.pendingLogPath(version)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayPendingLogs writes the pending logs of the versions missing from the hot
+// Database, which were lost by a crash since its writes are not synced. Each
+// version is written by a single batch, which the hot Database writes atomically.
+func (db *Database) replayPendingLogs() error {
+	latest, err := db.hot.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	versions, err := db.pendingVersions()
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		if version <= latest || version <= db.meta.ArchivedTo {
+			continue
+		}
+
+		entries, err := db.readPendingLog(version)
+		if err != nil {
+			return err
+		}
+		b, err := db.hot.NewBatch(version)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if e.deleted {
+				err = b.Delete(e.storeKey, e.key)
+			} else {
+				err = b.Set(e.storeKey, e.key, e.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if err := b.Write(); err != nil {
+			return fmt.Errorf("failed to replay the pending log of version %d: %w", version, err)
+		}
+		db.logger.Info("replayed the pending log of the state storage archive", "version", version)
+	}
+
+	return nil
+}
+
+func (db *Database) pendingLogPath(version uint64) string {
+	return filepath.Join(db.dir, pendingDir, fmt.Sprintf("%020d%s", version, pendingSuffix))
+}
+
+// appendPendingLog appends the writes of a batch to the pending log of the
+// version. Each batch is framed with its length and checksum, so that a batch
+// partially written by a crash is ignored.
+func (db *Database) appendPendingLog(version uint64, entries []entry) error {
+	var payload []byte
+	for i := range entries {
+		payload = appendEntry(payload, &entries[i], false)
+	}
+	frame := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+	frame = binary.BigEndian.AppendUint32(frame, crc32.ChecksumIEEE(payload))
+	frame = append(frame, payload...)
+
+	f, err := os.OpenFile(db.pendingLogPath(version), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	_, err = f.Write(frame)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		// drop the partial frame so that the next batches can be appended
+		_ = f.Truncate(info.Size())
+		_ = f.Close()
+		return fmt.Errorf("failed to write the pending log of version %d: %w", version, err)
+	}
+
+	return f.Close()
+}
+
+// readPendingLog returns the writes of the pending log of the version.
+func (db *Database) readPendingLog(version uint64) ([]entry, error) {
+	bz, err := os.ReadFile(db.pendingLogPath(version))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []entry
+	for len(bz) >= 8 {
+		size := binary.BigEndian.Uint32(bz)
+		if uint64(len(bz)-8) < uint64(size) || crc32.ChecksumIEEE(bz[8:8+size]) != binary.BigEndian.Uint32(bz[4:]) {
+			// a partially written batch
+			break
+		}
+
+		batchEntries, err := decodeEntries(bz[8:8+size], false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pending log of version %d: %w", version, err)
+		}
+		for i := range batchEntries {
+			batchEntries[i].version = version
+		}
+		entries = append(entries, batchEntries...)
+		bz = bz[8+size:]
+	}
+
+	return entries, nil
+}
+
+// write records the writes of a batch at version. The writes of the versions
+// which are not compacted yet are appended to their pending log, while the
+// writes of the versions which are already compacted, or being compacted, are
+// written to a new archive file. The empty batches are only recorded if the hot
+// Database is not synced, so that their version is replayed after a crash.
+func (db *Database) write(version uint64, entries []entry) error {
+	if len(entries) == 0 && !db.hotUnsynced {
+		return nil
+	}
+
+	db.logMtx.Lock()
+	defer db.logMtx.Unlock()
+
+	db.mtx.RLock()
+	archivedTo := db.meta.ArchivedTo
+	db.mtx.RUnlock()
+
+	if version > archivedTo {
+		if err := db.appendPendingLog(version, entries); err != nil {
+			return err
+		}
+		if version < db.compactFrom || version > db.compactTo {
+			return nil
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	for i := range entries {
+		entries[i].version = version
+	}
+	db.mtx.Lock()
+	seq := db.nextSeq
+	db.nextSeq++
+	db.mtx.Unlock()
+
+	af, err := writeFile(db.dir, version, version, seq, sortEntries(entries))
+	if err != nil {
+		return fmt.Errorf("failed to write archive file: %w", err)
+	}
+	db.addFile(af)
+
+	return nil
+}
+
+// addFile adds an archive file to the index of the files.
+func (db *Database) addFile(af *archiveFile) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.files, db.maxTo = indexFiles(append(slices.Clone(db.files), af))
+}
+
+// shouldCompact returns whether the next range of versions can be compacted
+// given the latest version.
+func (db *Database) shouldCompact(latest uint64) bool {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	return latest > db.keepRecent && latest-db.keepRecent >= db.meta.ArchivedTo+db.interval
+}
+
+// maybeCompact compacts the versions which are old enough in the background,
+// unless a compaction is already running.
+func (db *Database) maybeCompact(latest uint64) {
+	if !db.shouldCompact(latest) || !db.compacting.CompareAndSwap(false, true) {
+		return
+	}
+
+	run := func() {
+		defer db.compacting.Store(false)
+		if err := db.compact(latest - db.keepRecent); err != nil {
+			db.logger.Error("failed to compact the state storage archive", "err", err)
+		}
+	}
+	if db.syncCompaction {
+		run()
+		return
+	}
+
+	db.wg.Add(1)
+	go func() {
+		defer db.wg.Done()
+		run()
+	}()
+}
+
+// compact compacts the pending logs of the versions which are not archived yet
+// up to version into an archive file, and prunes the hot Database up to version.
+func (db *Database) compact(to uint64) error {
+	db.logMtx.Lock()
+	db.mtx.Lock()
+	from := db.meta.ArchivedTo + 1
+	seq := db.nextSeq
+	db.nextSeq++
+	db.mtx.Unlock()
+
+	versions, err := db.pendingVersions()
+	if err != nil {
+		db.logMtx.Unlock()
+		return err
+	}
+	versions = slices.DeleteFunc(versions, func(version uint64) bool {
+		return version < from || version > to
+	})
+
+	var entries []entry
+	for _, version := range versions {
+		versionEntries, err := db.readPendingLog(version)
+		if err != nil {
+			db.logMtx.Unlock()
+			return err
+		}
+		entries = append(entries, versionEntries...)
+	}
+	db.compactFrom, db.compactTo = from, to
+	db.logMtx.Unlock()
+
+	var af *archiveFile
+	if len(entries) > 0 {
+		af, err = writeFile(db.dir, from, to, seq, sortEntries(entries))
+		if err != nil {
+			db.logMtx.Lock()
+			db.compactFrom, db.compactTo = 0, 0
+			db.logMtx.Unlock()
+			return fmt.Errorf("failed to write archive file: %w", err)
+		}
+	}
+
+	db.logMtx.Lock()
+	defer db.logMtx.Unlock()
+	db.compactFrom, db.compactTo = 0, 0
+
+	db.mtx.Lock()
+	meta := db.meta
+	meta.ArchivedTo = to
+	if err := db.saveMetadata(meta); err != nil {
+		db.mtx.Unlock()
+		if af != nil {
+			_ = af.Close()
+			_ = os.Remove(af.path)
+		}
+		return err
+	}
+	db.meta = meta
+	prunedTo := meta.PrunedTo
+	db.mtx.Unlock()
+	if af != nil {
+		db.addFile(af)
+	}
+
+	for _, version := range versions {
+		if err := os.Remove(db.pendingLogPath(version)); err != nil {
+			return err
+		}
+	}
+
+	if to <= prunedTo {
+		return nil
+	}
+	return db.pruneHot(to)
+}
+
+func (db *Database) pruneHot(version uint64) error {
+	db.pruneMtx.Lock()
+	defer db.pruneMtx.Unlock()
+
+	return db.hot.Prune(version)
+}
+
+// archiveView returns the archive files which may hold writes at or below the
+// version, sorted by first version, with the greatest last version of the files
+// up to each index, and the metadata if the version is read from the archive, or
+// nil files if it is read from the hot Database.
+func (db *Database) archiveView(version uint64) ([]*archiveFile, []uint64, metadata, bool, error) {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	if version <= db.meta.PrunedTo {
+		return nil, nil, db.meta, true, storeerrors.ErrVersionPruned{EarliestVersion: db.meta.PrunedTo + 1, RequestedVersion: version}
+	}
+	if version > db.meta.ArchivedTo {
+		return nil, nil, db.meta, false, nil
+	}
+
+	n := sort.Search(len(db.files), func(i int) bool {
+		return db.files[i].from > version
+	})
+	return db.files[:n], db.maxTo[:n], db.meta, true, nil
+}
+
+// NewBatch implements storage.Database.
+func (db *Database) NewBatch(version uint64) (store.Batch, error) {
+	hotBatch, err := db.hot.NewBatch(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batch{db: db, version: version, hot: hotBatch}, nil
+}
+
+// Has implements storage.Database.
+func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	val, err := db.Get(storeKey, version, key)
+	if err != nil {
+		return false, err
+	}
+
+	return val != nil, nil
+}
+
+// Get implements storage.Database.
+func (db *Database) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	files, maxTo, meta, archived, err := db.archiveView(version)
+	if err != nil {
+		return nil, err
+	}
+	if !archived {
+		return db.hot.Get(storeKey, version, key)
+	}
+
+	var (
+		latest    *entry
+		latestSeq uint64
+	)
+	// iterate from the latest files, which hold the latest writes, until the
+	// remaining files only hold writes older than the latest one found
+	for i := len(files) - 1; i >= 0; i-- {
+		if latest != nil && maxTo[i] < latest.version {
+			break
+		}
+		af := files[i]
+		if latest != nil && af.to < latest.version {
+			continue
+		}
+
+		e, err := af.get(db.cache, storeKey, key, version)
+		if err != nil {
+			return nil, err
+		}
+		if e != nil && (latest == nil || e.version > latest.version || (e.version == latest.version && af.seq > latestSeq)) {
+			latest, latestSeq = e, af.seq
+		}
+	}
+
+	if latest == nil || latest.deleted || !meta.visible(storeKey, latest.version, version) {
+		return nil, nil
+	}
+	return slices.Clone(latest.value), nil
+}
+
+// GetLatestVersion implements storage.Database.
+func (db *Database) GetLatestVersion() (uint64, error) {
+	return db.hot.GetLatestVersion()
+}
+
+// SetLatestVersion implements storage.Database.
+func (db *Database) SetLatestVersion(version uint64) error {
+	return db.hot.SetLatestVersion(version)
+}
+
+// Iterator implements storage.Database.
+func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return db.iterator(storeKey, version, start, end, false)
+}
+
+// ReverseIterator implements storage.Database.
+func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	return db.iterator(storeKey, version, start, end, true)
+}
+
+func (db *Database) iterator(storeKey []byte, version uint64, start, end []byte, reverse bool) (corestore.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, storeerrors.ErrStartAfterEnd
+	}
+
+	files, _, meta, archived, err := db.archiveView(version)
+	if err != nil {
+		// like the other backends, iterating over a pruned version yields nothing
+		return newIterator(nil, storeKey, version, start, end, reverse, nil), nil
+	}
+	if !archived {
+		if reverse {
+			return db.hot.ReverseIterator(storeKey, version, start, end)
+		}
+		return db.hot.Iterator(storeKey, version, start, end)
+	}
+
+	// the iterator merges the files by sequence
+	files = slices.SortedFunc(slices.Values(files), func(a, b *archiveFile) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	return newIterator(files, storeKey, version, start, end, reverse, func(e *entry) bool {
+		return meta.visible(storeKey, e.version, version)
+	}), nil
+}
+
+// Prune implements storage.Database. The pruned versions can't be read anymore,
+// but the archive files are kept since the later versions are read from them.
+// Pruning the hot Database doesn't lose the writes which are not archived yet,
+// since the compactions read them from the pending logs.
+func (db *Database) Prune(version uint64) error {
+	db.mtx.Lock()
+	meta := db.meta
+	if version > meta.PrunedTo {
+		meta.PrunedTo = version
+		if err := db.saveMetadata(meta); err != nil {
+			db.mtx.Unlock()
+			return err
+		}
+		db.meta = meta
+	}
+	db.mtx.Unlock()
+
+	if version <= meta.ArchivedTo {
+		return nil
+	}
+	return db.pruneHot(version)
+}
+
+// PruneStoreKeys implements store.UpgradableDatabase. The writes of the removed
+// stores remain readable at the versions before their removal.
+func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) error {
+	hot, ok := db.hot.(store.UpgradableDatabase)
+	if !ok {
+		return errors.New("db does not implement UpgradableDatabase interface")
+	}
+	if err := hot.PruneStoreKeys(storeKeys, version); err != nil {
+		return err
+	}
+
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	meta := db.meta
+	meta.RemovedStores = make(map[string][]uint64, len(db.meta.RemovedStores)+len(storeKeys))
+	for storeKey, versions := range db.meta.RemovedStores {
+		meta.RemovedStores[storeKey] = versions
+	}
+	for _, storeKey := range storeKeys {
+		meta.RemovedStores[storeKey] = append(slices.Clone(meta.RemovedStores[storeKey]), version)
+	}
+	if err := db.saveMetadata(meta); err != nil {
+		return err
+	}
+	db.meta = meta
+
+	return nil
+}
+
+// Close waits for the running compaction and closes the archive files and the
+// hot Database.
+func (db *Database) Close() error {
+	db.wg.Wait()
+
+	db.mtx.Lock()
+	for _, af := range db.files {
+		_ = af.Close()
+	}
+	db.files, db.maxTo = nil, nil
+	db.mtx.Unlock()
+
+	return db.hot.Close()
+}
+
+// writeFileAtomic writes a file through a temporary file, so that the file is
+// either fully written or left untouched.
+func writeFileAtomic(path string, bz []byte) error {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,333 @@
+package archive
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+var storeKey1 = []byte("store1")
+
+func TestStorageTestSuite(t *testing.T) {
+	s := &storage.StorageTestSuite{
+		NewDB: func(dir string) (*storage.StorageStore, error) {
+			db, err := newTestDB(dir, &Config{KeepRecent: 2, Interval: 5})
+			return storage.NewStorageStore(db, coretesting.NewNopLogger()), err
+		},
+		EmptyBatchSize: 0,
+	}
+	suite.Run(t, s)
+}
+
+func newTestDB(dir string, cfg *Config) (*Database, error) {
+	hot, err := sqlite.New(dir)
+	if err != nil {
+		return nil, err
+	}
+	db, err := New(hot, filepath.Join(dir, "archive"), cfg, coretesting.NewNopLogger())
+	if err != nil {
+		return nil, err
+	}
+	db.syncCompaction = true
+
+	return db, nil
+}
+
+func writeVersion(t *testing.T, db *Database, version uint64, pairs ...corestore.KVPair) {
+	t.Helper()
+	ss := storage.NewStorageStore(db, coretesting.NewNopLogger())
+	require.NoError(t, ss.ApplyChangeset(version, corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		string(storeKey1): pairs,
+	})))
+}
+
+func TestDatabase_Archive(t *testing.T) {
+	dir := t.TempDir()
+	db, err := newTestDB(dir, &Config{KeepRecent: 10, Interval: 20})
+	require.NoError(t, err)
+
+	// key-N is set at version N and updated every 10 versions, odd keys are deleted at version 95
+	for version := uint64(1); version <= 100; version++ {
+		pairs := []corestore.KVPair{{Key: []byte(fmt.Sprintf("key-%03d", version)), Value: []byte(fmt.Sprintf("value-%d", version))}}
+		if version%10 == 0 {
+			for i := version - 9; i < version; i++ {
+				pairs = append(pairs, corestore.KVPair{Key: []byte(fmt.Sprintf("key-%03d", i)), Value: []byte(fmt.Sprintf("value-%d-%d", i, version))})
+			}
+		}
+		if version == 95 {
+			for i := uint64(1); i < 95; i += 2 {
+				pairs = append(pairs, corestore.KVPair{Key: []byte(fmt.Sprintf("key-%03d", i)), Remove: true})
+			}
+		}
+		writeVersion(t, db, version, pairs...)
+	}
+
+	// the versions up to 80 are archived and pruned from the hot database
+	require.Equal(t, uint64(80), db.meta.ArchivedTo)
+	_, err = db.hot.Get(storeKey1, 80, []byte("key-001"))
+	require.Error(t, err)
+
+	expectedValue := func(i, version uint64) []byte {
+		updated := (i-1)/10*10 + 10
+		switch {
+		case i > version:
+			return nil
+		case i%10 != 0 && updated <= version && updated > 95:
+			return []byte(fmt.Sprintf("value-%d-%d", i, updated))
+		case i%2 == 1 && i < 95 && version >= 95:
+			return nil
+		case i%10 != 0 && updated <= version:
+			return []byte(fmt.Sprintf("value-%d-%d", i, updated))
+		default:
+			return []byte(fmt.Sprintf("value-%d", i))
+		}
+	}
+	check := func(db *Database) {
+		t.Helper()
+		for _, version := range []uint64{1, 9, 10, 11, 20, 45, 80, 81, 95, 100} {
+			for i := uint64(1); i <= 100; i++ {
+				val, err := db.Get(storeKey1, version, []byte(fmt.Sprintf("key-%03d", i)))
+				require.NoError(t, err)
+				require.Equal(t, expectedValue(i, version), val, "key %d at version %d", i, version)
+			}
+
+			// iterators merge the archive files
+			var expected, reversed [][]byte
+			for i := uint64(1); i <= 100; i++ {
+				if value := expectedValue(i, version); value != nil && i >= 5 && i < 50 {
+					expected = append(expected, value)
+					reversed = append([][]byte{value}, reversed...)
+				}
+			}
+			for _, tc := range []struct {
+				reverse  bool
+				expected [][]byte
+			}{{false, expected}, {true, reversed}} {
+				var itr corestore.Iterator
+				if tc.reverse {
+					itr, err = db.ReverseIterator(storeKey1, version, []byte("key-005"), []byte("key-050"))
+				} else {
+					itr, err = db.Iterator(storeKey1, version, []byte("key-005"), []byte("key-050"))
+				}
+				require.NoError(t, err)
+				var values [][]byte
+				for ; itr.Valid(); itr.Next() {
+					values = append(values, itr.Value())
+				}
+				require.NoError(t, itr.Error())
+				require.NoError(t, itr.Close())
+				require.Equal(t, tc.expected, values, "version %d reverse %v", version, tc.reverse)
+			}
+		}
+	}
+	check(db)
+
+	// the archive is reloaded after a restart
+	require.NoError(t, db.Close())
+	db, err = newTestDB(dir, &Config{KeepRecent: 10, Interval: 20})
+	require.NoError(t, err)
+	defer db.Close()
+	check(db)
+
+	// writes to archived versions are archived as well
+	writeVersion(t, db, 30, corestore.KVPair{Key: []byte("key-001"), Value: []byte("late")})
+	val, err := db.Get(storeKey1, 30, []byte("key-001"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("late"), val)
+	val, err = db.Get(storeKey1, 29, []byte("key-001"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-1-10"), val)
+
+	// pruned versions can't be read anymore
+	require.NoError(t, db.Prune(40))
+	_, err = db.Get(storeKey1, 40, []byte("key-002"))
+	require.Error(t, err)
+	val, err = db.Get(storeKey1, 41, []byte("key-002"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-2-10"), val)
+}
+
+func TestDatabase_ArchiveNonEmpty(t *testing.T) {
+	dir := t.TempDir()
+	hot, err := sqlite.New(dir)
+	require.NoError(t, err)
+	require.NoError(t, hot.SetLatestVersion(10))
+
+	_, err = New(hot, filepath.Join(dir, "archive"), DefaultConfig(), coretesting.NewNopLogger())
+	require.ErrorContains(t, err, "must be enabled on an empty state storage")
+}
+
+func TestDatabase_PendingLogRecovery(t *testing.T) {
+	dir := t.TempDir()
+	db, err := newTestDB(dir, &Config{KeepRecent: 10, Interval: 20})
+	require.NoError(t, err)
+
+	writeVersion(t, db, 1, corestore.KVPair{Key: []byte("key"), Value: []byte("value")})
+
+	// a partially written batch is ignored
+	f, err := os.OpenFile(db.pendingLogPath(1), os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 1, 0, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	entries, err := db.readPendingLog(1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, []byte("value"), entries[0].value)
+
+	// a file left by an interrupted compaction is removed
+	_, err = writeFile(db.dir, 1, 20, 100, entries)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	db, err = newTestDB(dir, &Config{KeepRecent: 10, Interval: 20})
+	require.NoError(t, err)
+	defer db.Close()
+	require.Empty(t, db.files)
+	_, err = os.Stat(filepath.Join(db.dir, fileName(1, 20, 100)))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDatabase_ManyFiles(t *testing.T) {
+	db, err := newTestDB(t.TempDir(), &Config{KeepRecent: 1, Interval: 1})
+	require.NoError(t, err)
+	defer db.Close()
+
+	// one archive file per version, key-N is set at version N and key-0 every 10 versions
+	for version := uint64(1); version <= 200; version++ {
+		pairs := []corestore.KVPair{{Key: []byte(fmt.Sprintf("key-%d", version)), Value: []byte(fmt.Sprintf("value-%d", version))}}
+		if version%10 == 0 {
+			pairs = append(pairs, corestore.KVPair{Key: []byte("key-0"), Value: []byte(fmt.Sprintf("value-0-%d", version))})
+		}
+		writeVersion(t, db, version, pairs...)
+	}
+	require.Len(t, db.files, 199)
+
+	for _, version := range []uint64{1, 10, 55, 100, 199} {
+		val, err := db.Get(storeKey1, version, []byte("key-1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-1"), val)
+
+		val, err = db.Get(storeKey1, version, []byte("key-0"))
+		require.NoError(t, err)
+		if version < 10 {
+			require.Nil(t, val)
+		} else {
+			require.Equal(t, []byte(fmt.Sprintf("value-0-%d", version/10*10)), val)
+		}
+
+		val, err = db.Get(storeKey1, version, []byte(fmt.Sprintf("key-%d", version+1)))
+		require.NoError(t, err)
+		require.Nil(t, val)
+	}
+
+	// a late write to an archived version takes precedence over the older writes
+	writeVersion(t, db, 55, corestore.KVPair{Key: []byte("key-0"), Value: []byte("late")})
+	val, err := db.Get(storeKey1, 57, []byte("key-0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("late"), val)
+	val, err = db.Get(storeKey1, 60, []byte("key-0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-0-60"), val)
+}
+
+func TestDatabase_PruneUnarchived(t *testing.T) {
+	db, err := newTestDB(t.TempDir(), &Config{KeepRecent: 10, Interval: 20})
+	require.NoError(t, err)
+	defer db.Close()
+
+	for version := uint64(1); version <= 40; version++ {
+		writeVersion(t, db, version, corestore.KVPair{Key: []byte("key"), Value: []byte(fmt.Sprintf("value-%d", version))})
+	}
+	require.Equal(t, uint64(20), db.meta.ArchivedTo)
+
+	// pruning the versions which are not archived yet prunes the hot database
+	require.NoError(t, db.Prune(35))
+	_, err = db.Get(storeKey1, 35, []byte("key"))
+	require.Error(t, err)
+
+	// but their writes are still archived by the next compaction
+	for version := uint64(41); version <= 50; version++ {
+		writeVersion(t, db, version, corestore.KVPair{Key: []byte("key"), Value: []byte(fmt.Sprintf("value-%d", version))})
+	}
+	require.Equal(t, uint64(40), db.meta.ArchivedTo)
+	af := db.files[len(db.files)-1]
+	require.Equal(t, uint64(21), af.from)
+	require.Equal(t, uint64(40), af.to)
+	e, err := af.get(db.cache, storeKey1, []byte("key"), 30)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-30"), e.value)
+}
+
+func TestDatabase_ReplayPendingLogs(t *testing.T) {
+	dir := t.TempDir()
+	newDB := func() *Database {
+		hot, err := pebbledb.New(dir)
+		require.NoError(t, err)
+		db, err := New(hot, filepath.Join(dir, "archive"), &Config{KeepRecent: 10, Interval: 20}, coretesting.NewNopLogger())
+		require.NoError(t, err)
+		return db
+	}
+
+	db := newDB()
+	require.True(t, db.hotUnsynced)
+	writeVersion(t, db, 1, corestore.KVPair{Key: []byte("key"), Value: []byte("value-1")})
+
+	// the writes of version 2 reach the pending log but not the hot database
+	require.NoError(t, db.appendPendingLog(2, []entry{{storeKey: storeKey1, key: []byte("key"), value: []byte("value-2")}}))
+	require.NoError(t, db.Close())
+
+	db = newDB()
+	defer db.Close()
+	latest, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest)
+	val, err := db.Get(storeKey1, 2, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-2"), val)
+	val, err = db.Get(storeKey1, 1, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-1"), val)
+}
+
+func TestConfig_ValidatePruning(t *testing.T) {
+	cfg := &Config{Enable: true, KeepRecent: 100, Interval: 10}
+	require.NoError(t, cfg.ValidatePruning(nil))
+	require.NoError(t, cfg.ValidatePruning(&store.PruningOption{KeepRecent: 2, Interval: 0}))
+	require.NoError(t, cfg.ValidatePruning(&store.PruningOption{KeepRecent: 110, Interval: 100}))
+	require.ErrorContains(t, cfg.ValidatePruning(&store.PruningOption{KeepRecent: 109, Interval: 100}), "may not be archived yet")
+
+	cfg.Enable = false
+	require.NoError(t, cfg.ValidatePruning(&store.PruningOption{KeepRecent: 2, Interval: 100}))
+}
+
+func TestBloomFilter(t *testing.T) {
+	filter := newBloomFilter(1000)
+	for i := 0; i < 1000; i++ {
+		filter.add(storeKey1, []byte(fmt.Sprintf("key-%d", i)))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 1000; i++ {
+		require.True(t, filter.mayContain(storeKey1, []byte(fmt.Sprintf("key-%d", i))))
+		if filter.mayContain(storeKey1, []byte(fmt.Sprintf("other-%d", i))) {
+			falsePositives++
+		}
+	}
+	require.Less(t, falsePositives, 50)
+
+	// the store key is part of the filtered key
+	require.False(t, filter.mayContain([]byte("store"), []byte("1key-0")) && filter.mayContain([]byte("store"), []byte("1key-1")))
+}
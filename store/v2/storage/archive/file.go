@@ -0,0 +1,536 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	fileSuffix = ".arc"
+	fileMagic  = "ssarchv1"
+	footerSize = 8 + 8 + 8 + len(fileMagic)
+
+	// blockSize is the uncompressed size above which a block of entries is
+	// flushed to the file.
+	blockSize = 64 << 10
+
+	// filterBitsPerKey and filterHashes size the bloom filter of the keys of an
+	// archive file for a false positive rate of about 1%.
+	filterBitsPerKey = 10
+	filterHashes     = 7
+)
+
+// entry is a write of a key at a version, as stored in the archive files and
+// the pending logs.
+type entry struct {
+	storeKey []byte
+	key      []byte
+	version  uint64
+	deleted  bool
+	value    []byte
+}
+
+// compareEntries orders entries by store key, key and version.
+func compareEntries(a, b *entry) int {
+	if c := bytes.Compare(a.storeKey, b.storeKey); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(a.key, b.key); c != 0 {
+		return c
+	}
+	switch {
+	case a.version < b.version:
+		return -1
+	case a.version > b.version:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sortEntries sorts the entries and removes the duplicate writes of a key at
+// the same version, keeping the last one.
+func sortEntries(entries []entry) []entry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return compareEntries(&entries[i], &entries[j]) < 0
+	})
+
+	res := entries[:0]
+	for i := range entries {
+		if len(res) > 0 && compareEntries(&res[len(res)-1], &entries[i]) == 0 {
+			res[len(res)-1] = entries[i]
+			continue
+		}
+		res = append(res, entries[i])
+	}
+
+	return res
+}
+
+// appendEntry appends the encoding of the entry, without its version if
+// withVersion is false.
+func appendEntry(buf []byte, e *entry, withVersion bool) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(e.storeKey)))
+	buf = append(buf, e.storeKey...)
+	buf = binary.AppendUvarint(buf, uint64(len(e.key)))
+	buf = append(buf, e.key...)
+	if withVersion {
+		buf = binary.AppendUvarint(buf, e.version)
+	}
+	if e.deleted {
+		return append(buf, 1)
+	}
+	buf = append(buf, 0)
+	buf = binary.AppendUvarint(buf, uint64(len(e.value)))
+	return append(buf, e.value...)
+}
+
+// decodeEntries decodes a sequence of entries, which have no version encoded
+// if withVersion is false.
+func decodeEntries(bz []byte, withVersion bool) ([]entry, error) {
+	var entries []entry
+	readBytes := func() ([]byte, error) {
+		n, size := binary.Uvarint(bz)
+		if size <= 0 || uint64(len(bz)-size) < n {
+			return nil, errors.New("invalid archive entry")
+		}
+		res := bz[size : size+int(n)]
+		bz = bz[size+int(n):]
+		return res, nil
+	}
+
+	for len(bz) > 0 {
+		var (
+			e   entry
+			err error
+		)
+		if e.storeKey, err = readBytes(); err != nil {
+			return nil, err
+		}
+		if e.key, err = readBytes(); err != nil {
+			return nil, err
+		}
+		if withVersion {
+			version, size := binary.Uvarint(bz)
+			if size <= 0 {
+				return nil, errors.New("invalid archive entry version")
+			}
+			e.version = version
+			bz = bz[size:]
+		}
+		if len(bz) == 0 {
+			return nil, errors.New("invalid archive entry")
+		}
+		e.deleted = bz[0] == 1
+		bz = bz[1:]
+		if !e.deleted {
+			if e.value, err = readBytes(); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// blockHandle locates a compressed block of entries in an archive file.
+type blockHandle struct {
+	offset uint64
+	length uint64
+	// first is the first entry of the block, without value.
+	first entry
+}
+
+// archiveFile is an immutable file holding the sorted writes of a range of
+// versions, split in compressed blocks.
+//
+// The file is made of the blocks, followed by the index of the blocks, a bloom
+// filter of the keys held by the file and a footer with the offset and length
+// of the index, the length of the filter and a magic string.
+type archiveFile struct {
+	path string
+	// from and to are the first and last versions of the writes held by the file.
+	from, to uint64
+	// seq orders the files by creation, the writes of a later file take
+	// precedence over the writes of an earlier file at the same version.
+	seq uint64
+
+	f      *os.File
+	blocks []blockHandle
+	filter bloomFilter
+}
+
+// blockCache caches the decoded blocks read by the lookups of keys, shared by
+// all the archive files of a Database.
+type blockCache struct {
+	lru *lru.Cache
+}
+
+type blockCacheKey struct {
+	seq   uint64
+	block int
+}
+
+func newBlockCache(size int) (*blockCache, error) {
+	c, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &blockCache{lru: c}, nil
+}
+
+func fileName(from, to, seq uint64) string {
+	return fmt.Sprintf("%020d-%020d-%020d%s", from, to, seq, fileSuffix)
+}
+
+// parseFileName returns the version range and sequence number of an archive
+// file from its name.
+func parseFileName(name string) (from, to, seq uint64, err error) {
+	parts := strings.Split(strings.TrimSuffix(name, fileSuffix), "-")
+	if len(parts) != 3 || !strings.HasSuffix(name, fileSuffix) {
+		return 0, 0, 0, fmt.Errorf("invalid archive file name %s", name)
+	}
+
+	values := make([]uint64, len(parts))
+	for i, part := range parts {
+		if values[i], err = strconv.ParseUint(part, 10, 64); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid archive file name %s: %w", name, err)
+		}
+	}
+
+	return values[0], values[1], values[2], nil
+}
+
+// writeFile writes the sorted entries to a new archive file in dir and opens it.
+func writeFile(dir string, from, to, seq uint64, entries []entry) (*archiveFile, error) {
+	path := filepath.Join(dir, fileName(from, to, seq))
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// the temporary file only remains if the file could not be written
+		_ = f.Close()
+		_ = os.Remove(tmpPath)
+	}()
+
+	w := bufio.NewWriter(f)
+	var (
+		offset uint64
+		index  []byte
+		block  []byte
+		first  *entry
+	)
+	flush := func() error {
+		var compressed bytes.Buffer
+		fw, err := flate.NewWriter(&compressed, flate.DefaultCompression)
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(block); err != nil {
+			return err
+		}
+		if err := fw.Close(); err != nil {
+			return err
+		}
+		if _, err := w.Write(compressed.Bytes()); err != nil {
+			return err
+		}
+
+		index = binary.AppendUvarint(index, offset)
+		index = binary.AppendUvarint(index, uint64(compressed.Len()))
+		index = appendEntry(index, &entry{storeKey: first.storeKey, key: first.key, version: first.version, deleted: true}, true)
+		offset += uint64(compressed.Len())
+		block = block[:0]
+		first = nil
+		return nil
+	}
+
+	filter := newBloomFilter(countKeys(entries))
+	for i := range entries {
+		if i == 0 || !sameKey(&entries[i-1], &entries[i]) {
+			filter.add(entries[i].storeKey, entries[i].key)
+		}
+		if first == nil {
+			first = &entries[i]
+		}
+		block = appendEntry(block, &entries[i], true)
+		if len(block) >= blockSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(block) > 0 {
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+
+	footer := binary.BigEndian.AppendUint64(nil, offset)
+	footer = binary.BigEndian.AppendUint64(footer, uint64(len(index)))
+	footer = binary.BigEndian.AppendUint64(footer, uint64(len(filter)))
+	footer = append(footer, fileMagic...)
+	if _, err := w.Write(index); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(filter); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(footer); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, err
+	}
+
+	return openFile(path)
+}
+
+// openFile opens an archive file and loads its index.
+func openFile(path string) (*archiveFile, error) {
+	from, to, seq, err := parseFileName(filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	af := &archiveFile{path: path, from: from, to: to, seq: seq, f: f}
+	if err := af.loadIndex(); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to open archive file %s: %w", path, err)
+	}
+
+	return af, nil
+}
+
+func (af *archiveFile) loadIndex() error {
+	info, err := af.f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < int64(footerSize) {
+		return errors.New("file too small")
+	}
+
+	footer := make([]byte, footerSize)
+	if _, err := af.f.ReadAt(footer, info.Size()-int64(footerSize)); err != nil {
+		return err
+	}
+	if string(footer[24:]) != fileMagic {
+		return errors.New("invalid magic")
+	}
+	indexOffset := binary.BigEndian.Uint64(footer)
+	indexLength := binary.BigEndian.Uint64(footer[8:])
+	filterLength := binary.BigEndian.Uint64(footer[16:])
+	if indexOffset+indexLength+filterLength+uint64(footerSize) != uint64(info.Size()) {
+		return errors.New("invalid footer")
+	}
+
+	af.filter = make(bloomFilter, filterLength)
+	if _, err := af.f.ReadAt(af.filter, int64(indexOffset+indexLength)); err != nil {
+		return err
+	}
+
+	bz := make([]byte, indexLength)
+	if _, err := af.f.ReadAt(bz, int64(indexOffset)); err != nil {
+		return err
+	}
+	for len(bz) > 0 {
+		var h blockHandle
+		var size int
+		if h.offset, size = binary.Uvarint(bz); size <= 0 {
+			return errors.New("invalid index")
+		}
+		bz = bz[size:]
+		if h.length, size = binary.Uvarint(bz); size <= 0 {
+			return errors.New("invalid index")
+		}
+		bz = bz[size:]
+
+		// the first entry is encoded as a deleted entry so that it has no value
+		end, err := entryEnd(bz)
+		if err != nil {
+			return err
+		}
+		first, err := decodeEntries(bz[:end], true)
+		if err != nil {
+			return err
+		}
+		h.first = first[0]
+		bz = bz[end:]
+		af.blocks = append(af.blocks, h)
+	}
+
+	return nil
+}
+
+// entryEnd returns the size of the first versioned entry without value of bz.
+func entryEnd(bz []byte) (int, error) {
+	pos := 0
+	for i := 0; i < 2; i++ {
+		n, size := binary.Uvarint(bz[pos:])
+		if size <= 0 || uint64(len(bz)-pos-size) < n {
+			return 0, errors.New("invalid index entry")
+		}
+		pos += size + int(n)
+	}
+	_, size := binary.Uvarint(bz[pos:])
+	if size <= 0 || len(bz) < pos+size+1 {
+		return 0, errors.New("invalid index entry")
+	}
+
+	return pos + size + 1, nil
+}
+
+// readBlock reads and decodes the block at index i.
+func (af *archiveFile) readBlock(i int) ([]entry, error) {
+	h := af.blocks[i]
+	compressed := make([]byte, h.length)
+	if _, err := af.f.ReadAt(compressed, int64(h.offset)); err != nil {
+		return nil, err
+	}
+
+	fr := flate.NewReader(bytes.NewReader(compressed))
+	defer fr.Close()
+	bz, err := io.ReadAll(fr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress block %d of %s: %w", i, af.path, err)
+	}
+
+	return decodeEntries(bz, true)
+}
+
+// searchBlock returns the index of the last block of which the first entry is
+// lower than or equal to target, or -1 if there is none.
+func (af *archiveFile) searchBlock(target *entry) int {
+	return sort.Search(len(af.blocks), func(i int) bool {
+		return compareEntries(&af.blocks[i].first, target) > 0
+	}) - 1
+}
+
+// get returns the latest write of the key at or below version, reading the
+// blocks through the cache.
+func (af *archiveFile) get(cache *blockCache, storeKey, key []byte, version uint64) (*entry, error) {
+	if !af.filter.mayContain(storeKey, key) {
+		return nil, nil
+	}
+
+	target := &entry{storeKey: storeKey, key: key, version: version}
+	i := af.searchBlock(target)
+	if i < 0 {
+		return nil, nil
+	}
+
+	var block []entry
+	cacheKey := blockCacheKey{seq: af.seq, block: i}
+	if cached, ok := cache.lru.Get(cacheKey); ok {
+		block = cached.([]entry)
+	} else {
+		var err error
+		block, err = af.readBlock(i)
+		if err != nil {
+			return nil, err
+		}
+		cache.lru.Add(cacheKey, block)
+	}
+
+	j := sort.Search(len(block), func(j int) bool {
+		return compareEntries(&block[j], target) > 0
+	}) - 1
+	if j < 0 || !bytes.Equal(block[j].storeKey, storeKey) || !bytes.Equal(block[j].key, key) {
+		return nil, nil
+	}
+
+	return &block[j], nil
+}
+
+func (af *archiveFile) Close() error {
+	return af.f.Close()
+}
+
+// sameKey returns whether two entries are writes of the same key.
+func sameKey(a, b *entry) bool {
+	return bytes.Equal(a.storeKey, b.storeKey) && bytes.Equal(a.key, b.key)
+}
+
+// countKeys returns the number of distinct keys of the sorted entries.
+func countKeys(entries []entry) int {
+	n := 0
+	for i := range entries {
+		if i == 0 || !sameKey(&entries[i-1], &entries[i]) {
+			n++
+		}
+	}
+	return n
+}
+
+// bloomFilter is a bloom filter of the keys of an archive file, so that the
+// lookups of keys skip the files which don't hold them without reading blocks.
+type bloomFilter []byte
+
+func newBloomFilter(numKeys int) bloomFilter {
+	return make(bloomFilter, (max(numKeys, 1)*filterBitsPerKey+7)/8)
+}
+
+// hashes returns the two hashes of a key from which the positions of its bits
+// are derived with double hashing.
+func (f bloomFilter) hashes(storeKey, key []byte) (uint32, uint32) {
+	h := fnv.New64a()
+	_, _ = h.Write(binary.AppendUvarint(nil, uint64(len(storeKey))))
+	_, _ = h.Write(storeKey)
+	_, _ = h.Write(key)
+	sum := h.Sum64()
+	return uint32(sum), uint32(sum >> 32)
+}
+
+func (f bloomFilter) add(storeKey, key []byte) {
+	h1, h2 := f.hashes(storeKey, key)
+	numBits := uint32(len(f) * 8)
+	for i := uint32(0); i < filterHashes; i++ {
+		bit := (h1 + i*h2) % numBits
+		f[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// mayContain returns false if the key is definitely not in the filter. An
+// empty filter contains all the keys.
+func (f bloomFilter) mayContain(storeKey, key []byte) bool {
+	if len(f) == 0 {
+		return true
+	}
+
+	h1, h2 := f.hashes(storeKey, key)
+	numBits := uint32(len(f) * 8)
+	for i := uint32(0); i < filterHashes; i++ {
+		bit := (h1 + i*h2) % numBits
+		if f[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
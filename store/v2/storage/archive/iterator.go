@@ -0,0 +1,275 @@
+package archive
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+
+	corestore "cosmossdk.io/core/store"
+)
+
+var _ corestore.Iterator = (*iterator)(nil)
+
+// fileCursor iterates over the keys of a store in an archive file, yielding for
+// each key its latest write at or below the iterated version.
+type fileCursor struct {
+	af         *archiveFile
+	storeKey   []byte
+	start, end []byte
+	version    uint64
+	reverse    bool
+
+	blockIdx int
+	block    []entry
+	pos      int
+
+	// cur is the write of the current key, nil once the cursor is exhausted.
+	cur *entry
+	err error
+}
+
+func newFileCursor(af *archiveFile, storeKey []byte, version uint64, start, end []byte, reverse bool) *fileCursor {
+	c := &fileCursor{
+		af:       af,
+		storeKey: storeKey,
+		start:    start,
+		end:      end,
+		version:  version,
+		reverse:  reverse,
+	}
+
+	if !reverse {
+		// position at the first entry greater than or equal to the start
+		target := &entry{storeKey: storeKey, key: start}
+		if !c.load(max(af.searchBlock(target), 0)) {
+			return c
+		}
+		c.pos = sort.Search(len(c.block), func(i int) bool {
+			return compareEntries(&c.block[i], target) >= 0
+		})
+		if c.pos == len(c.block) && !c.load(c.blockIdx+1) {
+			return c
+		}
+	} else {
+		// position at the last entry lower than the end, or than the entries of
+		// the next store key if there is no end
+		target := &entry{storeKey: storeKey, key: end}
+		if end == nil {
+			target.storeKey = append(slices.Clone(storeKey), 0)
+		}
+		i := sort.Search(len(af.blocks), func(i int) bool {
+			return compareEntries(&af.blocks[i].first, target) >= 0
+		}) - 1
+		if !c.load(i) {
+			return c
+		}
+		c.pos = sort.Search(len(c.block), func(i int) bool {
+			return compareEntries(&c.block[i], target) >= 0
+		}) - 1
+	}
+
+	c.nextKey()
+	return c
+}
+
+// load loads the block at index i, returning false if there is no such block.
+func (c *fileCursor) load(i int) bool {
+	if i < 0 || i >= len(c.af.blocks) {
+		c.block = nil
+		return false
+	}
+
+	block, err := c.af.readBlock(i)
+	if err != nil {
+		c.err = err
+		c.block = nil
+		return false
+	}
+
+	c.blockIdx, c.block = i, block
+	if c.reverse {
+		c.pos = len(block) - 1
+	} else {
+		c.pos = 0
+	}
+	return true
+}
+
+// entry returns the entry at the position of the cursor, nil if the cursor
+// is out of the blocks.
+func (c *fileCursor) entry() *entry {
+	if c.pos < 0 || c.pos >= len(c.block) {
+		return nil
+	}
+	return &c.block[c.pos]
+}
+
+// move moves the position of the cursor to the next entry in the direction of
+// the iteration.
+func (c *fileCursor) move() {
+	if c.reverse {
+		c.pos--
+		if c.pos < 0 {
+			c.load(c.blockIdx - 1)
+		}
+	} else {
+		c.pos++
+		if c.pos >= len(c.block) {
+			c.load(c.blockIdx + 1)
+		}
+	}
+}
+
+// inRange returns whether the entry belongs to the iterated store and domain.
+func (c *fileCursor) inRange(e *entry) bool {
+	if !bytes.Equal(e.storeKey, c.storeKey) {
+		return false
+	}
+	if c.start != nil && bytes.Compare(e.key, c.start) < 0 {
+		return false
+	}
+	if c.end != nil && bytes.Compare(e.key, c.end) >= 0 {
+		return false
+	}
+	return true
+}
+
+// nextKey moves the cursor to the next key which has a write at or below the
+// iterated version, and sets cur to that write.
+func (c *fileCursor) nextKey() {
+	c.cur = nil
+	for {
+		e := c.entry()
+		if e == nil || !c.inRange(e) {
+			return
+		}
+
+		// consume all the entries of the key, which are sorted by version
+		key := e.key
+		for ; e != nil && bytes.Equal(e.storeKey, c.storeKey) && bytes.Equal(e.key, key); e = c.entry() {
+			if e.version <= c.version && (!c.reverse || c.cur == nil) {
+				c.cur = e
+			}
+			c.move()
+		}
+		if c.cur != nil {
+			return
+		}
+	}
+}
+
+// iterator merges the cursors of the archive files holding a version, yielding
+// for each key the latest write among all the files.
+type iterator struct {
+	start, end []byte
+	reverse    bool
+	cursors    []*fileCursor
+	// visible returns whether a write is visible at the iterated version.
+	visible func(e *entry) bool
+
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+func newIterator(
+	files []*archiveFile,
+	storeKey []byte,
+	version uint64,
+	start, end []byte,
+	reverse bool,
+	visible func(e *entry) bool,
+) *iterator {
+	itr := &iterator{
+		start:   start,
+		end:     end,
+		reverse: reverse,
+		visible: visible,
+	}
+	for _, af := range files {
+		itr.cursors = append(itr.cursors, newFileCursor(af, storeKey, version, start, end, reverse))
+	}
+
+	itr.Next()
+	return itr
+}
+
+// Domain returns the domain of the iterator. The caller must not modify the
+// return values.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *iterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *iterator) Next() {
+	itr.valid = false
+	for {
+		// find the next key among the cursors
+		var (
+			key   []byte
+			found bool
+		)
+		for _, c := range itr.cursors {
+			if c.err != nil {
+				itr.err = c.err
+				return
+			}
+			if c.cur == nil {
+				continue
+			}
+			cmp := bytes.Compare(c.cur.key, key)
+			if !found || (!itr.reverse && cmp < 0) || (itr.reverse && cmp > 0) {
+				key, found = c.cur.key, true
+			}
+		}
+		if !found {
+			return
+		}
+
+		// the latest write of the key wins, the cursors are ordered by file sequence
+		var winner *entry
+		for _, c := range itr.cursors {
+			if c.cur == nil || !bytes.Equal(c.cur.key, key) {
+				continue
+			}
+			if winner == nil || c.cur.version >= winner.version {
+				winner = c.cur
+			}
+			c.nextKey()
+		}
+
+		if !winner.deleted && itr.visible(winner) {
+			itr.key, itr.value, itr.valid = winner.key, winner.value, true
+			return
+		}
+	}
+}
+
+func (itr *iterator) Key() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.key)
+}
+
+func (itr *iterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.value)
+}
+
+func (itr *iterator) Error() error {
+	return itr.err
+}
+
+func (itr *iterator) Close() error {
+	itr.valid = false
+	itr.cursors = nil
+	return nil
+}
+
+func (itr *iterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
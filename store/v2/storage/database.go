@@ -24,3 +24,11 @@ type Database interface {
 
 	io.Closer
 }
+
+// ChangesetReader is an optional interface of a Database which can read the
+// changesets of past versions back, e.g. to take incremental snapshots.
+type ChangesetReader interface {
+	// IterateChangesets calls fn with the changeset of each version in (from, to],
+	// in ascending order of versions, including the versions without changes.
+	IterateChangesets(from, to uint64, fn func(version uint64, cs *corestore.Changeset) error) error
+}
@@ -36,8 +36,22 @@ const (
 var (
 	_ storage.Database         = (*Database)(nil)
 	_ store.UpgradableDatabase = (*Database)(nil)
+	_ storage.ChangesetReader  = (*Database)(nil)
 )
 
+// BackendName is the name under which the backend is registered.
+const BackendName = "pebble"
+
+func init() {
+	storage.RegisterDatabase(BackendName, func(dataDir string) (storage.Database, error) {
+		db, err := New(dataDir)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	})
+}
+
 type Database struct {
 	storage *pebble.DB
 
@@ -344,6 +358,81 @@ func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) error {
 	return batch.Commit(&pebble.WriteOptions{Sync: db.sync})
 }
 
+// IterateChangesets reads the changesets of the versions in (from, to] back from
+// the keys written at these versions.
+//
+// Note, the implementation of this method iterates over all keys in the database
+// and holds the changesets of the whole range in memory.
+func (db *Database) IterateChangesets(from, to uint64, fn func(version uint64, cs *corestore.Changeset) error) error {
+	if from < db.earliestVersion {
+		return storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: from}
+	}
+
+	prefix := []byte("s/k:")
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: prefix, UpperBound: util.CopyIncr(prefix)})
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	changesets := make(map[uint64]*corestore.Changeset)
+	for itr.First(); itr.Valid(); itr.Next() {
+		keyBz, verBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+
+		keyVersion, err := decodeUint64Ascending(verBz)
+		if err != nil {
+			return fmt.Errorf("failed to decode key version: %w", err)
+		}
+		if keyVersion <= from || keyVersion > to {
+			continue
+		}
+
+		// the key is prefixed with the store key and a '/' separator
+		storeKey, key, ok := bytes.Cut(keyBz[len(prefix):], []byte("/"))
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB key: %s", keyBz)
+		}
+
+		value, err := itr.ValueAndErr()
+		if err != nil {
+			return err
+		}
+		valBz, _, ok := SplitMVCCKey(value)
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC value: %s", value)
+		}
+
+		cs, ok := changesets[keyVersion]
+		if !ok {
+			cs = corestore.NewChangeset()
+			changesets[keyVersion] = cs
+		}
+		if valTombstoned(value) {
+			cs.Add(slices.Clone(storeKey), slices.Clone(key), nil, true)
+		} else {
+			cs.Add(slices.Clone(storeKey), slices.Clone(key), slices.Clone(valBz), false)
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+
+	for version := from + 1; version <= to; version++ {
+		cs, ok := changesets[version]
+		if !ok {
+			cs = corestore.NewChangeset()
+		}
+		if err := fn(version, cs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func storePrefix(storeKey []byte) []byte {
 	return []byte(fmt.Sprintf(StorePrefixTpl, storeKey))
 }
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DatabaseFactory creates a Database storing its data in the provided directory.
+type DatabaseFactory func(dataDir string) (Database, error)
+
+var (
+	registryMtx sync.RWMutex
+	registry    = map[string]DatabaseFactory{}
+)
+
+// RegisterDatabase registers a Database backend under the given name, which is the
+// name used to select it in the store configuration. Backends usually register
+// themselves in an init function, so that importing their package is enough to
+// make them available. It panics if a backend is already registered under the name.
+func RegisterDatabase(name string, factory DatabaseFactory) {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("state storage backend %q is already registered", name))
+	}
+	registry[name] = factory
+}
+
+// NewDatabase creates a Database using the backend registered under the given name.
+func NewDatabase(name, dataDir string) (Database, error) {
+	registryMtx.RLock()
+	factory, ok := registry[name]
+	registryMtx.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf(
+			"state storage backend %q is not registered (available: %s); make sure the package of the backend is imported and built with the build tags it requires",
+			name, strings.Join(RegisteredDatabases(), ", "),
+		)
+	}
+
+	return factory(dataDir)
+}
+
+// RegisteredDatabases returns the sorted names of the registered Database backends.
+func RegisteredDatabases() []string {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+
+	return slices.Sorted(maps.Keys(registry))
+}
@@ -33,6 +33,16 @@ var (
 	defaultReadOpts  = grocksdb.NewDefaultReadOptions()
 )
 
+func init() {
+	storage.RegisterDatabase(BackendName, func(dataDir string) (storage.Database, error) {
+		db, err := New(dataDir)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	})
+}
+
 type Database struct {
 	storage  *grocksdb.DB
 	cfHandle *grocksdb.ColumnFamilyHandle
@@ -0,0 +1,4 @@
+package rocksdb
+
+// BackendName is the name under which the backend is registered.
+const BackendName = "rocksdb"
@@ -0,0 +1,16 @@
+//go:build !rocksdb
+// +build !rocksdb
+
+package rocksdb
+
+import (
+	"errors"
+
+	"cosmossdk.io/store/v2/storage"
+)
+
+func init() {
+	storage.RegisterDatabase(BackendName, func(string) (storage.Database, error) {
+		return nil, errors.New("rocksdb state storage must be built with -tags rocksdb")
+	})
+}
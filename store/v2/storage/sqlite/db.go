@@ -47,8 +47,22 @@ const (
 var (
 	_ storage.Database         = (*Database)(nil)
 	_ store.UpgradableDatabase = (*Database)(nil)
+	_ storage.ChangesetReader  = (*Database)(nil)
 )
 
+// BackendName is the name under which the backend is registered.
+const BackendName = "sqlite"
+
+func init() {
+	storage.RegisterDatabase(BackendName, func(dataDir string) (storage.Database, error) {
+		db, err := New(dataDir)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	})
+}
+
 type Database struct {
 	storage *sql.DB
 
@@ -291,6 +305,74 @@ func (db *Database) PruneStoreKeys(storeKeys []string, version uint64) (err erro
 	return tx.Commit()
 }
 
+// IterateChangesets reads the changesets of the versions in (from, to] back from
+// the rows written and tombstoned at these versions. A key deleted and set again
+// at the same version is returned as a delete followed by a set.
+func (db *Database) IterateChangesets(from, to uint64, fn func(version uint64, cs *corestore.Changeset) error) error {
+	if from < db.earliestVersion {
+		return storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: from}
+	}
+
+	stmt, err := db.storage.Prepare(`
+	SELECT version, 1 AS op, store_key, key, value FROM state_storage
+	WHERE version > ? AND version <= ? AND tombstone != version AND store_key != ?
+	UNION ALL
+	SELECT tombstone AS version, 0 AS op, store_key, key, value FROM state_storage
+	WHERE tombstone > ? AND tombstone <= ? AND store_key != ?
+	ORDER BY version, store_key, key, op;
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(from, to, reservedStoreKey, from, to, reservedStoreKey)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+	defer rows.Close()
+
+	version := from + 1
+	cs := corestore.NewChangeset()
+	for rows.Next() {
+		var (
+			rowVersion uint64
+			op         int
+			storeKey   []byte
+			key        []byte
+			value      []byte
+		)
+		if err := rows.Scan(&rowVersion, &op, &storeKey, &key, &value); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		for ; version < rowVersion; version++ {
+			if err := fn(version, cs); err != nil {
+				return err
+			}
+			cs = corestore.NewChangeset()
+		}
+		if op == 0 {
+			cs.Add(storeKey, key, nil, true)
+		} else {
+			cs.Add(storeKey, key, value, false)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("received unexpected error: %w", err)
+	}
+
+	for ; version <= to; version++ {
+		if err := fn(version, cs); err != nil {
+			return err
+		}
+		cs = corestore.NewChangeset()
+	}
+
+	return nil
+}
+
 func (db *Database) PrintRowsDebug() {
 	stmt, err := db.storage.Prepare("SELECT store_key, key, value, version, tombstone FROM state_storage")
 	if err != nil {
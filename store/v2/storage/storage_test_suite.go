@@ -706,6 +706,66 @@ func (s *StorageTestSuite) TestDatabase_Restore() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_IterateChangesets() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	if _, ok := db.db.(ChangesetReader); !ok {
+		s.T().Skip("database does not implement ChangesetReader")
+	}
+
+	storeKey2 := []byte("store2")
+	changesets := map[uint64]*corestore.Changeset{
+		1: corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			storeKey1:         {{Key: []byte("key000"), Value: []byte("val000-001")}, {Key: []byte("key001"), Value: []byte("val001-001")}},
+			string(storeKey2): {{Key: []byte("key000"), Value: []byte("val000-001")}},
+		}),
+		2: corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			storeKey1: {{Key: []byte("key000"), Value: []byte("val000-002")}, {Key: []byte("key001"), Remove: true}},
+		}),
+		// version 3 has no changes
+		4: corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			string(storeKey2): {{Key: []byte("key000"), Remove: true}, {Key: []byte("key001"), Value: []byte("val001-004")}},
+		}),
+	}
+	for v := uint64(1); v <= 4; v++ {
+		cs, ok := changesets[v]
+		if !ok {
+			cs = corestore.NewChangeset()
+		}
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+	}
+
+	var versions []uint64
+	err = db.IterateChangesets(1, 4, func(version uint64, cs *corestore.Changeset) error {
+		versions = append(versions, version)
+		expected := map[string]map[string]corestore.KVPair{}
+		if ecs, ok := changesets[version]; ok {
+			for _, pairs := range ecs.Changes {
+				expected[string(pairs.Actor)] = map[string]corestore.KVPair{}
+				for _, kv := range pairs.StateChanges {
+					expected[string(pairs.Actor)][string(kv.Key)] = kv
+				}
+			}
+		}
+		actual := map[string]map[string]corestore.KVPair{}
+		for _, pairs := range cs.Changes {
+			actual[string(pairs.Actor)] = map[string]corestore.KVPair{}
+			for _, kv := range pairs.StateChanges {
+				if kv.Remove {
+					kv.Value = nil
+				}
+				actual[string(pairs.Actor)][string(kv.Key)] = kv
+			}
+		}
+		s.Require().Equal(expected, actual, "version %d", version)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2, 3, 4}, versions)
+}
+
 func (s *StorageTestSuite) TestUpgradable() {
 	ss, err := s.NewDB(s.T().TempDir())
 	s.Require().NoError(err)
@@ -20,6 +20,7 @@ var (
 	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
 	_ store.Pruner                 = (*StorageStore)(nil)
 	_ store.UpgradableDatabase     = (*StorageStore)(nil)
+	_ snapshots.ChangesetReader    = (*StorageStore)(nil)
 )
 
 // StorageStore is a wrapper around the store.VersionedDatabase interface.
@@ -139,6 +140,17 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 	return nil
 }
 
+// IterateChangesets reads the changesets of the versions in (from, to] back from
+// the database, which implements the snapshots.ChangesetReader interface.
+func (ss *StorageStore) IterateChangesets(from, to uint64, fn func(version uint64, cs *corestore.Changeset) error) error {
+	reader, ok := ss.db.(ChangesetReader)
+	if !ok {
+		return errors.New("db does not implement ChangesetReader interface")
+	}
+
+	return reader.IterateChangesets(from, to, fn)
+}
+
 // PruneStoreKeys prunes the store keys which implements the store.UpgradableDatabase
 // interface.
 func (ss *StorageStore) PruneStoreKeys(storeKeys []string, version uint64) error {
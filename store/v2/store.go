@@ -105,4 +105,11 @@ type QueryResult struct {
 	Value    []byte
 	Version  uint64
 	ProofOps []proof.CommitmentOp
+
+	// Unprovable is set instead of ProofOps when a proof is requested for a
+	// version pruned from the SC backend.
+	Unprovable bool
+	// CommitInfo is the commit info of the unprovable version, if kept by the
+	// SC backend, against which the light clients can check the app hash.
+	CommitInfo *proof.CommitInfo
 }
@@ -12,6 +12,7 @@ import (
 	lockupaccount "cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/lockup/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -200,4 +201,40 @@ func (s *E2ETestSuite) TestContinuousLockingAccount() {
 		delFree := lockupAccountInfoResponse.DelegatedFree
 		require.True(t, delFree.AmountOf("stake").Equal(math.NewInt(100)))
 	})
+
+	migrateMsg, err := codectypes.NewAnyWithValue(&types.MsgMigrateLockupAccount{})
+	require.NoError(t, err)
+
+	t.Run("error - execute migrate message, account type not allowed", func(t *testing.T) {
+		msg := &types.MsgMigrate{
+			Sender:      ownerAddrStr,
+			AccountType: lockupaccount.PERIODIC_LOCKING_ACCOUNT_V2,
+			Message:     migrateMsg,
+		}
+		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		require.NotNil(t, err)
+	})
+	t.Run("ok - execute migrate message", func(t *testing.T) {
+		msg := &types.MsgMigrate{
+			Sender:      ownerAddrStr,
+			AccountType: lockupaccount.CONTINUOUS_LOCKING_ACCOUNT_V2,
+			Message:     migrateMsg,
+		}
+		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		require.NoError(t, err)
+
+		accType, err := app.AccountsKeeper.AccountsByType.Get(ctx, accountAddr)
+		require.NoError(t, err)
+		require.Equal(t, lockupaccount.CONTINUOUS_LOCKING_ACCOUNT_V2, accType)
+
+		// the v2 account keeps the state of the account
+		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
+		require.Equal(t, ownerAddrStr, lockupAccountInfoResponse.Owner)
+		delFree := lockupAccountInfoResponse.DelegatedFree
+		require.True(t, delFree.AmountOf("stake").Equal(math.NewInt(100)))
+
+		// the v2 account cannot be migrated any further
+		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		require.NotNil(t, err)
+	})
 }
@@ -8,6 +8,7 @@ import (
 	"pgregory.net/rapid"
 
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
 )
 
 func TestCollectionsCorrectness(t *testing.T) {
@@ -34,6 +35,10 @@ func TestCollectionsCorrectness(t *testing.T) {
 	t.Run("BytesIndexingKey", func(t *testing.T) {
 		colltest.TestKeyCodec(t, LengthPrefixedBytesKey, []byte{})
 	})
+
+	t.Run("LegacyDec", func(t *testing.T) {
+		colltest.TestValueCodec(t, LegacyDecValue, math.LegacyNewDecWithPrec(25, 4))
+	})
 }
 
 func TestLEUint64Key(t *testing.T) {
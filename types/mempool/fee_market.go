@@ -0,0 +1,390 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/huandu/skiplist"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*FeeMarketMempool)(nil)
+	_ Iterator = (*FeeMarketIterator)(nil)
+)
+
+type (
+	// FeeMarketMempoolConfig defines the configuration used to configure the
+	// FeeMarketMempool.
+	FeeMarketMempoolConfig struct {
+		// TxGasPrice returns the effective gas price (fee per unit of gas) of a
+		// transaction. Transactions paying a higher gas price are selected first.
+		TxGasPrice func(ctx context.Context, tx sdk.Tx) (math.LegacyDec, error)
+
+		// MaxTx sets the maximum number of transactions allowed in the mempool with
+		// the semantics:
+		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
+		// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
+		//   evicting the lowest paying transactions to make room for better paying
+		//   ones.
+		// - if MaxTx < 0, `Insert` is a no-op.
+		MaxTx int
+
+		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
+		SignerExtractor SignerExtractionAdapter
+
+		// ReplacementPriceBump is the minimum gas price increase, in percent, that
+		// a tx must pay over the tx with the same sender and nonce it replaces.
+		// A replacement must always pay a strictly higher gas price, even when
+		// ReplacementPriceBump is 0.
+		ReplacementPriceBump uint64
+	}
+
+	// FeeMarketMempool is a mempool implementation that orders transactions by
+	// their effective gas price across senders, while keeping the transactions of
+	// a single sender in sender-nonce (sequence number) order.
+	//
+	// Internally it keeps one skip list per sender ordered by nonce and one global
+	// skip list ordered by gas price. The global index is used to find eviction
+	// candidates when the mempool is full: the lowest paying transaction that is
+	// the last (highest nonce) transaction of its sender is evicted, so that
+	// eviction never creates a nonce gap for the remaining transactions.
+	FeeMarketMempool struct {
+		mtx           sync.Mutex
+		priceIndex    *skiplist.SkipList
+		senderIndices map[string]*skiplist.SkipList
+		cfg           FeeMarketMempoolConfig
+	}
+
+	// FeeMarketIterator defines an iterator that is used for mempool iteration
+	// on Select(). On every step it yields the highest paying transaction among
+	// the next (lowest nonce) transaction of every sender.
+	FeeMarketIterator struct {
+		heads   feeMarketHeads
+		current *skiplist.Element
+	}
+
+	// feeMarketTx stores a transaction together with the metadata used in the
+	// mempool indices.
+	feeMarketTx struct {
+		tx       sdk.Tx
+		sender   string
+		nonce    uint64
+		gasPrice math.LegacyDec
+	}
+
+	// feeMarketKey is the key of the global gas price index.
+	feeMarketKey struct {
+		gasPrice math.LegacyDec
+		sender   string
+		nonce    uint64
+	}
+
+	// feeMarketHeads is a max-heap of sender index elements ordered by gas price.
+	feeMarketHeads []*skiplist.Element
+)
+
+// NewFeeTxGasPrice returns a TxGasPrice function computing the effective gas
+// price of a sdk.FeeTx as the amount of fees paid in denom divided by the gas
+// limit of the transaction.
+func NewFeeTxGasPrice(denom string) func(context.Context, sdk.Tx) (math.LegacyDec, error) {
+	return func(_ context.Context, tx sdk.Tx) (math.LegacyDec, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return math.LegacyDec{}, fmt.Errorf("tx of type %T does not implement FeeTx", tx)
+		}
+
+		gas := feeTx.GetGas()
+		if gas == 0 {
+			return math.LegacyZeroDec(), nil
+		}
+
+		fee := feeTx.GetFee().AmountOf(denom)
+		return math.LegacyNewDecFromInt(fee).QuoInt(math.NewIntFromUint64(gas)), nil
+	}
+}
+
+// DefaultReplacementPriceBump is the default minimum gas price increase, in
+// percent, required to replace a tx in the FeeMarketMempool.
+const DefaultReplacementPriceBump = 10
+
+// DefaultFeeMarketMempoolConfig returns the default FeeMarketMempool
+// configuration, computing gas prices from the fees paid in denom.
+func DefaultFeeMarketMempoolConfig(denom string) FeeMarketMempoolConfig {
+	return FeeMarketMempoolConfig{
+		TxGasPrice:           NewFeeTxGasPrice(denom),
+		SignerExtractor:      NewDefaultSignerExtractionAdapter(),
+		ReplacementPriceBump: DefaultReplacementPriceBump,
+	}
+}
+
+// feeMarketComparable orders the gas price index by ascending gas price, then
+// sender, then nonce, uniquely identifying a transaction.
+var feeMarketComparable = skiplist.GreaterThanFunc(func(a, b any) int {
+	keyA := a.(feeMarketKey)
+	keyB := b.(feeMarketKey)
+
+	switch {
+	case keyA.gasPrice.LT(keyB.gasPrice):
+		return -1
+	case keyA.gasPrice.GT(keyB.gasPrice):
+		return 1
+	}
+
+	if res := skiplist.String.Compare(keyA.sender, keyB.sender); res != 0 {
+		return res
+	}
+
+	return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+})
+
+// NewFeeMarketMempool returns a mempool that orders transactions by effective
+// gas price across senders and by nonce within a sender.
+func NewFeeMarketMempool(cfg FeeMarketMempoolConfig) *FeeMarketMempool {
+	if cfg.TxGasPrice == nil {
+		panic("fee market mempool: TxGasPrice must be set")
+	}
+	if cfg.SignerExtractor == nil {
+		cfg.SignerExtractor = NewDefaultSignerExtractionAdapter()
+	}
+
+	return &FeeMarketMempool{
+		priceIndex:    skiplist.New(feeMarketComparable),
+		senderIndices: make(map[string]*skiplist.SkipList),
+		cfg:           cfg,
+	}
+}
+
+// NextSenderTx returns the next transaction for a given sender by nonce order,
+// i.e. the next valid transaction for the sender. If no such transaction exists,
+// nil will be returned.
+func (mp *FeeMarketMempool) NextSenderTx(sender string) sdk.Tx {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return nil
+	}
+
+	return senderIndex.Front().Value.(*feeMarketTx).tx
+}
+
+// Insert attempts to insert a Tx into the app-side mempool in O(log n) time,
+// or O(n) time when the mempool is full, returning an error if unsuccessful.
+// Sender and nonce are derived from the transaction's first signature.
+//
+// Inserting a tx with an existing sender and nonce replaces the existing tx if
+// its gas price is at least ReplacementPriceBump percent higher, otherwise it is
+// rejected with ErrTxReplacementUnderpriced.
+//
+// When the mempool is full, the lowest paying evictable tx is removed to make
+// room for the new one. If the new tx does not pay strictly more than that tx,
+// it is rejected with ErrMempoolTxMaxCapacity.
+func (mp *FeeMarketMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return errors.New("tx must have at least one signer")
+	}
+
+	gasPrice, err := mp.cfg.TxGasPrice(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	sig := sigs[0]
+	entry := &feeMarketTx{
+		tx:       tx,
+		sender:   sig.Signer.String(),
+		nonce:    sig.Sequence,
+		gasPrice: gasPrice,
+	}
+
+	senderIndex, ok := mp.senderIndices[entry.sender]
+	if ok {
+		if existing := senderIndex.Get(entry.nonce); existing != nil {
+			replaced := existing.Value.(*feeMarketTx)
+			if !mp.canReplace(replaced.gasPrice, entry.gasPrice) {
+				return fmt.Errorf("%w: gas price %s, replaced tx gas price %s, min bump %d%%",
+					ErrTxReplacementUnderpriced, entry.gasPrice, replaced.gasPrice, mp.cfg.ReplacementPriceBump)
+			}
+
+			// replacing an existing tx does not change the mempool size
+			mp.priceIndex.Remove(replaced.key())
+			mp.set(senderIndex, entry)
+			return nil
+		}
+	}
+
+	if mp.cfg.MaxTx > 0 && mp.priceIndex.Len() >= mp.cfg.MaxTx {
+		evict := mp.evictionCandidate()
+		if evict == nil || evict.gasPrice.GTE(entry.gasPrice) {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		mp.remove(evict)
+		// the evicted tx may have been the last tx of the sender
+		senderIndex, ok = mp.senderIndices[entry.sender]
+	}
+
+	if !ok {
+		senderIndex = skiplist.New(skiplist.Uint64)
+		mp.senderIndices[entry.sender] = senderIndex
+	}
+
+	mp.set(senderIndex, entry)
+	return nil
+}
+
+// canReplace returns true if a tx paying newPrice can replace a tx paying oldPrice.
+func (mp *FeeMarketMempool) canReplace(oldPrice, newPrice math.LegacyDec) bool {
+	minPrice := oldPrice.MulInt64(100 + int64(mp.cfg.ReplacementPriceBump)).QuoInt64(100)
+	return newPrice.GT(oldPrice) && newPrice.GTE(minPrice)
+}
+
+func (mp *FeeMarketMempool) set(senderIndex *skiplist.SkipList, entry *feeMarketTx) {
+	senderIndex.Set(entry.nonce, entry)
+	mp.priceIndex.Set(entry.key(), entry)
+}
+
+func (mp *FeeMarketMempool) remove(entry *feeMarketTx) {
+	mp.priceIndex.Remove(entry.key())
+
+	senderIndex := mp.senderIndices[entry.sender]
+	senderIndex.Remove(entry.nonce)
+	if senderIndex.Len() == 0 {
+		delete(mp.senderIndices, entry.sender)
+	}
+}
+
+// evictionCandidate returns the lowest paying tx which is the highest nonce tx
+// of its sender, or nil if the mempool is empty. It scans the price index from
+// the lowest paying tx, so it runs in O(n) time in the worst case, when the
+// lowest paying txs are not the last txs of their senders.
+func (mp *FeeMarketMempool) evictionCandidate() *feeMarketTx {
+	for node := mp.priceIndex.Front(); node != nil; node = node.Next() {
+		entry := node.Value.(*feeMarketTx)
+		if mp.senderIndices[entry.sender].Back().Value.(*feeMarketTx) == entry {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+// Select returns a set of transactions from the mempool, ordered by gas price
+// and sender-nonce in O(n log s) time, where s is the number of senders. The
+// passed in list of transactions are ignored. This is a readonly operation,
+// the mempool is not modified.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (mp *FeeMarketMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.priceIndex.Len() == 0 {
+		return nil
+	}
+
+	heads := make(feeMarketHeads, 0, len(mp.senderIndices))
+	for _, senderIndex := range mp.senderIndices {
+		heads = append(heads, senderIndex.Front())
+	}
+	heap.Init(&heads)
+
+	iterator := &FeeMarketIterator{heads: heads}
+	return iterator.Next()
+}
+
+// Next returns the next iterator state which will contain the highest paying
+// tx among the next nonce ordered tx of every sender.
+func (i *FeeMarketIterator) Next() Iterator {
+	if i.heads.Len() == 0 {
+		return nil
+	}
+
+	i.current = heap.Pop(&i.heads).(*skiplist.Element)
+	if next := i.current.Next(); next != nil {
+		heap.Push(&i.heads, next)
+	}
+
+	return i
+}
+
+func (i *FeeMarketIterator) Tx() sdk.Tx {
+	return i.current.Value.(*feeMarketTx).tx
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *FeeMarketMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.priceIndex.Len()
+}
+
+// Remove removes a transaction from the mempool in O(log n) time, returning an
+// error if unsuccessful.
+func (mp *FeeMarketMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return errors.New("attempted to remove a tx with no signatures")
+	}
+
+	sig := sigs[0]
+	senderIndex, ok := mp.senderIndices[sig.Signer.String()]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	elem := senderIndex.Get(sig.Sequence)
+	if elem == nil {
+		return ErrTxNotFound
+	}
+
+	mp.remove(elem.Value.(*feeMarketTx))
+	return nil
+}
+
+func (e *feeMarketTx) key() feeMarketKey {
+	return feeMarketKey{gasPrice: e.gasPrice, sender: e.sender, nonce: e.nonce}
+}
+
+func (h feeMarketHeads) Len() int { return len(h) }
+
+func (h feeMarketHeads) Less(i, j int) bool {
+	// reverse the gas price index order so that the highest paying tx is popped
+	// first, ties are resolved deterministically by sender.
+	return feeMarketComparable.Compare(h[i].Value.(*feeMarketTx).key(), h[j].Value.(*feeMarketTx).key()) > 0
+}
+
+func (h feeMarketHeads) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *feeMarketHeads) Push(x any) { *h = append(*h, x.(*skiplist.Element)) }
+
+func (h *feeMarketHeads) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,113 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Property Based Testing
+// Insert random fee paying txs from a set of senders and test the following properties on the selected txs
+// with an unbounded mempool all inserted txs are selected except for sender nonce duplicates, which are overwritten by the later duplicate entries
+// paying at least the replacement price bump and rejected otherwise.
+// with a bounded mempool the number of txs never exceeds the capacity.
+// for every sender transaction tx_n, tx_0.nonce < tx_1.nonce ... < tx_n.nonce
+// every selected tx pays at least as much as the next nonce ordered tx of every other sender.
+
+func genFeeTxs(t *rapid.T) []feeTestTx {
+	genMultipleAddress := rapid.SliceOfNDistinct(AddressGenerator(t), 1, 10, func(acc sdk.AccAddress) string {
+		return acc.String()
+	})
+
+	accounts := genMultipleAddress.Draw(t, "address")
+	genTx := rapid.Custom(func(t *rapid.T) feeTestTx {
+		return feeTestTx{
+			testTx: testTx{
+				nonce:   rapid.Uint64Range(0, 100).Draw(t, "nonce"),
+				address: rapid.SampledFrom(accounts).Draw(t, "acc"),
+			},
+			gas: rapid.Uint64Range(0, 1_000_000).Draw(t, "gas"),
+			fee: rapid.Int64Range(0, 1_000_000).Draw(t, "fee"),
+		}
+	})
+
+	return rapid.SliceOfN(genTx, 1, 1000).Draw(t, "txs")
+}
+
+func testFeeMarketMempoolProperties(t *rapid.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	maxTx := rapid.IntRange(0, 200).Draw(t, "maxTx")
+	mp := newFeeMarketMempool(maxTx)
+
+	txs := genFeeTxs(t)
+	distinct := make(map[string]map[uint64]feeTestTx)
+	for _, tx := range txs {
+		err := mp.Insert(ctx, tx)
+		if maxTx == 0 {
+			replaced, isReplacement := distinct[tx.address.String()][tx.nonce]
+			minPrice := replaced.gasPrice().MulInt64(100 + mempool.DefaultReplacementPriceBump).QuoInt64(100)
+			if isReplacement && (!tx.gasPrice().GT(replaced.gasPrice()) || tx.gasPrice().LT(minPrice)) {
+				require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+			} else {
+				require.NoError(t, err)
+			}
+		}
+		if err == nil {
+			sender := tx.address.String()
+			if distinct[sender] == nil {
+				distinct[sender] = make(map[uint64]feeTestTx)
+			}
+			distinct[sender][tx.nonce] = tx
+		}
+		if maxTx > 0 {
+			require.LessOrEqual(t, mp.CountTx(), maxTx)
+		}
+	}
+
+	var selected []feeTestTx
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx().(feeTestTx))
+	}
+	require.Equal(t, len(selected), mp.CountTx())
+
+	if maxTx == 0 {
+		count := 0
+		for _, senderTxs := range distinct {
+			count += len(senderTxs)
+		}
+		require.Equal(t, count, len(selected))
+	}
+
+	// every selected tx must be the lowest remaining nonce of its sender and pay
+	// at least as much as the lowest remaining nonce of every other sender.
+	remaining := make(map[string][]feeTestTx)
+	for _, tx := range selected {
+		sender := tx.address.String()
+		remaining[sender] = append(remaining[sender], tx)
+		require.Equal(t, distinct[sender][tx.nonce], tx)
+	}
+	for _, tx := range selected {
+		sender := tx.address.String()
+		require.Equal(t, remaining[sender][0], tx)
+		for other, senderTxs := range remaining {
+			if other == sender || len(senderTxs) == 0 {
+				continue
+			}
+			require.True(t, tx.gasPrice().GTE(senderTxs[0].gasPrice()))
+		}
+		remaining[sender] = remaining[sender][1:]
+		if len(remaining[sender]) > 0 {
+			require.Less(t, tx.nonce, remaining[sender][0].nonce)
+		}
+	}
+}
+
+func TestFeeMarketMempoolProperties(t *testing.T) {
+	rapid.Check(t, testFeeMarketMempoolProperties)
+}
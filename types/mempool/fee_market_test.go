@@ -0,0 +1,222 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+const feeMarketDenom = "stake"
+
+var _ sdk.FeeTx = (*feeTestTx)(nil)
+
+// feeTestTx is a testTx paying fee feeMarketDenom for gas.
+type feeTestTx struct {
+	testTx
+	gas uint64
+	fee int64
+}
+
+func (tx feeTestTx) GetGas() uint64 { return tx.gas }
+
+func (tx feeTestTx) GetFee() sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(feeMarketDenom, tx.fee)) }
+
+func (tx feeTestTx) FeePayer() []byte { return tx.address }
+
+func (tx feeTestTx) FeeGranter() []byte { return nil }
+
+func (tx feeTestTx) gasPrice() math.LegacyDec {
+	if tx.gas == 0 {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDec(tx.fee).QuoInt64(int64(tx.gas))
+}
+
+func newFeeMarketMempool(maxTx int) *mempool.FeeMarketMempool {
+	cfg := mempool.DefaultFeeMarketMempoolConfig(feeMarketDenom)
+	cfg.MaxTx = maxTx
+	return mempool.NewFeeMarketMempool(cfg)
+}
+
+func TestFeeMarketMempool_TxOrder(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	tests := []struct {
+		name  string
+		txs   []feeTestTx
+		order []int
+	}{
+		{
+			name: "highest gas price first across senders",
+			txs: []feeTestTx{
+				{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 100, fee: 100},
+				{testTx: testTx{id: 1, nonce: 0, address: sb}, gas: 100, fee: 300},
+				{testTx: testTx{id: 2, nonce: 0, address: sc}, gas: 100, fee: 200},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			name: "gas price is fee per unit of gas",
+			txs: []feeTestTx{
+				{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 1000, fee: 1000},
+				{testTx: testTx{id: 1, nonce: 0, address: sb}, gas: 100, fee: 500},
+			},
+			order: []int{1, 0},
+		},
+		{
+			name: "sender nonce order is respected",
+			txs: []feeTestTx{
+				{testTx: testTx{id: 0, nonce: 1, address: sa}, gas: 100, fee: 900},
+				{testTx: testTx{id: 1, nonce: 0, address: sa}, gas: 100, fee: 100},
+				{testTx: testTx{id: 2, nonce: 0, address: sb}, gas: 100, fee: 500},
+			},
+			order: []int{2, 1, 0},
+		},
+		{
+			name: "low paying nonce unblocks high paying successor",
+			txs: []feeTestTx{
+				{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 100, fee: 100},
+				{testTx: testTx{id: 1, nonce: 1, address: sa}, gas: 100, fee: 900},
+				{testTx: testTx{id: 2, nonce: 0, address: sb}, gas: 100, fee: 200},
+				{testTx: testTx{id: 3, nonce: 0, address: sc}, gas: 100, fee: 50},
+			},
+			order: []int{2, 0, 1, 3},
+		},
+		{
+			name: "replacement changes the gas price",
+			txs: []feeTestTx{
+				{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 100, fee: 100},
+				{testTx: testTx{id: 1, nonce: 0, address: sb}, gas: 100, fee: 200},
+				{testTx: testTx{id: 2, nonce: 0, address: sa}, gas: 100, fee: 300},
+			},
+			order: []int{2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mp := newFeeMarketMempool(0)
+			for _, tx := range tt.txs {
+				require.NoError(t, mp.Insert(ctx, tx))
+			}
+			require.Equal(t, len(tt.order), mp.CountTx())
+
+			var order []int
+			for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+				order = append(order, iter.Tx().(feeTestTx).id)
+			}
+			require.Equal(t, tt.order, order)
+
+			for _, tx := range tt.txs {
+				err := mp.Remove(tx)
+				if err != nil {
+					require.ErrorIs(t, err, mempool.ErrTxNotFound)
+				}
+			}
+			require.Equal(t, 0, mp.CountTx())
+			require.Nil(t, mp.Select(ctx, nil))
+		})
+	}
+}
+
+func TestFeeMarketMempool_Eviction(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	mp := newFeeMarketMempool(3)
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 100, fee: 100}))
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 1, nonce: 1, address: sa}, gas: 100, fee: 500}))
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 2, nonce: 0, address: sb}, gas: 100, fee: 300}))
+
+	// paying less than every evictable tx is rejected
+	err := mp.Insert(ctx, feeTestTx{testTx: testTx{id: 3, nonce: 0, address: sc}, gas: 100, fee: 300})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+
+	// sa's nonce 0 is the cheapest tx but evicting it would leave a nonce gap,
+	// so sb's tx is evicted instead
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 4, nonce: 0, address: sc}, gas: 100, fee: 400}))
+	require.Equal(t, 3, mp.CountTx())
+	require.Nil(t, mp.NextSenderTx(sb.String()))
+
+	// replacing an existing tx never evicts
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 5, nonce: 0, address: sa}, gas: 100, fee: 110}))
+	require.Equal(t, 3, mp.CountTx())
+
+	var order []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		order = append(order, iter.Tx().(feeTestTx).id)
+	}
+	require.Equal(t, []int{4, 5, 1}, order)
+
+	// disabled
+	mp = newFeeMarketMempool(-1)
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 100, fee: 100}))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestFeeMarketMempool_Replacement(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	sa := accounts[0].Address
+
+	mp := newFeeMarketMempool(0)
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 100, fee: 100}))
+
+	// a replacement must pay at least the default 10% more
+	for _, fee := range []int64{50, 100, 109} {
+		err := mp.Insert(ctx, feeTestTx{testTx: testTx{id: 1, nonce: 0, address: sa}, gas: 100, fee: fee})
+		require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+	}
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 2, nonce: 0, address: sa}, gas: 100, fee: 110}))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, 2, mp.NextSenderTx(sa.String()).(feeTestTx).id)
+
+	// without a bump, a replacement must still pay strictly more
+	cfg := mempool.DefaultFeeMarketMempoolConfig(feeMarketDenom)
+	cfg.ReplacementPriceBump = 0
+	mp = mempool.NewFeeMarketMempool(cfg)
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 0, nonce: 0, address: sa}, gas: 100, fee: 0}))
+	err := mp.Insert(ctx, feeTestTx{testTx: testTx{id: 1, nonce: 0, address: sa}, gas: 100, fee: 0})
+	require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+	require.NoError(t, mp.Insert(ctx, feeTestTx{testTx: testTx{id: 2, nonce: 0, address: sa}, gas: 100, fee: 1}))
+}
+
+func TestFeeMarketMempool_GasPriceOverflow(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	// a gas limit above MaxInt64 must not overflow into a negative gas price
+	gasPrice, err := mempool.NewFeeTxGasPrice(feeMarketDenom)(ctx, feeTestTx{
+		testTx: testTx{nonce: 0, address: accounts[0].Address},
+		gas:    1<<63 + 1,
+		fee:    1_000_000,
+	})
+	require.NoError(t, err)
+	require.True(t, gasPrice.IsPositive())
+	require.True(t, gasPrice.LT(math.LegacyOneDec()))
+}
+
+func TestFeeMarketMempool_NonFeeTx(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	mp := newFeeMarketMempool(0)
+	require.Error(t, mp.Insert(ctx, testTx{nonce: 0, address: accounts[0].Address}))
+	require.Equal(t, 0, mp.CountTx())
+}
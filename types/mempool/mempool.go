@@ -38,6 +38,7 @@ type Iterator interface {
 }
 
 var (
-	ErrTxNotFound           = errors.New("tx not found in mempool")
-	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrTxNotFound               = errors.New("tx not found in mempool")
+	ErrMempoolTxMaxCapacity     = errors.New("pool reached max tx capacity")
+	ErrTxReplacementUnderpriced = errors.New("replacement tx underpriced")
 )
@@ -0,0 +1,258 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons for which a transaction is evicted from the mempool, reported by the
+// evicted transactions metric.
+const (
+	EvictReasonExpired       = "expired"
+	EvictReasonTimeoutHeight = "timeout_height"
+	EvictReasonSequence      = "sequence"
+)
+
+var _ RecheckMempool = (*EvictingMempool)(nil)
+
+// RecheckMempool defines a Mempool able to evict the transactions made stale by
+// a committed block.
+type RecheckMempool interface {
+	Mempool
+
+	// Recheck removes the transactions which can no longer be included in a
+	// block built on top of the state of ctx. It is called by BaseApp after
+	// every block commit with the new CheckTx state.
+	Recheck(ctx context.Context) error
+}
+
+type (
+	// EvictionConfig defines the configuration used to configure the
+	// EvictingMempool.
+	EvictionConfig struct {
+		// HeaderService returns the header of the context passed to Insert and
+		// Recheck. It is required.
+		HeaderService header.Service
+
+		// TxTTL is the maximum duration, measured in block time, a transaction
+		// stays in the mempool. Zero disables time based expiry.
+		TxTTL time.Duration
+
+		// TxTTLBlocks is the maximum number of blocks a transaction stays in the
+		// mempool. Zero disables height based expiry.
+		TxTTLBlocks uint64
+
+		// AccountSequence returns the current sequence of the given account. A
+		// transaction whose nonce is lower than its signer's sequence has already
+		// been consumed and is evicted. If nil, sequences are not checked.
+		AccountSequence func(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+
+		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
+		SignerExtractor SignerExtractionAdapter
+	}
+
+	// EvictingMempool wraps a Mempool, evicting on Recheck the transactions
+	// which expired, whose timeout height has passed or whose sequence has
+	// already been consumed.
+	EvictingMempool struct {
+		Mempool
+
+		mtx     sync.Mutex
+		entries map[evictionKey]evictionEntry
+		cfg     EvictionConfig
+	}
+
+	// evictionKey uniquely identifies a transaction by sender and nonce, as the
+	// SDK mempools do.
+	evictionKey struct {
+		sender string
+		nonce  uint64
+	}
+
+	// evictionEntry records when a transaction entered the mempool.
+	evictionEntry struct {
+		tx     sdk.Tx
+		signer sdk.AccAddress
+		height int64
+		time   time.Time
+	}
+)
+
+// NewEvictingMempool returns an EvictingMempool wrapping mp.
+func NewEvictingMempool(mp Mempool, cfg EvictionConfig) *EvictingMempool {
+	if cfg.HeaderService == nil {
+		panic("evicting mempool: HeaderService must be set")
+	}
+	if cfg.SignerExtractor == nil {
+		cfg.SignerExtractor = NewDefaultSignerExtractionAdapter()
+	}
+
+	return &EvictingMempool{
+		Mempool: mp,
+		entries: make(map[evictionKey]evictionEntry),
+		cfg:     cfg,
+	}
+}
+
+// Insert inserts tx into the wrapped mempool, recording the height and block
+// time of ctx as the time tx entered the mempool.
+func (mp *EvictingMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	// extract the signer first so that a tx which can't be tracked is never
+	// left in the wrapped mempool
+	sig, err := mp.firstSigner(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := mp.Mempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	headerInfo := mp.cfg.HeaderService.HeaderInfo(ctx)
+	mp.entries[evictionKey{sender: sig.Signer.String(), nonce: sig.Sequence}] = evictionEntry{
+		tx:     tx,
+		signer: sig.Signer,
+		height: headerInfo.Height,
+		time:   headerInfo.Time,
+	}
+
+	return nil
+}
+
+// Remove removes tx from the wrapped mempool.
+func (mp *EvictingMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	err := mp.Mempool.Remove(tx)
+	if err == nil || errors.Is(err, ErrTxNotFound) {
+		mp.forget(tx)
+	}
+
+	return err
+}
+
+// Recheck evicts the transactions made stale by the block committed in ctx:
+// the transactions which expired, whose timeout height is lower or equal to the
+// block height, or whose nonce is lower than their signer's sequence. Unordered
+// transactions are never evicted because of their sequence.
+func (mp *EvictingMempool) Recheck(ctx context.Context) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	headerInfo := mp.cfg.HeaderService.HeaderInfo(ctx)
+	height, blockTime := headerInfo.Height, headerInfo.Time
+
+	sequences := make(map[string]uint64)
+	evicted := make(map[string]int)
+	for key, entry := range mp.entries {
+		reason, err := mp.evictionReason(ctx, sequences, key, entry, height, blockTime)
+		if err != nil {
+			return err
+		}
+		if reason == "" {
+			continue
+		}
+
+		if err := mp.Mempool.Remove(entry.tx); err != nil && !errors.Is(err, ErrTxNotFound) {
+			return err
+		} else if err == nil {
+			evicted[reason]++
+		}
+		delete(mp.entries, key)
+	}
+
+	for reason, count := range evicted {
+		telemetry.IncrCounterWithLabels(
+			[]string{"mempool", "evicted_txs"},
+			float32(count),
+			[]metrics.Label{telemetry.NewLabel("reason", reason)},
+		)
+	}
+
+	return nil
+}
+
+// evictionReason returns the reason for which the transaction of entry must be
+// evicted, or an empty string if it stays in the mempool.
+func (mp *EvictingMempool) evictionReason(
+	ctx context.Context,
+	sequences map[string]uint64,
+	key evictionKey,
+	entry evictionEntry,
+	height int64,
+	blockTime time.Time,
+) (string, error) {
+	if tx, ok := entry.tx.(sdk.TxWithTimeoutHeight); ok {
+		if timeoutHeight := tx.GetTimeoutHeight(); timeoutHeight > 0 && height >= 0 && timeoutHeight <= uint64(height) {
+			return EvictReasonTimeoutHeight, nil
+		}
+	}
+
+	unordered := false
+	if tx, ok := entry.tx.(sdk.TxWithUnordered); ok {
+		unordered = tx.GetUnordered()
+	}
+
+	if mp.cfg.AccountSequence != nil && !unordered {
+		sequence, ok := sequences[key.sender]
+		if !ok {
+			var err error
+			sequence, err = mp.cfg.AccountSequence(ctx, entry.signer)
+			if err != nil {
+				return "", fmt.Errorf("failed to get sequence of %s: %w", key.sender, err)
+			}
+			sequences[key.sender] = sequence
+		}
+
+		if key.nonce < sequence {
+			return EvictReasonSequence, nil
+		}
+	}
+
+	if mp.cfg.TxTTLBlocks > 0 && height-entry.height >= int64(mp.cfg.TxTTLBlocks) {
+		return EvictReasonExpired, nil
+	}
+
+	if mp.cfg.TxTTL > 0 && blockTime.Sub(entry.time) >= mp.cfg.TxTTL {
+		return EvictReasonExpired, nil
+	}
+
+	return "", nil
+}
+
+// forget drops the eviction entry of tx.
+func (mp *EvictingMempool) forget(tx sdk.Tx) {
+	sig, err := mp.firstSigner(tx)
+	if err != nil {
+		return
+	}
+
+	delete(mp.entries, evictionKey{sender: sig.Signer.String(), nonce: sig.Sequence})
+}
+
+// firstSigner returns the signer identifying tx in the mempool.
+func (mp *EvictingMempool) firstSigner(tx sdk.Tx) (SignerData, error) {
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return SignerData{}, err
+	}
+	if len(sigs) == 0 {
+		return SignerData{}, errors.New("tx must have at least one signer")
+	}
+
+	return sigs[0], nil
+}
@@ -0,0 +1,184 @@
+package mempool_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+var _ sdk.TxWithTimeoutHeight = (*timeoutTestTx)(nil)
+
+// timeoutTestTx is a testTx with a timeout height.
+type timeoutTestTx struct {
+	testTx
+	timeoutHeight uint64
+}
+
+func (tx timeoutTestTx) GetTimeoutHeight() uint64 { return tx.timeoutHeight }
+
+type headerInfoKey struct{}
+
+// recheckHeaderService returns the header stored in a context by
+// newRecheckContext, so that the mempool is exercised without an sdk.Context.
+type recheckHeaderService struct{}
+
+func (recheckHeaderService) HeaderInfo(ctx context.Context) header.Info {
+	return ctx.Value(headerInfoKey{}).(header.Info)
+}
+
+func newRecheckContext(height int64, blockTime time.Time) context.Context {
+	return context.WithValue(context.Background(), headerInfoKey{}, header.Info{Height: height, Time: blockTime})
+}
+
+func TestEvictingMempool_Recheck(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	start := time.Unix(1_000_000, 0)
+
+	tests := []struct {
+		name      string
+		cfg       mempool.EvictionConfig
+		txs       []sdk.Tx
+		ctx       context.Context
+		remaining []int
+	}{
+		{
+			name: "nothing stale",
+			cfg:  mempool.EvictionConfig{TxTTL: time.Minute, TxTTLBlocks: 10},
+			txs: []sdk.Tx{
+				testTx{id: 0, nonce: 0, address: sa},
+				timeoutTestTx{testTx: testTx{id: 1, nonce: 0, address: sb}, timeoutHeight: 5},
+			},
+			ctx:       newRecheckContext(4, start.Add(time.Second)),
+			remaining: []int{0, 1},
+		},
+		{
+			name: "timeout height passed",
+			txs: []sdk.Tx{
+				testTx{id: 0, nonce: 0, address: sa},
+				timeoutTestTx{testTx: testTx{id: 1, nonce: 0, address: sb}, timeoutHeight: 5},
+			},
+			ctx:       newRecheckContext(5, start),
+			remaining: []int{0},
+		},
+		{
+			name: "expired by height",
+			cfg:  mempool.EvictionConfig{TxTTLBlocks: 3},
+			txs: []sdk.Tx{
+				testTx{id: 0, nonce: 0, address: sa},
+			},
+			ctx:       newRecheckContext(4, start),
+			remaining: nil,
+		},
+		{
+			name: "expired by time",
+			cfg:  mempool.EvictionConfig{TxTTL: time.Minute},
+			txs: []sdk.Tx{
+				testTx{id: 0, nonce: 0, address: sa},
+			},
+			ctx:       newRecheckContext(2, start.Add(time.Minute)),
+			remaining: nil,
+		},
+		{
+			name: "sequence consumed",
+			cfg: mempool.EvictionConfig{
+				AccountSequence: func(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+					if addr.Equals(sa) {
+						return 2, nil
+					}
+					return 0, nil
+				},
+			},
+			txs: []sdk.Tx{
+				testTx{id: 0, nonce: 1, address: sa},
+				testTx{id: 1, nonce: 2, address: sa},
+				testTx{id: 2, nonce: 0, address: sb},
+			},
+			ctx:       newRecheckContext(2, start),
+			remaining: []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.HeaderService = recheckHeaderService{}
+			mp := mempool.NewEvictingMempool(mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)), tt.cfg)
+			ctx := newRecheckContext(1, start)
+			for _, tx := range tt.txs {
+				require.NoError(t, mp.Insert(ctx, tx))
+			}
+
+			require.NoError(t, mp.Recheck(tt.ctx))
+			require.Equal(t, len(tt.remaining), mp.CountTx())
+
+			var remaining []int
+			for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+				switch tx := iter.Tx().(type) {
+				case testTx:
+					remaining = append(remaining, tx.id)
+				case timeoutTestTx:
+					remaining = append(remaining, tx.id)
+				}
+			}
+			require.ElementsMatch(t, tt.remaining, remaining)
+		})
+	}
+}
+
+func TestEvictingMempool_RecheckError(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	errSequence := errors.New("sequence error")
+
+	mp := mempool.NewEvictingMempool(mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)), mempool.EvictionConfig{
+		HeaderService: recheckHeaderService{},
+		AccountSequence: func(context.Context, sdk.AccAddress) (uint64, error) {
+			return 0, errSequence
+		},
+	})
+	ctx := newRecheckContext(1, time.Now())
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 0, address: accounts[0].Address}))
+	require.ErrorIs(t, mp.Recheck(ctx), errSequence)
+	require.Equal(t, 1, mp.CountTx())
+
+	// removed txs are not rechecked anymore
+	require.NoError(t, mp.Remove(testTx{nonce: 0, address: accounts[0].Address}))
+	require.NoError(t, mp.Recheck(ctx))
+}
+
+// noSignerExtractionAdapter returns no signer for any tx.
+type noSignerExtractionAdapter struct{}
+
+func (noSignerExtractionAdapter) GetSigners(sdk.Tx) ([]mempool.SignerData, error) {
+	return nil, nil
+}
+
+func TestEvictingMempool_InsertWithoutSigner(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	mp := mempool.NewEvictingMempool(mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)), mempool.EvictionConfig{
+		HeaderService:   recheckHeaderService{},
+		SignerExtractor: noSignerExtractionAdapter{},
+	})
+
+	// a tx which can't be tracked for eviction is not inserted
+	ctx := newRecheckContext(1, time.Now())
+	require.Error(t, mp.Insert(ctx, testTx{nonce: 0, address: accounts[0].Address}))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestNewEvictingMempool_NoHeaderService(t *testing.T) {
+	require.Panics(t, func() {
+		mempool.NewEvictingMempool(mempool.NewSenderNonceMempool(), mempool.EvictionConfig{})
+	})
+}
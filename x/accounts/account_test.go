@@ -16,7 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ implementation.Account = (*TestAccount)(nil)
+var _ implementation.MigratableAccount = (*TestAccount)(nil)
 
 func NewTestAccount(d accountstd.Dependencies) (*TestAccount, error) {
 	return &TestAccount{
@@ -39,6 +39,19 @@ func (t TestAccount) RegisterInitHandler(builder *implementation.InitBuilder) {
 	})
 }
 
+// migration testing, the account reads the counter left by the previous account type
+// and can only be migrated to test-v2.
+func (t TestAccount) RegisterMigrateHandler(builder *implementation.MigrateBuilder) {
+	builder.AllowMigrationTo("test-v2")
+	implementation.RegisterMigrateHandler(builder, func(ctx context.Context, req *types.UInt64Value) (*types.UInt64Value, error) {
+		v, err := t.Counter.Peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UInt64Value{Value: v}, t.Counter.Set(ctx, v+req.Value)
+	})
+}
+
 func (t TestAccount) RegisterExecuteHandlers(builder *implementation.ExecuteBuilder) {
 	implementation.RegisterExecuteHandler(builder, func(_ context.Context, _ *types.Empty) (*types.Empty, error) {
 		return &types.Empty{}, nil
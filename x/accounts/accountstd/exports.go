@@ -27,6 +27,12 @@ type QueryBuilder = implementation.QueryBuilder
 // InitBuilder is the exported type of InitBuilder.
 type InitBuilder = implementation.InitBuilder
 
+// MigrateBuilder is the exported type of MigrateBuilder.
+type MigrateBuilder = implementation.MigrateBuilder
+
+// MigratableInterface is the exported interface of an Account which takes part in migrations.
+type MigratableInterface = implementation.MigratableAccount
+
 // AccountCreatorFunc is the exported type of AccountCreatorFunc.
 type AccountCreatorFunc = implementation.AccountCreatorFunc
 
@@ -56,6 +62,14 @@ func RegisterInitHandler[
 	implementation.RegisterInitHandler(router, handler)
 }
 
+// RegisterMigrateHandler registers a migration handler for a smart account that uses protobuf.
+func RegisterMigrateHandler[
+	Req any, ProtoReq implementation.ProtoMsgG[Req], Resp any, ProtoResp implementation.ProtoMsgG[Resp],
+](router *MigrateBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterMigrateHandler(router, handler)
+}
+
 // AddAccount is a helper function to add a smart account to the list of smart accounts.
 func AddAccount[A Interface](name string, constructor func(deps Dependencies) (A, error)) AccountCreatorFunc {
 	return func(deps implementation.Dependencies) (string, implementation.Account, error) {
@@ -91,6 +105,13 @@ func SenderIsAccountsModule(ctx context.Context) bool {
 // returns nil.
 func Funds(ctx context.Context) sdk.Coins { return implementation.Funds(ctx) }
 
+// ClearState removes the state of the account stored under the prefix, or all of it if
+// the prefix is empty. Migration handlers use it to remove the state of the previous
+// account type which is not used anymore.
+func ClearState(ctx context.Context, prefix []byte) error {
+	return implementation.ClearState(ctx, prefix)
+}
+
 func ExecModule(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
 	return implementation.ExecModule(ctx, msg)
 }
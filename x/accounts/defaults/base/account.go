@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
@@ -85,7 +86,7 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, err
 	}
 
-	txData, err := a.getTxData(msg)
+	txData, err := GetTxData(msg)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +96,7 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
 	}
 
-	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	signMode, err := ParseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
 	}
@@ -114,7 +115,8 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
 }
 
-func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+// ParseSignMode returns the sign mode of a signer, only the single sign mode is accepted.
+func ParseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 	single, ok := info.Sum.(*tx.ModeInfo_Single_)
 	if !ok {
 		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
@@ -124,37 +126,46 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 
 // computeSignerData will populate signer data and also increase the sequence.
 func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
-	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
 		return secp256k1.PubKey{}, signing.SignerData{}, err
 	}
-	chainID := a.hs.HeaderInfo(ctx).ChainID
 
-	wantSequence, err := a.Sequence.Next(ctx)
+	pk, err := a.PubKey.Get(ctx)
 	if err != nil {
 		return secp256k1.PubKey{}, signing.SignerData{}, err
 	}
 
-	pk, err := a.PubKey.Get(ctx)
+	signerData, err := NewSignerData(ctx, a.addrCodec, a.hs.HeaderInfo(ctx).ChainID, wantSequence, &pk)
 	if err != nil {
 		return secp256k1.PubKey{}, signing.SignerData{}, err
 	}
+	return pk, signerData, nil
+}
+
+// NewSignerData returns the signer data of the account executing the message,
+// which signs with the given pubkey and sequence.
+func NewSignerData(ctx context.Context, addrCodec address.Codec, chainID string, sequence uint64, pk cryptotypes.PubKey) (signing.SignerData, error) {
+	addrStr, err := addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
 
-	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	pkAny, err := codectypes.NewAnyWithValue(pk)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
-	accNum, err := a.getNumber(ctx, addrStr)
+	accNum, err := GetAccountNumber(ctx, addrStr)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
-	return pk, signing.SignerData{
+	return signing.SignerData{
 		Address:       addrStr,
 		ChainID:       chainID,
 		AccountNumber: accNum,
-		Sequence:      wantSequence,
+		Sequence:      sequence,
 		PubKey: &anypb.Any{
 			TypeUrl: pkAny.TypeUrl,
 			Value:   pkAny.Value,
@@ -162,7 +173,8 @@ func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signi
 	}, nil
 }
 
-func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+// GetAccountNumber returns the account number of an account from the accounts module.
+func GetAccountNumber(ctx context.Context, addrStr string) (uint64, error) {
 	accNum, err := accountstd.QueryModule(ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
 	if err != nil {
 		return 0, err
@@ -176,7 +188,8 @@ func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error)
 	return resp.Number, nil
 }
 
-func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+// GetTxData returns the data of the transaction to authenticate which is signed.
+func GetTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
 	// TODO: add a faster way to do this, we can avoid unmarshalling but we need
 	// to write a function that converts this into the protov2 counterparty.
 	txBody := new(txv1beta1.TxBody)
@@ -16,7 +16,8 @@ import (
 
 // Compile-time type assertions
 var (
-	_ accountstd.Interface = (*ContinuousLockingAccount)(nil)
+	_ accountstd.Interface           = (*ContinuousLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*ContinuousLockingAccount)(nil)
 )
 
 // NewContinuousLockingAccount creates a new ContinuousLockingAccount object.
@@ -201,3 +202,7 @@ func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 func (cva ContinuousLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, cva.QueryLockupAccountInfo)
 }
+
+func (cva ContinuousLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	builder.AllowMigrationTo(CONTINUOUS_LOCKING_ACCOUNT_V2)
+}
@@ -234,3 +234,35 @@ func TestContinousAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestContinousAccountMigrate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinousAccount(t, sdkCtx, ss)
+
+	// the bond denom changed since the account creation, so the delegations
+	// of the bond denom are not tracked
+	require.NoError(t, acc.DelegatedFree.Remove(sdkCtx, "test"))
+	require.NoError(t, acc.DelegatedLocking.Remove(sdkCtx, "test"))
+	delegate := &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(1)),
+	}
+	_, err := acc.Delegate(sdkCtx, delegate)
+	require.Error(t, err)
+
+	// the migration initializes the tracking of the bond denom
+	_, err = acc.Migrate(sdkCtx, &lockuptypes.MsgMigrateLockupAccount{})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(sdkCtx, delegate)
+	require.NoError(t, err)
+
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.Equal(math.NewInt(1)))
+}
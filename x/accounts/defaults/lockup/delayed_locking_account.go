@@ -14,7 +14,8 @@ import (
 
 // Compile-time type assertions
 var (
-	_ accountstd.Interface = (*DelayedLockingAccount)(nil)
+	_ accountstd.Interface           = (*DelayedLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*DelayedLockingAccount)(nil)
 )
 
 // NewDelayedLockingAccount creates a new DelayedLockingAccount object.
@@ -150,3 +151,7 @@ func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.Exe
 func (dva DelayedLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, dva.QueryVestingAccountInfo)
 }
+
+func (dva DelayedLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	builder.AllowMigrationTo(DELAYED_LOCKING_ACCOUNT_V2)
+}
@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
 	banktypes "cosmossdk.io/x/bank/types"
 	distrtypes "cosmossdk.io/x/distribution/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
@@ -42,6 +43,14 @@ var (
 	DELAYED_LOCKING_ACCOUNT    = "delayed-locking-account"
 	PERIODIC_LOCKING_ACCOUNT   = "periodic-locking-account"
 	PERMANENT_LOCKING_ACCOUNT  = "permanent-locking-account"
+
+	// v2 lockup accounts, which the lockup accounts of the same kind can be migrated to.
+	// They are not registered by default: a chain shipping a fixed lockup account registers
+	// it under these names, see x/accounts/testing/lockupv2 for an example.
+	CONTINUOUS_LOCKING_ACCOUNT_V2 = "continuous-locking-account-v2"
+	DELAYED_LOCKING_ACCOUNT_V2    = "delayed-locking-account-v2"
+	PERIODIC_LOCKING_ACCOUNT_V2   = "periodic-locking-account-v2"
+	PERMANENT_LOCKING_ACCOUNT_V2  = "permanent-locking-account-v2"
 )
 
 type getLockedCoinsFunc = func(ctx context.Context, time time.Time, denoms ...string) (sdk.Coins, error)
@@ -128,6 +137,40 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
 }
 
+// Migrate can be used as the migration handler of the v2 lockup accounts which keep the
+// state layout of the lockup accounts they are migrated from. It checks the lockup state of
+// the account and initializes the delegation tracking of the current bond denom, which is
+// missing when the bond denom changed since the account creation.
+func (bva *BaseLockup) Migrate(ctx context.Context, _ *lockuptypes.MsgMigrateLockupAccount) (
+	*lockuptypes.MsgMigrateLockupAccountResponse, error,
+) {
+	if _, err := bva.Owner.Get(ctx); err != nil {
+		return nil, fmt.Errorf("account is not a lockup account, owner: %w", err)
+	}
+	if _, err := bva.EndTime.Get(ctx); err != nil {
+		return nil, fmt.Errorf("account is not a lockup account, end time: %w", err)
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tracking := range []collections.Map[string, math.Int]{bva.DelegatedFree, bva.DelegatedLocking} {
+		has, err := tracking.Has(ctx, bondDenom)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			continue
+		}
+		if err := tracking.Set(ctx, bondDenom, math.ZeroInt()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgMigrateLockupAccountResponse{}, nil
+}
+
 func (bva *BaseLockup) Delegate(
 	ctx context.Context, msg *lockuptypes.MsgDelegate, getLockedCoinsFunc getLockedCoinsFunc,
 ) (
@@ -277,6 +320,36 @@ func (bva *BaseLockup) SendCoins(
 	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
 }
 
+// MigrateAccount allows the owner to migrate the lockup account to another account type,
+// which the lockup account type must allow.
+func (bva *BaseLockup) MigrateAccount(
+	ctx context.Context, msg *lockuptypes.MsgMigrate,
+) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	err := bva.checkSender(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	whoami := accountstd.Whoami(ctx)
+	self, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	msgMigrate := &accountsv1.MsgMigrate{
+		Sender:      self,
+		AccountType: msg.AccountType,
+		Message:     msg.Message,
+	}
+	resp, err := sendMessage(ctx, msgMigrate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
+}
+
 // WithdrawUnlockedCoins allow owner to withdraw the unlocked token for a specific denoms to an
 // account of choice. Update the withdrawed token tracking for lockup account
 func (bva *BaseLockup) WithdrawUnlockedCoins(
@@ -678,4 +751,5 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 func (bva BaseLockup) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, bva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, bva.WithdrawReward)
+	accountstd.RegisterExecuteHandler(builder, bva.MigrateAccount)
 }
@@ -278,3 +278,58 @@ func TestQueryLockupAccountBaseInfo(t *testing.T) {
 	require.Equal(t, res.Owner, "owner")
 	require.NoError(t, err)
 }
+
+func TestMigrateAccount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseLockup := setup(t, ctx, ss)
+
+	testcases := []struct {
+		name   string
+		msg    lockuptypes.MsgMigrate
+		expErr bool
+	}{
+		{
+			"sender is not the owner",
+			lockuptypes.MsgMigrate{
+				Sender:      "sender",
+				AccountType: CONTINUOUS_LOCKING_ACCOUNT_V2,
+			},
+			true,
+		},
+		{
+			"successfully migrate",
+			lockuptypes.MsgMigrate{
+				Sender:      "owner",
+				AccountType: CONTINUOUS_LOCKING_ACCOUNT_V2,
+			},
+			false,
+		},
+	}
+
+	for _, test := range testcases {
+		_, err := baseLockup.MigrateAccount(ctx, &test.msg)
+		if test.expErr {
+			require.Error(t, err, test.name)
+			continue
+		}
+		require.NoError(t, err, test.name)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	// an account without lockup state cannot be migrated
+	ctx, ss := newMockContext(t)
+	baseLockup := newBaseLockup(makeMockDependencies(ss))
+	_, err := baseLockup.Migrate(ctx, &lockuptypes.MsgMigrateLockupAccount{})
+	require.ErrorContains(t, err, "account is not a lockup account")
+
+	// the tracking of the delegations is kept
+	baseLockup = setup(t, ctx, ss)
+	require.NoError(t, baseLockup.DelegatedFree.Set(ctx, "test", math.NewInt(5)))
+	_, err = baseLockup.Migrate(ctx, &lockuptypes.MsgMigrateLockupAccount{})
+	require.NoError(t, err)
+
+	delFree, err := baseLockup.DelegatedFree.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delFree.Equal(math.NewInt(5)))
+}
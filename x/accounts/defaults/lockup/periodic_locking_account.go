@@ -17,7 +17,10 @@ import (
 )
 
 // Compile-time type assertions
-var _ accountstd.Interface = (*PeriodicLockingAccount)(nil)
+var (
+	_ accountstd.Interface           = (*PeriodicLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*PeriodicLockingAccount)(nil)
+)
 
 // NewPeriodicLockingAccount creates a new PeriodicLockingAccount object.
 func NewPeriodicLockingAccount(d accountstd.Dependencies) (*PeriodicLockingAccount, error) {
@@ -344,3 +347,7 @@ func (pva PeriodicLockingAccount) RegisterQueryHandlers(builder *accountstd.Quer
 	accountstd.RegisterQueryHandler(builder, pva.QueryLockupAccountInfo)
 	accountstd.RegisterQueryHandler(builder, pva.QueryLockingPeriods)
 }
+
+func (pva PeriodicLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	builder.AllowMigrationTo(PERIODIC_LOCKING_ACCOUNT_V2)
+}
@@ -13,7 +13,8 @@ import (
 
 // Compile-time type assertions
 var (
-	_ accountstd.Interface = (*PermanentLockingAccount)(nil)
+	_ accountstd.Interface           = (*PermanentLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*PermanentLockingAccount)(nil)
 )
 
 // NewPermanentLockingAccount creates a new PermanentLockingAccount object.
@@ -103,8 +104,13 @@ func (plva PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 	accountstd.RegisterExecuteHandler(builder, plva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, plva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, plva.WithdrawReward)
+	accountstd.RegisterExecuteHandler(builder, plva.MigrateAccount)
 }
 
 func (plva PermanentLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, plva.QueryLockupAccountInfo)
 }
+
+func (plva PermanentLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	builder.AllowMigrationTo(PERMANENT_LOCKING_ACCOUNT_V2)
+}
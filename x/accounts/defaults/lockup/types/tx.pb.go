@@ -237,6 +237,82 @@ func (m *MsgInitPeriodicLockingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInitPeriodicLockingAccountResponse proto.InternalMessageInfo
 
+// MsgMigrateLockupAccount defines the message sent to the migration handler of the
+// v2 lockup accounts, when a lockup account is migrated to them.
+type MsgMigrateLockupAccount struct {
+}
+
+func (m *MsgMigrateLockupAccount) Reset()         { *m = MsgMigrateLockupAccount{} }
+func (m *MsgMigrateLockupAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockupAccount) ProtoMessage()    {}
+func (*MsgMigrateLockupAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{4}
+}
+func (m *MsgMigrateLockupAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockupAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockupAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockupAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockupAccount.Merge(m, src)
+}
+func (m *MsgMigrateLockupAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockupAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockupAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockupAccount proto.InternalMessageInfo
+
+// MsgMigrateLockupAccountResponse defines the response type of the migration handler
+// of the v2 lockup accounts.
+type MsgMigrateLockupAccountResponse struct {
+}
+
+func (m *MsgMigrateLockupAccountResponse) Reset()         { *m = MsgMigrateLockupAccountResponse{} }
+func (m *MsgMigrateLockupAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockupAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateLockupAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{5}
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockupAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockupAccountResponse.Merge(m, src)
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockupAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockupAccountResponse proto.InternalMessageInfo
+
 // MsgDelegate defines a message that enable lockup account to execute delegate message
 type MsgDelegate struct {
 	// sender is the owner of the lockup account
@@ -249,7 +325,7 @@ func (m *MsgDelegate) Reset()         { *m = MsgDelegate{} }
 func (m *MsgDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegate) ProtoMessage()    {}
 func (*MsgDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{4}
+	return fileDescriptor_e5f39108a4d67f92, []int{6}
 }
 func (m *MsgDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +365,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{5}
+	return fileDescriptor_e5f39108a4d67f92, []int{7}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +404,7 @@ func (m *MsgWithdrawReward) Reset()         { *m = MsgWithdrawReward{} }
 func (m *MsgWithdrawReward) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawReward) ProtoMessage()    {}
 func (*MsgWithdrawReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{6}
+	return fileDescriptor_e5f39108a4d67f92, []int{8}
 }
 func (m *MsgWithdrawReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +444,7 @@ func (m *MsgSend) Reset()         { *m = MsgSend{} }
 func (m *MsgSend) String() string { return proto.CompactTextString(m) }
 func (*MsgSend) ProtoMessage()    {}
 func (*MsgSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{7}
+	return fileDescriptor_e5f39108a4d67f92, []int{9}
 }
 func (m *MsgSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,6 +473,50 @@ func (m *MsgSend) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSend proto.InternalMessageInfo
 
+// MsgMigrate defines a message that enable lockup account to migrate itself to another
+// account type
+type MsgMigrate struct {
+	// sender is the owner of the lockup account
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// account_type is the account type to migrate the lockup account to
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// message is the message sent to the migration handler of the new account type
+	Message *any.Any `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *MsgMigrate) Reset()         { *m = MsgMigrate{} }
+func (m *MsgMigrate) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrate) ProtoMessage()    {}
+func (*MsgMigrate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{10}
+}
+func (m *MsgMigrate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrate.Merge(m, src)
+}
+func (m *MsgMigrate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrate proto.InternalMessageInfo
+
 // MsgExecuteMessagesResponse defines the response for lockup execute operations
 type MsgExecuteMessagesResponse struct {
 	Responses []*any.Any `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
@@ -406,7 +526,7 @@ func (m *MsgExecuteMessagesResponse) Reset()         { *m = MsgExecuteMessagesRe
 func (m *MsgExecuteMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteMessagesResponse) ProtoMessage()    {}
 func (*MsgExecuteMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{8}
+	return fileDescriptor_e5f39108a4d67f92, []int{11}
 }
 func (m *MsgExecuteMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +574,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{9}
+	return fileDescriptor_e5f39108a4d67f92, []int{12}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +613,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{10}
+	return fileDescriptor_e5f39108a4d67f92, []int{13}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,10 +661,13 @@ func init() {
 	proto.RegisterType((*MsgInitLockupAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitLockupAccountResponse")
 	proto.RegisterType((*MsgInitPeriodicLockingAccount)(nil), "cosmos.accounts.defaults.lockup.MsgInitPeriodicLockingAccount")
 	proto.RegisterType((*MsgInitPeriodicLockingAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitPeriodicLockingAccountResponse")
+	proto.RegisterType((*MsgMigrateLockupAccount)(nil), "cosmos.accounts.defaults.lockup.MsgMigrateLockupAccount")
+	proto.RegisterType((*MsgMigrateLockupAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgMigrateLockupAccountResponse")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.accounts.defaults.lockup.MsgDelegate")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.accounts.defaults.lockup.MsgUndelegate")
 	proto.RegisterType((*MsgWithdrawReward)(nil), "cosmos.accounts.defaults.lockup.MsgWithdrawReward")
 	proto.RegisterType((*MsgSend)(nil), "cosmos.accounts.defaults.lockup.MsgSend")
+	proto.RegisterType((*MsgMigrate)(nil), "cosmos.accounts.defaults.lockup.MsgMigrate")
 	proto.RegisterType((*MsgExecuteMessagesResponse)(nil), "cosmos.accounts.defaults.lockup.MsgExecuteMessagesResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "cosmos.accounts.defaults.lockup.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "cosmos.accounts.defaults.lockup.MsgWithdrawResponse")
@@ -555,58 +678,62 @@ func init() {
 }
 
 var fileDescriptor_e5f39108a4d67f92 = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0xaa, 0xc1, 0x43, 0x81, 0xb2, 0x58, 0xc5, 0x58, 0x65, 0x97, 0x5a, 0x42, 0x58,
-	0x56, 0xbd, 0x5b, 0x68, 0xa5, 0x56, 0x56, 0x2f, 0xb8, 0xf4, 0x97, 0x54, 0x57, 0xc8, 0xf4, 0x87,
-	0xd4, 0x1c, 0xac, 0xf1, 0xee, 0x30, 0xac, 0xf0, 0xce, 0x58, 0x3b, 0x63, 0x1b, 0xdf, 0xa2, 0x28,
-	0x87, 0x88, 0x13, 0xe7, 0x9c, 0x38, 0x45, 0x11, 0x27, 0x47, 0xe2, 0x8f, 0xe0, 0x88, 0x38, 0x71,
-	0x0a, 0x91, 0x89, 0x64, 0xfe, 0x8c, 0x68, 0x77, 0x66, 0x89, 0x0d, 0x04, 0x1c, 0x1f, 0x12, 0x29,
-	0x17, 0xef, 0xcc, 0xbc, 0xef, 0xbd, 0xf7, 0xbd, 0x6f, 0xde, 0xdb, 0x35, 0xc8, 0x5a, 0x94, 0xb9,
-	0x94, 0x99, 0xd0, 0xb2, 0x68, 0x83, 0x70, 0x66, 0xda, 0x68, 0x0b, 0x36, 0x6a, 0x9c, 0x99, 0x35,
-	0x6a, 0xed, 0x34, 0xea, 0x26, 0xdf, 0x35, 0xea, 0x1e, 0xe5, 0x54, 0xd5, 0x05, 0xd2, 0x08, 0x91,
-	0x46, 0x88, 0x34, 0x04, 0x32, 0x3d, 0x03, 0x5d, 0x87, 0x50, 0x33, 0xf8, 0x15, 0x3e, 0x69, 0x4d,
-	0x46, 0xaf, 0x42, 0x86, 0xcc, 0xe6, 0x4a, 0x15, 0x71, 0xb8, 0x62, 0x5a, 0xd4, 0x21, 0xd2, 0xfe,
-	0xcd, 0x7d, 0xd9, 0xc5, 0x43, 0xa2, 0xe7, 0x24, 0xda, 0x65, 0xd8, 0x6c, 0xae, 0xf8, 0x0f, 0x69,
-	0x98, 0x17, 0x86, 0x4a, 0xb0, 0x33, 0x25, 0x4f, 0x61, 0x4a, 0x62, 0x8a, 0xa9, 0x38, 0xf7, 0x57,
-	0xa1, 0x03, 0xa6, 0x14, 0xd7, 0x90, 0x19, 0xec, 0xaa, 0x8d, 0x2d, 0x13, 0x92, 0xb6, 0x34, 0xe9,
-	0xd7, 0x4d, 0xdc, 0x71, 0x11, 0xe3, 0xd0, 0x95, 0x2c, 0x32, 0x0f, 0xa3, 0x20, 0x59, 0x62, 0xf8,
-	0x0f, 0xe2, 0xf0, 0x3f, 0x03, 0x76, 0x6b, 0x82, 0xbc, 0x6a, 0x80, 0xcf, 0x68, 0x8b, 0x20, 0x2f,
-	0xa5, 0x2c, 0x2a, 0xd9, 0x44, 0x31, 0x75, 0x7a, 0x94, 0x4f, 0x4a, 0x2e, 0x6b, 0xb6, 0xed, 0x21,
-	0xc6, 0x36, 0xb9, 0xe7, 0x10, 0x5c, 0x16, 0x30, 0x75, 0x1d, 0x8c, 0x23, 0x62, 0x57, 0xfc, 0xf8,
-	0xa9, 0xe8, 0xa2, 0x92, 0x9d, 0x58, 0x4d, 0x1b, 0x22, 0xb9, 0x11, 0x26, 0x37, 0xfe, 0x0e, 0x93,
-	0x17, 0x27, 0x8f, 0x5f, 0xea, 0x91, 0xfd, 0x73, 0x5d, 0x79, 0xde, 0xeb, 0xe4, 0x94, 0xf2, 0x18,
-	0x22, 0xb6, 0x6f, 0x54, 0x7f, 0x07, 0x80, 0x71, 0xe8, 0x71, 0x11, 0x27, 0xf6, 0xbe, 0x71, 0x12,
-	0x81, 0xb3, 0x6f, 0x2e, 0x64, 0x2f, 0x0f, 0x74, 0x65, 0xaf, 0xd7, 0xc9, 0xc9, 0x9b, 0xce, 0x33,
-	0x7b, 0xc7, 0xbc, 0xad, 0xd2, 0x8c, 0x06, 0xbe, 0xba, 0xed, 0xbc, 0x8c, 0x58, 0x9d, 0x12, 0x86,
-	0x32, 0xcf, 0xa2, 0x60, 0x41, 0x02, 0x36, 0x90, 0xe7, 0x50, 0xdb, 0xb1, 0x7c, 0xa0, 0x43, 0xf0,
-	0xa8, 0x5a, 0x0d, 0x56, 0x19, 0x1d, 0xbd, 0x4a, 0xf5, 0x01, 0x98, 0xae, 0x09, 0x2e, 0x95, 0x7a,
-	0xc0, 0x8d, 0xa5, 0x62, 0x8b, 0xb1, 0xec, 0xc4, 0xea, 0xb2, 0x71, 0x4f, 0x83, 0x1b, 0xa2, 0x96,
-	0x62, 0xc2, 0x8f, 0x2d, 0xe2, 0x4e, 0xc9, 0x50, 0xc2, 0xc2, 0x0a, 0xc6, 0xe5, 0x81, 0x1e, 0xf1,
-	0x25, 0x5c, 0xba, 0x29, 0xa1, 0xc0, 0x0c, 0x0a, 0xb9, 0x0c, 0x96, 0xee, 0xd4, 0xe9, 0x4a, 0xd1,
-	0xae, 0x02, 0x26, 0x4a, 0x0c, 0xaf, 0xa3, 0x1a, 0xc2, 0x90, 0x23, 0xf5, 0x5b, 0x10, 0x67, 0x88,
-	0xd8, 0x43, 0x08, 0x28, 0x71, 0xea, 0x5f, 0x60, 0xa6, 0x09, 0x6b, 0x8e, 0x0d, 0x39, 0xf5, 0x2a,
-	0x50, 0x40, 0x02, 0x21, 0x13, 0xc5, 0xaf, 0x4f, 0x8f, 0xf2, 0x0b, 0xd2, 0xf9, 0xdf, 0x10, 0x33,
-	0x18, 0xe5, 0x8b, 0xe6, 0xb5, 0x73, 0xf5, 0x27, 0x10, 0x87, 0xae, 0xcf, 0x51, 0xf6, 0xdc, 0x7c,
-	0x28, 0x9f, 0x3f, 0xeb, 0x86, 0x9c, 0x75, 0xe3, 0x67, 0xea, 0x90, 0x7e, 0xc1, 0xa4, 0x4f, 0x61,
-	0xf6, 0xc9, 0x81, 0x1e, 0xf1, 0xc5, 0x7a, 0xd4, 0xeb, 0xe4, 0x24, 0xc5, 0xcc, 0x6b, 0x05, 0x4c,
-	0x96, 0x18, 0xfe, 0x87, 0xd8, 0x9f, 0x74, 0x99, 0x87, 0x0a, 0x98, 0x29, 0x31, 0xfc, 0x9f, 0xc3,
-	0xb7, 0x6d, 0x0f, 0xb6, 0xca, 0xa8, 0x05, 0x3d, 0xfb, 0xe3, 0x97, 0x7a, 0x3b, 0xd9, 0xc7, 0x51,
-	0x30, 0x56, 0x62, 0x78, 0x13, 0x91, 0x51, 0x28, 0xfe, 0x00, 0x00, 0xa7, 0xd7, 0xb8, 0xbd, 0xdb,
-	0x2b, 0xc1, 0x69, 0x28, 0x7b, 0xbb, 0x4f, 0xf6, 0xd8, 0xdd, 0xb2, 0xff, 0xea, 0xcb, 0x7e, 0x78,
-	0xae, 0x67, 0xb1, 0xc3, 0xb7, 0x1b, 0x55, 0xc3, 0xa2, 0xae, 0xfc, 0x04, 0x98, 0x7d, 0x43, 0xc8,
-	0xdb, 0x75, 0xc4, 0x02, 0x07, 0xf6, 0xb4, 0xd7, 0xc9, 0x7d, 0xee, 0x37, 0x98, 0xd5, 0xae, 0xf8,
-	0xdf, 0x22, 0x36, 0xc4, 0x9d, 0x6d, 0x80, 0x74, 0x89, 0xe1, 0x5f, 0x76, 0x91, 0xd5, 0xe0, 0xa8,
-	0x84, 0x18, 0x83, 0x18, 0xb1, 0x70, 0x3a, 0xd5, 0x55, 0x90, 0xf0, 0xe4, 0x9a, 0xa5, 0x94, 0x80,
-	0x70, 0xf2, 0xc6, 0xcb, 0x69, 0x8d, 0xb4, 0xcb, 0x6f, 0x61, 0x99, 0x17, 0x62, 0xa2, 0xc3, 0x2e,
-	0x50, 0x7f, 0x04, 0xa0, 0x25, 0xd7, 0x43, 0x08, 0xdc, 0x87, 0x1d, 0x5d, 0xe4, 0x2f, 0x41, 0xdc,
-	0x46, 0x84, 0xba, 0xe2, 0x0d, 0x98, 0x28, 0xcb, 0x5d, 0x61, 0xae, 0x5f, 0x81, 0xbe, 0x4c, 0x99,
-	0x33, 0x05, 0xcc, 0x0e, 0x74, 0xae, 0xac, 0xff, 0x7b, 0x30, 0xee, 0x21, 0x0b, 0x39, 0xcd, 0x21,
-	0x98, 0x5f, 0x21, 0xd5, 0x3d, 0x05, 0x4c, 0x0b, 0xcd, 0x2b, 0xf2, 0xcc, 0x4e, 0x45, 0x3f, 0xd4,
-	0x6d, 0x4f, 0x89, 0xcc, 0x65, 0x99, 0xb8, 0xf8, 0xdb, 0x71, 0x57, 0x53, 0x4e, 0xba, 0x9a, 0xf2,
-	0xaa, 0xab, 0x29, 0xfb, 0x17, 0x5a, 0xe4, 0xe4, 0x42, 0x8b, 0x9c, 0x5d, 0x68, 0x91, 0xff, 0xf3,
-	0x22, 0x2e, 0xb3, 0x77, 0x0c, 0x87, 0x9a, 0xbb, 0x77, 0xfc, 0x53, 0xf2, 0x93, 0x56, 0xe3, 0xc1,
-	0x85, 0x7f, 0xf7, 0x26, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xb6, 0xac, 0x3d, 0x59, 0x09, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6b, 0x1b, 0x47,
+	0x14, 0xd7, 0x48, 0xd4, 0x8e, 0x9e, 0xf3, 0x51, 0x6f, 0x4c, 0x2d, 0x9b, 0x66, 0xd7, 0x16, 0x84,
+	0x08, 0x53, 0xcf, 0xd6, 0x6e, 0xa1, 0xc5, 0xf4, 0x62, 0x35, 0xfd, 0x82, 0x6e, 0x09, 0x9b, 0xb4,
+	0x85, 0xf6, 0x20, 0x46, 0xbb, 0x93, 0xc9, 0x62, 0xed, 0x8c, 0xd8, 0x19, 0xc9, 0xd6, 0xad, 0x94,
+	0x1e, 0x4a, 0x4e, 0x39, 0xf7, 0xe4, 0x53, 0x28, 0x39, 0xb9, 0x90, 0x3f, 0x22, 0xc7, 0x90, 0x53,
+	0x4e, 0x4d, 0xb1, 0x0b, 0xce, 0x9f, 0x51, 0x66, 0x67, 0xd6, 0x91, 0x1c, 0x7f, 0xa8, 0x3a, 0xb4,
+	0x90, 0x8b, 0x76, 0x77, 0xde, 0xef, 0xbd, 0xf7, 0x7b, 0xbf, 0x79, 0xf3, 0x46, 0xd0, 0x88, 0x84,
+	0x4c, 0x85, 0xf4, 0x49, 0x14, 0x89, 0x1e, 0x57, 0xd2, 0x8f, 0xe9, 0x5d, 0xd2, 0xeb, 0x28, 0xe9,
+	0x77, 0x44, 0xb4, 0xd5, 0xeb, 0xfa, 0x6a, 0x07, 0x77, 0x33, 0xa1, 0x84, 0xe3, 0x19, 0x24, 0x2e,
+	0x90, 0xb8, 0x40, 0x62, 0x83, 0x5c, 0x9c, 0x25, 0x69, 0xc2, 0x85, 0x9f, 0xff, 0x1a, 0x9f, 0x45,
+	0xd7, 0x46, 0x6f, 0x13, 0x49, 0xfd, 0xfe, 0x5a, 0x9b, 0x2a, 0xb2, 0xe6, 0x47, 0x22, 0xe1, 0xd6,
+	0xfe, 0xde, 0x79, 0xd9, 0xcd, 0xc3, 0xa2, 0xe7, 0x2d, 0x3a, 0x95, 0xcc, 0xef, 0xaf, 0xe9, 0x87,
+	0x35, 0x2c, 0x18, 0x43, 0x2b, 0xff, 0xf2, 0x2d, 0x4f, 0x63, 0x9a, 0x63, 0x82, 0x09, 0xb3, 0xae,
+	0xdf, 0x0a, 0x07, 0x26, 0x04, 0xeb, 0x50, 0x3f, 0xff, 0x6a, 0xf7, 0xee, 0xfa, 0x84, 0x0f, 0xac,
+	0xc9, 0x3b, 0x6e, 0x52, 0x49, 0x4a, 0xa5, 0x22, 0xa9, 0x65, 0x51, 0xff, 0xa9, 0x0c, 0x73, 0x81,
+	0x64, 0x5f, 0xf1, 0x44, 0x7d, 0x9d, 0xb3, 0xdb, 0x34, 0xe4, 0x1d, 0x0c, 0x6f, 0x89, 0x6d, 0x4e,
+	0xb3, 0x1a, 0x5a, 0x42, 0x8d, 0x6a, 0xb3, 0xf6, 0xec, 0xf1, 0xea, 0x9c, 0xe5, 0xb2, 0x19, 0xc7,
+	0x19, 0x95, 0xf2, 0xb6, 0xca, 0x12, 0xce, 0x42, 0x03, 0x73, 0x6e, 0xc2, 0x05, 0xca, 0xe3, 0x96,
+	0x8e, 0x5f, 0x2b, 0x2f, 0xa1, 0xc6, 0xcc, 0xfa, 0x22, 0x36, 0xc9, 0x71, 0x91, 0x1c, 0xdf, 0x29,
+	0x92, 0x37, 0x2f, 0x3d, 0xf9, 0xd3, 0x2b, 0x3d, 0x78, 0xe1, 0xa1, 0xdf, 0x0f, 0xf7, 0x56, 0x50,
+	0x38, 0x4d, 0x79, 0xac, 0x8d, 0xce, 0x97, 0x00, 0x52, 0x91, 0x4c, 0x99, 0x38, 0x95, 0x7f, 0x1b,
+	0xa7, 0x9a, 0x3b, 0x6b, 0xf3, 0x46, 0xe3, 0xe5, 0xae, 0x87, 0xee, 0x1f, 0xee, 0xad, 0xd8, 0x9d,
+	0x5e, 0x95, 0xf1, 0x96, 0x7f, 0x52, 0xa5, 0x75, 0x17, 0xde, 0x3d, 0x69, 0x3d, 0xa4, 0xb2, 0x2b,
+	0xb8, 0xa4, 0xf5, 0x87, 0x65, 0xb8, 0x66, 0x01, 0xb7, 0x68, 0x96, 0x88, 0x38, 0x89, 0x34, 0x30,
+	0xe1, 0x6c, 0x52, 0xad, 0x46, 0xab, 0x2c, 0x4f, 0x5e, 0xa5, 0xf3, 0x23, 0x5c, 0xe9, 0x18, 0x2e,
+	0xad, 0x6e, 0xce, 0x4d, 0xd6, 0x2a, 0x4b, 0x95, 0xc6, 0xcc, 0xfa, 0x0d, 0x7c, 0x4e, 0x83, 0x63,
+	0x53, 0x4b, 0xb3, 0xaa, 0x63, 0x9b, 0xb8, 0x97, 0x6d, 0x28, 0x63, 0x91, 0x1b, 0xf8, 0xe5, 0xae,
+	0x57, 0xd2, 0x12, 0x5e, 0x7f, 0x5d, 0x42, 0x83, 0x19, 0x15, 0xf2, 0x06, 0x5c, 0x3f, 0x53, 0xa7,
+	0x23, 0x45, 0x17, 0x60, 0x3e, 0x90, 0x2c, 0x48, 0x58, 0x46, 0x14, 0x1d, 0x8d, 0xb1, 0x0c, 0xde,
+	0x29, 0xa6, 0x23, 0xef, 0x7d, 0x04, 0x33, 0x81, 0x64, 0x37, 0x69, 0x87, 0x32, 0xa2, 0xa8, 0xf3,
+	0x3e, 0x4c, 0x49, 0xca, 0xe3, 0x31, 0xe4, 0xb7, 0x38, 0xe7, 0x1b, 0x98, 0xed, 0x93, 0x4e, 0x12,
+	0x13, 0x25, 0xb2, 0x16, 0x31, 0x90, 0x7c, 0x1b, 0xaa, 0xcd, 0xe5, 0x67, 0x8f, 0x57, 0xaf, 0x59,
+	0xe7, 0xef, 0x0a, 0xcc, 0x68, 0x94, 0xb7, 0xfb, 0xc7, 0xd6, 0x9d, 0x4f, 0x60, 0x8a, 0xa4, 0x9a,
+	0xa3, 0xed, 0xd8, 0x85, 0x42, 0x7c, 0x3d, 0x29, 0xb0, 0x9d, 0x14, 0xf8, 0x53, 0x91, 0xf0, 0x61,
+	0xb9, 0xad, 0xcf, 0xc6, 0xd5, 0x5f, 0x77, 0xbd, 0x92, 0x96, 0xfa, 0xe7, 0xc3, 0xbd, 0x15, 0x4b,
+	0xb1, 0xfe, 0x37, 0x82, 0x4b, 0x81, 0x64, 0xdf, 0xf2, 0xf8, 0x8d, 0x2e, 0xf3, 0x11, 0x82, 0xd9,
+	0x40, 0xb2, 0xef, 0x13, 0x75, 0x2f, 0xce, 0xc8, 0x76, 0x48, 0xb7, 0x49, 0x16, 0xff, 0xff, 0xa5,
+	0x9e, 0x4c, 0xf6, 0x97, 0x32, 0x4c, 0x07, 0x92, 0xdd, 0xa6, 0x7c, 0x12, 0x8a, 0x1f, 0x01, 0x28,
+	0x71, 0x8c, 0xdb, 0xe9, 0x5e, 0x55, 0x25, 0x0a, 0xd9, 0x07, 0x43, 0xb2, 0x57, 0xce, 0x96, 0xfd,
+	0x73, 0x2d, 0xfb, 0xa3, 0x17, 0x5e, 0x83, 0x25, 0xea, 0x5e, 0xaf, 0x8d, 0x23, 0x91, 0xda, 0x0b,
+	0xc4, 0x1f, 0x3a, 0xc2, 0x6a, 0xd0, 0xa5, 0x32, 0x77, 0x90, 0xbf, 0x1d, 0xee, 0xad, 0x5c, 0xd4,
+	0x0d, 0x16, 0x0d, 0x5a, 0xfa, 0x26, 0x93, 0x63, 0xec, 0xd9, 0x43, 0x04, 0xf0, 0xea, 0x8c, 0x4e,
+	0xa0, 0xc4, 0x32, 0x5c, 0xb4, 0x53, 0xa9, 0xa5, 0xa9, 0x18, 0x2d, 0xc2, 0x19, 0xbb, 0x76, 0x67,
+	0xd0, 0xa5, 0x0e, 0x86, 0xe9, 0x94, 0x4a, 0x49, 0x58, 0x71, 0x09, 0xcc, 0xbd, 0x36, 0x1e, 0x37,
+	0xf9, 0x20, 0x2c, 0x40, 0x27, 0x13, 0xbd, 0x05, 0x8b, 0x81, 0x64, 0x9f, 0xed, 0xd0, 0xa8, 0xa7,
+	0x68, 0x60, 0x90, 0xb2, 0x18, 0x23, 0xce, 0x3a, 0x54, 0x33, 0xfb, 0x2e, 0x6b, 0x68, 0xa9, 0x72,
+	0x6a, 0x92, 0x57, 0xb0, 0xfa, 0x1f, 0x66, 0xf4, 0x14, 0xed, 0xea, 0x7c, 0x0c, 0xb0, 0x6d, 0xdf,
+	0xc7, 0xa8, 0x7f, 0x08, 0x3b, 0x79, 0x37, 0xbc, 0x03, 0x53, 0x31, 0xe5, 0x22, 0x35, 0x83, 0xbe,
+	0x1a, 0xda, 0xaf, 0x8d, 0xf9, 0x61, 0x05, 0x86, 0x32, 0xd5, 0x9f, 0x23, 0xb8, 0x3a, 0x72, 0xc4,
+	0x6c, 0xfd, 0x1f, 0xc2, 0x85, 0x8c, 0x46, 0x34, 0xe9, 0x8f, 0xc1, 0xfc, 0x08, 0xe9, 0xdc, 0x47,
+	0x70, 0xc5, 0x34, 0x47, 0xcb, 0xae, 0xc5, 0xb5, 0xf2, 0x7f, 0xd5, 0x96, 0x97, 0x4d, 0xe6, 0xd0,
+	0x26, 0x6e, 0x7e, 0xf1, 0x64, 0xdf, 0x45, 0x4f, 0xf7, 0x5d, 0xf4, 0xd7, 0xbe, 0x8b, 0x1e, 0x1c,
+	0xb8, 0xa5, 0xa7, 0x07, 0x6e, 0xe9, 0xf9, 0x81, 0x5b, 0xfa, 0x61, 0xd5, 0xc4, 0x95, 0xf1, 0x16,
+	0x4e, 0x84, 0xbf, 0x73, 0xc6, 0x1f, 0x42, 0x9d, 0xb4, 0x3d, 0x95, 0x6f, 0xf8, 0x07, 0xff, 0x0c,
+	0x00, 0x6e, 0xd3, 0x81, 0x22, 0x40, 0x0a, 0x00, 0x00,
 }
 
 func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
@@ -783,6 +910,52 @@ func (m *MsgInitPeriodicLockingAccountResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateLockupAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockupAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateLockupAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockupAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockupAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -965,6 +1138,55 @@ func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExecuteMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1159,6 +1381,24 @@ func (m *MsgInitPeriodicLockingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrateLockupAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateLockupAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1237,6 +1477,27 @@ func (m *MsgSend) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgExecuteMessagesResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1697,6 +1958,106 @@ func (m *MsgInitPeriodicLockingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateLockupAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateLockupAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2253,6 +2614,156 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &any.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgExecuteMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
 	banktypes "cosmossdk.io/x/bank/types"
 	distrtypes "cosmossdk.io/x/distribution/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
@@ -82,6 +83,8 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 				return &distrtypes.MsgWithdrawDelegatorRewardResponse{}, nil
 			case "/cosmos.bank.v1beta1.MsgSend":
 				return &banktypes.MsgSendResponse{}, nil
+			case "/cosmos.accounts.v1.MsgMigrate":
+				return &accountsv1.MsgMigrateResponse{}, nil
 			default:
 				return nil, errors.New("unrecognized request type")
 			}
@@ -0,0 +1,276 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+var SOCIAL_RECOVERY_ACCOUNT = "social-recovery-account"
+
+var (
+	PubKeyPrefix           = collections.NewPrefix(0)
+	SequencePrefix         = collections.NewPrefix(1)
+	GuardiansPrefix        = collections.NewPrefix(2)
+	ConfigPrefix           = collections.NewPrefix(3)
+	RecoverySequencePrefix = collections.NewPrefix(4)
+	RecoveriesPrefix       = collections.NewPrefix(5)
+	ApprovalsPrefix        = collections.NewPrefix(6)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*Account)(nil)
+)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			PubKey:           collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Sequence:         collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			Guardians:        collections.NewMap(deps.SchemaBuilder, GuardiansPrefix, "guardians", collections.BytesKey, collections.Uint64Value),
+			Config:           collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			RecoverySequence: collections.NewSequence(deps.SchemaBuilder, RecoverySequencePrefix, "recovery_sequence"),
+			Recoveries:       collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.Uint64Key, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			Approvals:        collections.NewKeySet(deps.SchemaBuilder, ApprovalsPrefix, "approvals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+			addrCodec:        deps.AddressCodec,
+			signingHandlers:  handlerMap,
+			hs:               deps.Environment.HeaderService,
+			eventService:     deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements an account whose owner designates guardians, which can
+// rotate the pubkey of the account once their approvals of a recovery reach the
+// threshold and the recovery delay has passed. The owner can veto the recoveries
+// until they are executed.
+type Account struct {
+	PubKey   collections.Item[secp256k1.PubKey]
+	Sequence collections.Sequence
+
+	Guardians collections.Map[[]byte, uint64]
+	Config    collections.Item[v1.Config]
+
+	RecoverySequence collections.Sequence
+	Recoveries       collections.Map[uint64, v1.Recovery]
+	Approvals        collections.KeySet[collections.Pair[uint64, []byte]] // key: recoveryID + guardian address
+
+	addrCodec    address.Codec
+	hs           header.Service
+	eventService event.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+// Init initializes the account with the pubkey of its owner, its guardians and
+// its config.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil {
+		return nil, errors.New("config must be specified")
+	}
+
+	if len(msg.Guardians) == 0 {
+		return nil, errors.New("guardians must be specified")
+	}
+
+	if err := a.verifyAndSetPubKey(ctx, msg.PubKey); err != nil {
+		return nil, err
+	}
+
+	// set guardians
+	guardiansMap := map[string]struct{}{} // to check for duplicates
+	for _, guardian := range msg.Guardians {
+		if _, ok := guardiansMap[guardian.Address]; ok {
+			return nil, errors.New("duplicate guardian address found")
+		}
+		guardiansMap[guardian.Address] = struct{}{}
+
+		if guardian.Weight == 0 {
+			return nil, errors.New("guardian weight must be greater than zero")
+		}
+
+		if err := a.setGuardian(ctx, guardian); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.Config.Set(ctx, *msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, a.validateConfig(ctx)
+}
+
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+}
+
+func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
+	_, err := dcrd_secp256k1.ParsePubKey(key)
+	if err != nil {
+		return err
+	}
+	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+}
+
+// Authenticate implements the authentication flow of an abstracted social
+// recovery account, the transactions are signed by the pubkey of the owner.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	pubKey, signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := base.GetTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := base.ParseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signature := msg.Tx.Signatures[msg.SignerIndex]
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	signerData, err := base.NewSignerData(ctx, a.addrCodec, a.hs.HeaderInfo(ctx).ChainID, wantSequence, &pk)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+	return pk, signerData, nil
+}
+
+// QuerySequence returns the sequence of the account, used to authenticate its
+// transactions.
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+// QueryConfig returns the guardians and the config of the account.
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	guardians := []*v1.Guardian{}
+	err = a.Guardians.Walk(ctx, nil, func(addr []byte, weight uint64) (stop bool, err error) {
+		addrStr, err := a.addrCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		guardians = append(guardians, &v1.Guardian{Address: addrStr, Weight: weight})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryConfigResponse{Guardians: guardians, Config: &cfg}, nil
+}
+
+// QueryPendingRecoveries returns the recoveries which are not executed nor
+// vetoed yet, along with their approvals.
+func (a Account) QueryPendingRecoveries(ctx context.Context, _ *v1.QueryPendingRecoveries) (*v1.QueryPendingRecoveriesResponse, error) {
+	recoveries := []*v1.PendingRecovery{}
+	err := a.Recoveries.Walk(ctx, nil, func(id uint64, recovery v1.Recovery) (stop bool, err error) {
+		approvals := []string{}
+		rng := collections.NewPrefixedPairRange[uint64, []byte](id)
+		err = a.Approvals.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (bool, error) {
+			addrStr, err := a.addrCodec.BytesToString(key.K2())
+			if err != nil {
+				return true, err
+			}
+			approvals = append(approvals, addrStr)
+			return false, nil
+		})
+		if err != nil {
+			return true, err
+		}
+
+		recoveries = append(recoveries, &v1.PendingRecovery{RecoveryId: id, Recovery: &recovery, Approvals: approvals})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryPendingRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateGuardians)
+	accountstd.RegisterExecuteHandler(builder, a.ProposeRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.VetoRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryPendingRecoveries)
+}
@@ -0,0 +1,359 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const self = "mock_recovery_account"
+
+func setupRecoveryAccount(t *testing.T, ss store.KVStoreService, timefn func() time.Time) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss, timefn)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("recovery", signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	recoveryAcc := acc.(Account)
+	require.NoError(t, err)
+
+	return recoveryAcc
+}
+
+func newMsgInit(pubKey []byte) *v1.MsgInit {
+	return &v1.MsgInit{
+		PubKey: pubKey,
+		Guardians: []*v1.Guardian{
+			{Address: "guardian1", Weight: 1},
+			{Address: "guardian2", Weight: 1},
+			{Address: "guardian3", Weight: 1},
+		},
+		Config: &v1.Config{
+			Threshold:     2,
+			RecoveryDelay: 60,
+		},
+	}
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss, time.Now)
+
+	testcases := []struct {
+		name   string
+		msg    func(msg *v1.MsgInit) *v1.MsgInit
+		expErr string
+	}{
+		{
+			"success",
+			func(msg *v1.MsgInit) *v1.MsgInit { return msg },
+			"",
+		},
+		{
+			"invalid pubkey",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.PubKey = []byte("invalid_pk")
+				return msg
+			},
+			"malformed public key",
+		},
+		{
+			"no guardians",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.Guardians = nil
+				return msg
+			},
+			"guardians must be specified",
+		},
+		{
+			"no config",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.Config = nil
+				return msg
+			},
+			"config must be specified",
+		},
+		{
+			"duplicate guardian",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.Guardians = append(msg.Guardians, &v1.Guardian{Address: "guardian1", Weight: 1})
+				return msg
+			},
+			"duplicate guardian address found",
+		},
+		{
+			"zero weight guardian",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.Guardians[0].Weight = 0
+				return msg
+			},
+			"guardian weight must be greater than zero",
+		},
+		{
+			"self guardian",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.Guardians[0].Address = self
+				return msg
+			},
+			"the account cannot be its own guardian",
+		},
+		{
+			"threshold above the total weight",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.Config.Threshold = 4
+				return msg
+			},
+			"threshold must be less than or equal to the total weight",
+		},
+		{
+			"no recovery delay",
+			func(msg *v1.MsgInit) *v1.MsgInit {
+				msg.Config.RecoveryDelay = 0
+				return msg
+			},
+			"threshold and recovery delay must be greater than zero",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Init(ctx, tc.msg(newMsgInit(secp256k1.GenPrivKey().PubKey().Bytes())))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateGuardians(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss, time.Now)
+	_, err := acc.Init(ctx, newMsgInit(secp256k1.GenPrivKey().PubKey().Bytes()))
+	require.NoError(t, err)
+
+	// only the owner can update the guardians
+	ctx = accountstd.SetSender(ctx, []byte("guardian1"))
+	_, err = acc.UpdateGuardians(ctx, &v1.MsgUpdateGuardians{
+		UpdateGuardians: []*v1.Guardian{{Address: "guardian4", Weight: 1}},
+	})
+	require.ErrorContains(t, err, "only the account itself can update the guardians")
+
+	ctx = accountstd.SetSender(ctx, []byte(self))
+	_, err = acc.UpdateGuardians(ctx, &v1.MsgUpdateGuardians{
+		UpdateGuardians: []*v1.Guardian{{Address: "guardian1"}, {Address: "guardian4", Weight: 2}},
+		Config:          &v1.Config{Threshold: 3, RecoveryDelay: 120},
+	})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, &v1.Config{Threshold: 3, RecoveryDelay: 120}, resp.Config)
+	require.Equal(t, []*v1.Guardian{
+		{Address: "guardian2", Weight: 1},
+		{Address: "guardian3", Weight: 1},
+		{Address: "guardian4", Weight: 2},
+	}, resp.Guardians)
+
+	// removing guardians below the threshold is rejected
+	_, err = acc.UpdateGuardians(ctx, &v1.MsgUpdateGuardians{
+		UpdateGuardians: []*v1.Guardian{{Address: "guardian4"}},
+	})
+	require.ErrorContains(t, err, "threshold must be less than or equal to the total weight")
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	currentTime := time.Now()
+	acc := setupRecoveryAccount(t, ss, func() time.Time { return currentTime })
+	_, err := acc.Init(ctx, newMsgInit(secp256k1.GenPrivKey().PubKey().Bytes()))
+	require.NoError(t, err)
+
+	newPubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	// only guardians can propose a recovery
+	_, err = acc.ProposeRecovery(accountstd.SetSender(ctx, []byte("stranger")), &v1.MsgProposeRecovery{NewPubKey: newPubKey})
+	require.Error(t, err)
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian1"))
+	_, err = acc.ProposeRecovery(ctx, &v1.MsgProposeRecovery{NewPubKey: []byte("invalid_pk")})
+	require.Error(t, err)
+
+	resp, err := acc.ProposeRecovery(ctx, &v1.MsgProposeRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	otherResp, err := acc.ProposeRecovery(accountstd.SetSender(ctx, []byte("guardian3")), &v1.MsgProposeRecovery{
+		NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+	})
+	require.NoError(t, err)
+
+	// the threshold is not reached by the proposer alone
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: resp.RecoveryId})
+	require.ErrorContains(t, err, "recovery has not reached the threshold")
+
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{RecoveryId: resp.RecoveryId})
+	require.ErrorContains(t, err, "guardian has already approved the recovery")
+
+	ctx = accountstd.SetSender(ctx, []byte("guardian2"))
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+
+	pending, err := acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Len(t, pending.Recoveries, 2)
+	require.Equal(t, &v1.PendingRecovery{
+		RecoveryId: resp.RecoveryId,
+		Recovery: &v1.Recovery{
+			NewPubKey:       newPubKey,
+			Proposer:        "guardian1",
+			ExecutableAfter: currentTime.Add(time.Minute).Unix(),
+		},
+		Approvals: []string{"guardian1", "guardian2"},
+	}, pending.Recoveries[0])
+
+	// the owner can veto during the delay
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: resp.RecoveryId})
+	require.ErrorContains(t, err, "recovery delay has not passed yet")
+
+	currentTime = currentTime.Add(time.Minute)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPubKey, pk.Key)
+
+	// the other recoveries are discarded
+	pending, err = acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Recoveries)
+	_, err = acc.ApproveRecovery(ctx, &v1.MsgApproveRecovery{RecoveryId: otherResp.RecoveryId})
+	require.Error(t, err)
+}
+
+func TestVetoRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	currentTime := time.Now()
+	acc := setupRecoveryAccount(t, ss, func() time.Time { return currentTime })
+	ownerPubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	_, err := acc.Init(ctx, newMsgInit(ownerPubKey))
+	require.NoError(t, err)
+
+	resp, err := acc.ProposeRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgProposeRecovery{
+		NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+	})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(accountstd.SetSender(ctx, []byte("guardian2")), &v1.MsgApproveRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+
+	// only the owner can veto
+	_, err = acc.VetoRecovery(accountstd.SetSender(ctx, []byte("guardian3")), &v1.MsgVetoRecovery{RecoveryId: resp.RecoveryId})
+	require.ErrorContains(t, err, "only the account itself can veto a recovery")
+
+	ctx = accountstd.SetSender(ctx, []byte(self))
+	_, err = acc.VetoRecovery(ctx, &v1.MsgVetoRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+	_, err = acc.VetoRecovery(ctx, &v1.MsgVetoRecovery{RecoveryId: resp.RecoveryId})
+	require.ErrorContains(t, err, "not found")
+
+	currentTime = currentTime.Add(time.Hour)
+	_, err = acc.ExecuteRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgExecuteRecovery{RecoveryId: resp.RecoveryId})
+	require.Error(t, err)
+
+	pending, err := acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Recoveries)
+
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ownerPubKey, pk.Key)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	currentTime := time.Now()
+	acc := setupRecoveryAccount(t, ss, func() time.Time { return currentTime })
+	ownerKey := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, newMsgInit(ownerKey.PubKey().Bytes()))
+	require.NoError(t, err)
+
+	authenticate := func(privKey *secp256k1.PrivKey) error {
+		seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+		require.NoError(t, err)
+
+		transaction := tx.Tx{
+			Body: &tx.TxBody{},
+			AuthInfo: &tx.AuthInfo{
+				SignerInfos: []*tx.SignerInfo{
+					{
+						ModeInfo: &tx.ModeInfo{
+							Sum: &tx.ModeInfo_Single_{
+								Single: &tx.ModeInfo_Single{
+									Mode: 1,
+								},
+							},
+						},
+						Sequence: seq.Sequence,
+					},
+				},
+			},
+		}
+
+		bodyByte, err := transaction.Body.Marshal()
+		require.NoError(t, err)
+		authByte, err := transaction.AuthInfo.Marshal()
+		require.NoError(t, err)
+
+		txDoc := tx.SignDoc{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			ChainId:       "test",
+			AccountNumber: 1,
+		}
+		signBytes, err := txDoc.Marshal()
+		require.NoError(t, err)
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		transaction.Signatures = [][]byte{sig}
+
+		_, err = acc.Authenticate(accountstd.SetSender(ctx, address.Module("accounts")), &aa_interface_v1.MsgAuthenticate{
+			RawTx: &tx.TxRaw{
+				BodyBytes:     bodyByte,
+				AuthInfoBytes: authByte,
+				Signatures:    transaction.Signatures,
+			},
+			Tx:          &transaction,
+			SignerIndex: 0,
+		})
+		return err
+	}
+
+	require.NoError(t, authenticate(ownerKey))
+
+	// the guardians recover the account to a new key
+	newKey := secp256k1.GenPrivKey()
+	resp, err := acc.ProposeRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgProposeRecovery{
+		NewPubKey: newKey.PubKey().Bytes(),
+	})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(accountstd.SetSender(ctx, []byte("guardian2")), &v1.MsgApproveRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+	currentTime = currentTime.Add(time.Minute)
+	_, err = acc.ExecuteRecovery(accountstd.SetSender(ctx, []byte("guardian2")), &v1.MsgExecuteRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+
+	require.ErrorContains(t, authenticate(ownerKey), "signature verification failed")
+	require.NoError(t, authenticate(newKey))
+}
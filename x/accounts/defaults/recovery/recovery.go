@@ -0,0 +1,236 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+)
+
+// ProposeRecovery creates a new recovery, approved by the guardian proposing it.
+func (a Account) ProposeRecovery(ctx context.Context, msg *v1.MsgProposeRecovery) (*v1.MsgProposeRecoveryResponse, error) {
+	sender := accountstd.Sender(ctx)
+
+	// check if the sender is a guardian
+	_, err := a.Guardians.Get(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dcrd_secp256k1.ParsePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	id, err := a.RecoverySequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery := v1.Recovery{
+		NewPubKey: msg.NewPubKey,
+		Proposer:  proposer,
+	}
+	if err := a.approve(ctx, id, recovery, sender); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_proposed",
+		event.NewAttribute("recovery_id", fmt.Sprint(id)),
+		event.NewAttribute("proposer", proposer),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgProposeRecoveryResponse{RecoveryId: id}, nil
+}
+
+// ApproveRecovery approves a recovery. The sender must be a guardian who did not
+// approve the recovery yet.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	sender := accountstd.Sender(ctx)
+
+	// check if the sender is a guardian
+	_, err := a.Guardians.Get(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.Recoveries.Get(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if the guardian has already approved
+	approved, err := a.Approvals.Has(ctx, collections.Join(msg.RecoveryId, sender))
+	if err != nil {
+		return nil, err
+	}
+	if approved {
+		return nil, errors.New("guardian has already approved the recovery")
+	}
+
+	if err := a.approve(ctx, msg.RecoveryId, recovery, sender); err != nil {
+		return nil, err
+	}
+
+	addr, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+		event.NewAttribute("guardian", addr),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgApproveRecoveryResponse{}, nil
+}
+
+// approve records the approval of the guardian and starts the recovery delay
+// once the approvals reach the threshold.
+func (a Account) approve(ctx context.Context, id uint64, recovery v1.Recovery, guardian []byte) error {
+	if err := a.Approvals.Set(ctx, collections.Join(id, guardian)); err != nil {
+		return err
+	}
+
+	if recovery.ExecutableAfter == 0 {
+		cfg, err := a.Config.Get(ctx)
+		if err != nil {
+			return err
+		}
+		weight, err := a.tally(ctx, id)
+		if err != nil {
+			return err
+		}
+		if weight >= cfg.Threshold {
+			recovery.ExecutableAfter = a.hs.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(cfg.RecoveryDelay)).Unix()
+		}
+	}
+
+	return a.Recoveries.Set(ctx, id, recovery)
+}
+
+// tally returns the weight of the approvals of a recovery by the current
+// guardians.
+func (a Account) tally(ctx context.Context, id uint64) (uint64, error) {
+	weight := uint64(0)
+	rng := collections.NewPrefixedPairRange[uint64, []byte](id)
+	err := a.Approvals.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+		guardianWeight, err := a.Guardians.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			// edge case: if a guardian has been removed after approving, we should ignore their approval
+			return false, nil
+		} else if err != nil {
+			return true, err
+		}
+
+		weight, err = safeAdd(weight, guardianWeight)
+		if err != nil {
+			return true, err
+		}
+		return false, nil
+	})
+	return weight, err
+}
+
+// ExecuteRecovery rotates the pubkey of the account to the one of a recovery
+// whose approvals reached the threshold and whose delay has passed. The other
+// pending recoveries are discarded.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	// check if the sender is a guardian
+	_, err := a.Guardians.Get(ctx, accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.Recoveries.Get(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if recovery.ExecutableAfter == 0 {
+		return nil, errors.New("recovery has not reached the threshold")
+	}
+	if a.hs.HeaderInfo(ctx).Time.Unix() < recovery.ExecutableAfter {
+		return nil, errors.New("recovery delay has not passed yet")
+	}
+
+	// the guardians may have changed since the threshold was reached
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	weight, err := a.tally(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	if weight < cfg.Threshold {
+		return nil, errors.New("threshold not reached")
+	}
+
+	if err := a.verifyAndSetPubKey(ctx, recovery.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	// the recoveries are made for the previous pubkey
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+	if err := a.Approvals.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, nil
+}
+
+// VetoRecovery deletes a pending recovery and its approvals, it can only be
+// executed by the account itself, i.e. the owner.
+func (a Account) VetoRecovery(ctx context.Context, msg *v1.MsgVetoRecovery) (*v1.MsgVetoRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("only the account itself can veto a recovery")
+	}
+
+	has, err := a.Recoveries.Has(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("recovery %d not found", msg.RecoveryId)
+	}
+
+	if err := a.Recoveries.Remove(ctx, msg.RecoveryId); err != nil {
+		return nil, err
+	}
+	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.RecoveryId)
+	if err := a.Approvals.Clear(ctx, rng); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_vetoed",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVetoRecoveryResponse{}, nil
+}
@@ -0,0 +1,108 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+)
+
+// UpdateGuardians updates the guardians and the configuration of the account.
+func (a Account) UpdateGuardians(ctx context.Context, msg *v1.MsgUpdateGuardians) (*v1.MsgUpdateGuardiansResponse, error) {
+	// this function can only be executed by the account itself, i.e. the owner
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("only the account itself can update the guardians")
+	}
+
+	// set guardians
+	for _, guardian := range msg.UpdateGuardians {
+		if guardian.Weight == 0 {
+			addrBz, err := a.addrCodec.StringToBytes(guardian.Address)
+			if err != nil {
+				return nil, err
+			}
+			if err := a.Guardians.Remove(ctx, addrBz); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := a.setGuardian(ctx, guardian); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.Config != nil {
+		// set config
+		if err := a.Config.Set(ctx, *msg.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	// verify that the new set of guardians and config are valid
+	if err := a.validateConfig(ctx); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateGuardiansResponse{}, nil
+}
+
+func (a Account) setGuardian(ctx context.Context, guardian *v1.Guardian) error {
+	addrBz, err := a.addrCodec.StringToBytes(guardian.Address)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(addrBz, accountstd.Whoami(ctx)) {
+		return errors.New("the account cannot be its own guardian")
+	}
+
+	return a.Guardians.Set(ctx, addrBz, guardian.Weight)
+}
+
+// validateConfig checks the stored config against the weight of the stored
+// guardians.
+func (a Account) validateConfig(ctx context.Context) error {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalWeight := uint64(0)
+	var addErr error
+	err = a.Guardians.Walk(ctx, nil, func(_ []byte, value uint64) (stop bool, err error) {
+		totalWeight, addErr = safeAdd(totalWeight, value)
+		if addErr != nil {
+			return true, addErr
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// check for zero values, the recovery delay is the veto window of the owner
+	if cfg.Threshold == 0 || cfg.RecoveryDelay <= 0 {
+		return errors.New("threshold and recovery delay must be greater than zero")
+	}
+
+	// threshold must be less than or equal to the total weight
+	if totalWeight < cfg.Threshold {
+		return errors.New("threshold must be less than or equal to the total weight")
+	}
+
+	return nil
+}
+
+func safeAdd(nums ...uint64) (uint64, error) {
+	var sum uint64
+	for _, num := range nums {
+		if newSum := sum + num; newSum < sum {
+			return 0, errors.New("overflow")
+		} else {
+			sum = newSum
+		}
+	}
+	return sum, nil
+}
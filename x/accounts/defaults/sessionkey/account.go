@@ -0,0 +1,265 @@
+package sessionkey
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+var SESSION_KEY_ACCOUNT = "session-key-account"
+
+var (
+	PubKeyPrefix   = collections.NewPrefix(0)
+	SequencePrefix = collections.NewPrefix(1)
+	SessionsPrefix = collections.NewPrefix(2)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*Account)(nil)
+)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			Sessions:        collections.NewMap(deps.SchemaBuilder, SessionsPrefix, "sessions", collections.BytesKey, codec.CollValue[v1.Session](deps.LegacyStateCodec)),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account whose owner can register session keys, which
+// sign the transactions of the account within the message types, spend limit
+// and expiry time of their session.
+type Account struct {
+	PubKey   collections.Item[secp256k1.PubKey]
+	Sequence collections.Sequence
+	// Sessions maps the pubkey of the session keys to their session.
+	Sessions collections.Map[[]byte, v1.Session]
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+}
+
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+}
+
+func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
+	_, err := dcrd_secp256k1.ParsePubKey(key)
+	if err != nil {
+		return err
+	}
+	has, err := a.Sessions.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errors.New("pubkey is already used by a session key")
+	}
+	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+}
+
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(pk.Key, msg.Session.PubKey) {
+		return nil, errors.New("the owner pubkey cannot be used as a session key")
+	}
+	if err := validateSession(msg.Session, a.hs.HeaderInfo(ctx).Time); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.Sessions.Set(ctx, msg.Session.PubKey, msg.Session)
+}
+
+func (a Account) RevokeSessionKey(ctx context.Context, msg *v1.MsgRevokeSessionKey) (*v1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.Sessions.Has(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("session key %X not found", msg.PubKey)
+	}
+
+	return &v1.MsgRevokeSessionKeyResponse{}, a.Sessions.Remove(ctx, msg.PubKey)
+}
+
+// Authenticate implements the authentication flow of an abstracted session key
+// account. The transaction is signed either by the owner, or by a session key
+// set as the public key of the signer info, in which case the transaction must
+// be within the limits of its session.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+	pubKey, session, err := a.getSigner(ctx, signerInfo.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signerData, err := a.computeSignerData(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := base.GetTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := signerInfo.Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := base.ParseSignMode(signerInfo.ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signature := msg.Tx.Signatures[msg.SignerIndex]
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	if session == nil {
+		return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	}
+
+	spendLimit, err := checkSession(*session, msg, signerData.Address, a.hs.HeaderInfo(ctx).Time)
+	if err != nil {
+		return nil, err
+	}
+	session.SpendLimit = spendLimit
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.Sessions.Set(ctx, session.PubKey, *session)
+}
+
+// getSigner returns the pubkey of the signer of the transaction, along with its
+// session if the signer is a session key. The owner signs the transaction when
+// the signer info has no public key or has the one of the owner.
+func (a Account) getSigner(ctx context.Context, pkAny *codectypes.Any) (*secp256k1.PubKey, *v1.Session, error) {
+	owner, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pkAny == nil {
+		return &owner, nil, nil
+	}
+
+	if pkAny.TypeUrl != codectypes.MsgTypeURL(&owner) {
+		return nil, nil, fmt.Errorf("unsupported signer public key type: %s", pkAny.TypeUrl)
+	}
+	pk := &secp256k1.PubKey{}
+	if err := pk.Unmarshal(pkAny.Value); err != nil {
+		return nil, nil, err
+	}
+	if bytes.Equal(pk.Key, owner.Key) {
+		return &owner, nil, nil
+	}
+
+	session, err := a.Sessions.Get(ctx, pk.Key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil, fmt.Errorf("unknown session key %X", pk.Key)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pk, &session, nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, pk *secp256k1.PubKey) (signing.SignerData, error) {
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return base.NewSignerData(ctx, a.addrCodec, a.hs.HeaderInfo(ctx).ChainID, wantSequence, pk)
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	var sessions []v1.Session
+	err := a.Sessions.Walk(ctx, nil, func(_ []byte, session v1.Session) (bool, error) {
+		sessions = append(sessions, session)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{Sessions: sessions}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+}
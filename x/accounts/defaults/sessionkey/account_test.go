@@ -0,0 +1,421 @@
+package sessionkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const self = "mock_session_key_account"
+
+var (
+	msgSendTypeURL    = codectypes.MsgTypeURL(&banktypes.MsgSend{})
+	msgExecuteTypeURL = codectypes.MsgTypeURL(&accountsv1.MsgExecute{})
+)
+
+func setupSessionKeyAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("session-key", signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	sessionKeyAcc := acc.(Account)
+	require.NoError(t, err)
+
+	return sessionKeyAcc
+}
+
+func newSession(pubKey []byte) v1.Session {
+	return v1.Session{
+		PubKey:      pubKey,
+		AllowedMsgs: []string{msgSendTypeURL, msgExecuteTypeURL},
+		SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		ExpiresAt:   blockTime.Add(time.Hour),
+	}
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey: secp256k1.GenPrivKey().PubKey().Bytes(),
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Init(ctx, &v1.MsgInit{
+		PubKey: []byte("invalid_pk"),
+	})
+	require.Error(t, err)
+}
+
+func TestAddSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+	owner := secp256k1.GenPrivKey().PubKey().Bytes()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: owner})
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	testcases := []struct {
+		name     string
+		genCtx   func(ctx context.Context) context.Context
+		session  func() v1.Session
+		isExpErr bool
+		expErr   error
+	}{
+		{
+			"invalid transaction, sender is not self",
+			func(ctx context.Context) context.Context {
+				return accountstd.SetSender(ctx, []byte("sender"))
+			},
+			func() v1.Session { return newSession(sessionKey) },
+			true,
+			errors.New("unauthorized"),
+		},
+		{
+			"invalid session, owner pubkey",
+			nil,
+			func() v1.Session { return newSession(owner) },
+			true,
+			nil,
+		},
+		{
+			"invalid session, invalid pubkey",
+			nil,
+			func() v1.Session { return newSession([]byte("invalid_pk")) },
+			true,
+			nil,
+		},
+		{
+			"invalid session, no allowed messages",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.AllowedMsgs = nil
+				return session
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid session, invalid spend limit",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.SpendLimit = sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}
+				return session
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid session, expired",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.ExpiresAt = blockTime
+				return session
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid session, delegation",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.AllowedMsgs = append(session.AllowedMsgs, "/cosmos.staking.v1beta1.MsgDelegate")
+				return session
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid session, IBC transfer",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.AllowedMsgs = append(session.AllowedMsgs, "/ibc.applications.transfer.v1.MsgTransfer")
+				return session
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid session, governance deposit",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.AllowedMsgs = append(session.AllowedMsgs, "/cosmos.gov.v1.MsgDeposit")
+				return session
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid session, delegation without spend limit",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.AllowedMsgs = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+				session.SpendLimit = nil
+				return session
+			},
+			true,
+			nil,
+		},
+		{
+			"valid session, without spend limit",
+			nil,
+			func() v1.Session {
+				session := newSession(sessionKey)
+				session.SpendLimit = nil
+				return session
+			},
+			false,
+			nil,
+		},
+		{
+			"valid session",
+			nil,
+			func() v1.Session { return newSession(sessionKey) },
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := accountstd.SetSender(ctx, []byte(self))
+			if tc.genCtx != nil {
+				ctx = tc.genCtx(ctx)
+			}
+			_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{Session: tc.session()})
+			if tc.isExpErr {
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, err, tc.name)
+				} else {
+					require.NotNil(t, err, tc.name)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	resp, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1)
+	require.Equal(t, sessionKey, resp.Sessions[0].PubKey)
+
+	// the owner cannot swap to the pubkey of a session key
+	ctx = accountstd.SetSender(ctx, []byte(self))
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: sessionKey})
+	require.Error(t, err)
+
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey})
+	require.NoError(t, err)
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey})
+	require.Error(t, err)
+
+	resp, err = acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Sessions)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupSessionKeyAccount(t, ss)
+	ownerKey := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: ownerKey.PubKey().Bytes()})
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey()
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte(self)), &v1.MsgAddSessionKey{
+		Session: newSession(sessionKey.PubKey().Bytes()),
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	// authenticate signs a transaction of the given messages and fee with the
+	// given key, which is set as the public key of the signer info if setPubKey.
+	authenticate := func(privKey *secp256k1.PrivKey, setPubKey bool, fee sdk.Coins, msgs ...sdk.Msg) error {
+		seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+		require.NoError(t, err)
+
+		anyMsgs := make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			anyMsg, err := codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+			anyMsgs[i] = anyMsg
+		}
+		signerInfo := &tx.SignerInfo{
+			ModeInfo: &tx.ModeInfo{
+				Sum: &tx.ModeInfo_Single_{
+					Single: &tx.ModeInfo_Single{
+						Mode: 1,
+					},
+				},
+			},
+			Sequence: seq.Sequence,
+		}
+		if setPubKey {
+			pkAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+			require.NoError(t, err)
+			signerInfo.PublicKey = pkAny
+		}
+		transaction := tx.Tx{
+			Body: &tx.TxBody{Messages: anyMsgs},
+			AuthInfo: &tx.AuthInfo{
+				SignerInfos: []*tx.SignerInfo{signerInfo},
+				Fee:         &tx.Fee{Amount: fee},
+			},
+		}
+
+		bodyByte, err := transaction.Body.Marshal()
+		require.NoError(t, err)
+		authByte, err := transaction.AuthInfo.Marshal()
+		require.NoError(t, err)
+
+		txDoc := tx.SignDoc{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			ChainId:       "test",
+			AccountNumber: 1,
+		}
+		signBytes, err := txDoc.Marshal()
+		require.NoError(t, err)
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		transaction.Signatures = [][]byte{sig}
+
+		_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+			RawTx: &tx.TxRaw{
+				BodyBytes:     bodyByte,
+				AuthInfoBytes: authByte,
+				Signatures:    transaction.Signatures,
+			},
+			Tx:          &transaction,
+			SignerIndex: 0,
+		})
+		return err
+	}
+
+	send := func(amount int64) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: self,
+			ToAddress:   "recipient",
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}
+	}
+	spendLimit := func() sdk.Coins {
+		resp, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+		require.NoError(t, err)
+		require.Len(t, resp.Sessions, 1)
+		return resp.Sessions[0].SpendLimit
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// the owner is not limited by the sessions
+	require.NoError(t, authenticate(ownerKey, false, fee, send(1000)))
+	require.NoError(t, authenticate(ownerKey, true, fee, &banktypes.MsgMultiSend{}))
+
+	// the session key spends its limit through fees and sends
+	require.NoError(t, authenticate(sessionKey, true, fee, send(40)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), spendLimit())
+
+	testcases := []struct {
+		name string
+		fee  sdk.Coins
+		msgs []sdk.Msg
+	}{
+		{
+			"exceeds the spend limit",
+			fee,
+			[]sdk.Msg{send(30), send(20)},
+		},
+		{
+			"spends a denom out of the spend limit",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			[]sdk.Msg{send(1)},
+		},
+		{
+			"message type not allowed",
+			nil,
+			[]sdk.Msg{&banktypes.MsgMultiSend{}},
+		},
+		{
+			"executes a message on the account itself",
+			nil,
+			[]sdk.Msg{&accountsv1.MsgExecute{Sender: "sender", Target: self}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, authenticate(sessionKey, true, tc.fee, tc.msgs...))
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), spendLimit())
+		})
+	}
+
+	// a session key signing for the owner
+	require.Equal(t, errors.New("signature verification failed"), authenticate(sessionKey, false, nil, send(1)))
+
+	// an unknown key
+	require.Error(t, authenticate(secp256k1.GenPrivKey(), true, nil, send(1)))
+
+	// a session with a spend limit registered with a message which is not
+	// accounted for, e.g. before it was rejected, cannot bypass the limit
+	session := newSession(sessionKey.PubKey().Bytes())
+	session.AllowedMsgs = append(session.AllowedMsgs, codectypes.MsgTypeURL(&accountsv1.MsgInit{}))
+	require.NoError(t, acc.Sessions.Set(ctx, session.PubKey, session))
+	require.Error(t, authenticate(sessionKey, true, nil, &accountsv1.MsgInit{
+		Sender:      self,
+		AccountType: "base",
+		Funds:       sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}))
+	require.Equal(t, session.SpendLimit, spendLimit())
+
+	// a session without a spend limit can't spend any coins, including its fees
+	session = newSession(sessionKey.PubKey().Bytes())
+	session.SpendLimit = nil
+	require.NoError(t, acc.Sessions.Set(ctx, session.PubKey, session))
+	require.Error(t, authenticate(sessionKey, true, fee, &accountsv1.MsgExecute{Sender: self, Target: "target"}))
+	require.Error(t, authenticate(sessionKey, true, nil, send(1)))
+	require.NoError(t, authenticate(sessionKey, true, nil, &accountsv1.MsgExecute{Sender: self, Target: "target"}))
+	require.Empty(t, spendLimit())
+
+	// nor allow messages which are not accounted for, e.g. registered before
+	// they were rejected
+	session.AllowedMsgs = append(session.AllowedMsgs, codectypes.MsgTypeURL(&accountsv1.MsgInit{}))
+	require.NoError(t, acc.Sessions.Set(ctx, session.PubKey, session))
+	require.Error(t, authenticate(sessionKey, true, nil, &accountsv1.MsgInit{Sender: self, AccountType: "base"}))
+
+	// an expired session
+	session = newSession(sessionKey.PubKey().Bytes())
+	session.ExpiresAt = blockTime
+	require.NoError(t, acc.Sessions.Set(ctx, session.PubKey, session))
+	require.Error(t, authenticate(sessionKey, true, nil, send(1)))
+}
@@ -0,0 +1,133 @@
+package sessionkey
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// spendAccountedMsgs are the message types whose coins sent by the account are
+// accounted for by msgSpend. They are the only messages a session can allow, as
+// the other messages could move the coins of the account beyond its spend limit.
+var spendAccountedMsgs = []string{
+	codectypes.MsgTypeURL(&banktypes.MsgSend{}),
+	codectypes.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	codectypes.MsgTypeURL(&accountsv1.MsgExecute{}),
+}
+
+// validateSession checks a session before it is registered.
+func validateSession(session v1.Session, now time.Time) error {
+	if _, err := dcrd_secp256k1.ParsePubKey(session.PubKey); err != nil {
+		return err
+	}
+	if len(session.AllowedMsgs) == 0 {
+		return errors.New("session must allow at least one message type")
+	}
+	for _, typeURL := range session.AllowedMsgs {
+		if typeURL == "" {
+			return errors.New("session allowed message type cannot be empty")
+		}
+		if !slices.Contains(spendAccountedMsgs, typeURL) {
+			return fmt.Errorf("message %s cannot be allowed by a session, only %v can", typeURL, spendAccountedMsgs)
+		}
+	}
+	if err := session.SpendLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid session spend limit: %w", err)
+	}
+	if !session.ExpiresAt.After(now) {
+		return fmt.Errorf("session expiry %s must be after the block time %s", session.ExpiresAt, now)
+	}
+	return nil
+}
+
+// checkSession checks that the transaction is within the limits of the session
+// of its signer, and returns the spend limit left once the transaction spends
+// its coins. A session with an empty spend limit can't spend any coins, so the
+// fees of its transactions must be paid by a fee granter or another signer.
+func checkSession(session v1.Session, msg *aa_interface_v1.MsgAuthenticate, self string, now time.Time) (sdk.Coins, error) {
+	if !now.Before(session.ExpiresAt) {
+		return nil, fmt.Errorf("session key expired at %s", session.ExpiresAt)
+	}
+
+	spent := sdk.NewCoins()
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		if !slices.Contains(session.AllowedMsgs, anyMsg.TypeUrl) {
+			return nil, fmt.Errorf("message %s is not allowed by the session", anyMsg.TypeUrl)
+		}
+		if !slices.Contains(spendAccountedMsgs, anyMsg.TypeUrl) {
+			return nil, fmt.Errorf("message %s is not accounted for by the session spend limit", anyMsg.TypeUrl)
+		}
+		coins, err := msgSpend(anyMsg, self)
+		if err != nil {
+			return nil, err
+		}
+		spent = spent.Add(coins...)
+	}
+
+	// the fee is paid by the first signer unless a payer or a granter is set
+	fee := msg.Tx.AuthInfo.Fee
+	if fee != nil && fee.Granter == "" && (fee.Payer == self || (fee.Payer == "" && msg.SignerIndex == 0)) {
+		spent = spent.Add(fee.Amount...)
+	}
+
+	left, hasNeg := session.SpendLimit.SafeSub(spent...)
+	if hasNeg {
+		return nil, fmt.Errorf("transaction spends %s, exceeding the session spend limit %s", spent, session.SpendLimit)
+	}
+	return left, nil
+}
+
+// msgSpend returns the coins sent by the account in the message. Only the
+// spendAccountedMsgs are accounted for, so the sessions can't allow the other
+// messages.
+func msgSpend(anyMsg *codectypes.Any, self string) (sdk.Coins, error) {
+	switch anyMsg.TypeUrl {
+	case codectypes.MsgTypeURL(&banktypes.MsgSend{}):
+		msg := &banktypes.MsgSend{}
+		if err := msg.Unmarshal(anyMsg.Value); err != nil {
+			return nil, err
+		}
+		if msg.FromAddress == self {
+			return msg.Amount, nil
+		}
+
+	case codectypes.MsgTypeURL(&banktypes.MsgMultiSend{}):
+		msg := &banktypes.MsgMultiSend{}
+		if err := msg.Unmarshal(anyMsg.Value); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, input := range msg.Inputs {
+			if input.Address == self {
+				spent = spent.Add(input.Coins...)
+			}
+		}
+		return spent, nil
+
+	case codectypes.MsgTypeURL(&accountsv1.MsgExecute{}):
+		msg := &accountsv1.MsgExecute{}
+		if err := msg.Unmarshal(anyMsg.Value); err != nil {
+			return nil, err
+		}
+		// a session key must not manage the session keys of the account
+		if msg.Target == self {
+			return nil, errors.New("session keys cannot execute messages on the account itself")
+		}
+		if msg.Sender == self {
+			return msg.Funds, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,214 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/subscription/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+var SUBSCRIPTION_ACCOUNT = "subscription-account"
+
+var (
+	PubKeyPrefix        = collections.NewPrefix(0)
+	SequencePrefix      = collections.NewPrefix(1)
+	SubscriptionsPrefix = collections.NewPrefix(2)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*Account)(nil)
+)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			Subscriptions:   collections.NewMap(deps.SchemaBuilder, SubscriptionsPrefix, "subscriptions", collections.BytesKey, codec.CollValue[v1.Subscription](deps.LegacyStateCodec)),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+			eventService:    deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements an account whose owner authorizes payees to pull up to a
+// given amount of coins from the account every period, for example to pay for
+// a subscription. The owner signs the transactions of the account with a
+// secp256k1 key, like a base account.
+type Account struct {
+	PubKey   collections.Item[secp256k1.PubKey]
+	Sequence collections.Sequence
+
+	Subscriptions collections.Map[[]byte, v1.Subscription] // key: payee address
+
+	addrCodec    address.Codec
+	hs           header.Service
+	eventService event.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+// Init initializes the account with the pubkey of its owner.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+}
+
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+}
+
+func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
+	_, err := dcrd_secp256k1.ParsePubKey(key)
+	if err != nil {
+		return err
+	}
+	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+}
+
+// Authenticate implements the authentication flow of an abstracted
+// subscription account, the transactions are signed by the pubkey of the owner.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	pubKey, signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := base.GetTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := base.ParseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signature := msg.Tx.Signatures[msg.SignerIndex]
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+
+	signerData, err := base.NewSignerData(ctx, a.addrCodec, a.hs.HeaderInfo(ctx).ChainID, wantSequence, &pk)
+	if err != nil {
+		return secp256k1.PubKey{}, signing.SignerData{}, err
+	}
+	return pk, signerData, nil
+}
+
+// QuerySequence returns the sequence of the account, used to authenticate its
+// transactions.
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+// QuerySubscription returns the subscription of a payee, with its period reset
+// if it ended before the current block time.
+func (a Account) QuerySubscription(ctx context.Context, msg *v1.QuerySubscription) (*v1.QuerySubscriptionResponse, error) {
+	payee, err := a.addrCodec.StringToBytes(msg.Payee)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := a.Subscriptions.Get(ctx, payee)
+	if err != nil {
+		return nil, err
+	}
+	tryResetPeriod(&sub, a.hs.HeaderInfo(ctx).Time)
+
+	return &v1.QuerySubscriptionResponse{Subscription: &sub}, nil
+}
+
+// QuerySubscriptions returns all the subscriptions of the account, with their
+// period reset if it ended before the current block time.
+func (a Account) QuerySubscriptions(ctx context.Context, _ *v1.QuerySubscriptions) (*v1.QuerySubscriptionsResponse, error) {
+	now := a.hs.HeaderInfo(ctx).Time
+
+	subscriptions := []*v1.PayeeSubscription{}
+	err := a.Subscriptions.Walk(ctx, nil, func(payee []byte, sub v1.Subscription) (stop bool, err error) {
+		payeeStr, err := a.addrCodec.BytesToString(payee)
+		if err != nil {
+			return true, err
+		}
+		tryResetPeriod(&sub, now)
+		subscriptions = append(subscriptions, &v1.PayeeSubscription{Payee: payeeStr, Subscription: &sub})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QuerySubscriptionsResponse{Subscriptions: subscriptions}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.Subscribe)
+	accountstd.RegisterExecuteHandler(builder, a.CancelSubscription)
+	accountstd.RegisterExecuteHandler(builder, a.Pull)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySubscription)
+	accountstd.RegisterQueryHandler(builder, a.QuerySubscriptions)
+}
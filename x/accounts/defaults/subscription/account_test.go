@@ -0,0 +1,339 @@
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/subscription/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const self = "mock_subscription_account"
+
+func setupSubscriptionAccount(t *testing.T, ss store.KVStoreService, timefn func() time.Time) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss, timefn)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("subscription", signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	subscriptionAcc := acc.(Account)
+	require.NoError(t, err)
+
+	return subscriptionAcc
+}
+
+func coins(amt int64, denom string) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(amt)))
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t, &[]transaction.Msg{})
+	acc := setupSubscriptionAccount(t, ss, time.Now)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: []byte("invalid_pk")})
+	require.ErrorContains(t, err, "malformed public key")
+
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, ss := newMockContext(t, &[]transaction.Msg{})
+	currentTime := time.Now()
+	acc := setupSubscriptionAccount(t, ss, func() time.Time { return currentTime })
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+
+	expired := currentTime.Add(-time.Second)
+
+	testcases := []struct {
+		name   string
+		sender string
+		msg    func(msg *v1.MsgSubscribe) *v1.MsgSubscribe
+		expErr string
+	}{
+		{
+			"success",
+			self,
+			func(msg *v1.MsgSubscribe) *v1.MsgSubscribe { return msg },
+			"",
+		},
+		{
+			"not the account itself",
+			"payee",
+			func(msg *v1.MsgSubscribe) *v1.MsgSubscribe { return msg },
+			"only the account itself can add a subscription",
+		},
+		{
+			"self as payee",
+			self,
+			func(msg *v1.MsgSubscribe) *v1.MsgSubscribe {
+				msg.Payee = self
+				return msg
+			},
+			"the account cannot be its own payee",
+		},
+		{
+			"empty period spend limit",
+			self,
+			func(msg *v1.MsgSubscribe) *v1.MsgSubscribe {
+				msg.PeriodSpendLimit = nil
+				return msg
+			},
+			"period spend limit cannot be empty",
+		},
+		{
+			"invalid period spend limit",
+			self,
+			func(msg *v1.MsgSubscribe) *v1.MsgSubscribe {
+				msg.PeriodSpendLimit = sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}}
+				return msg
+			},
+			"invalid period spend limit",
+		},
+		{
+			"zero period",
+			self,
+			func(msg *v1.MsgSubscribe) *v1.MsgSubscribe {
+				msg.Period = 0
+				return msg
+			},
+			"period must be greater than zero",
+		},
+		{
+			"expiration in the past",
+			self,
+			func(msg *v1.MsgSubscribe) *v1.MsgSubscribe {
+				msg.Expiration = &expired
+				return msg
+			},
+			"must be after the block time",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg(&v1.MsgSubscribe{
+				Payee:            "payee",
+				PeriodSpendLimit: coins(100, "stake"),
+				Period:           time.Hour,
+			})
+
+			_, err := acc.Subscribe(accountstd.SetSender(ctx, []byte(tc.sender)), msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := acc.QuerySubscription(ctx, &v1.QuerySubscription{Payee: "payee"})
+			require.NoError(t, err)
+			require.Equal(t, coins(100, "stake"), resp.Subscription.PeriodCanSpend)
+			require.True(t, resp.Subscription.PeriodReset.Equal(currentTime.Add(time.Hour)))
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	execMsgs := []transaction.Msg{}
+	ctx, ss := newMockContext(t, &execMsgs)
+	start := time.Now()
+	currentTime := start
+	acc := setupSubscriptionAccount(t, ss, func() time.Time { return currentTime })
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+
+	expiration := start.Add(90 * time.Minute)
+	_, err = acc.Subscribe(accountstd.SetSender(ctx, []byte(self)), &v1.MsgSubscribe{
+		Payee:            "payee",
+		PeriodSpendLimit: coins(100, "stake"),
+		Period:           time.Hour,
+	})
+	require.NoError(t, err)
+	_, err = acc.Subscribe(accountstd.SetSender(ctx, []byte(self)), &v1.MsgSubscribe{
+		Payee:            "expiring_payee",
+		PeriodSpendLimit: coins(100, "stake"),
+		Period:           time.Hour,
+		Expiration:       &expiration,
+	})
+	require.NoError(t, err)
+
+	payeeCtx := accountstd.SetSender(ctx, []byte("payee"))
+	pull := func(ctx context.Context, amount sdk.Coins) error {
+		_, err := acc.Pull(ctx, &v1.MsgPull{Amount: amount})
+		return err
+	}
+	canSpend := func(payee string) sdk.Coins {
+		resp, err := acc.QuerySubscription(ctx, &v1.QuerySubscription{Payee: payee})
+		require.NoError(t, err)
+		return resp.Subscription.PeriodCanSpend
+	}
+
+	// pull within the period limit
+	require.NoError(t, pull(payeeCtx, coins(60, "stake")))
+	require.Equal(t, []transaction.Msg{&banktypes.MsgSend{
+		FromAddress: self,
+		ToAddress:   "payee",
+		Amount:      coins(60, "stake"),
+	}}, execMsgs)
+	require.Equal(t, coins(40, "stake"), canSpend("payee"))
+
+	// pull over the period limit or in another denom
+	require.ErrorContains(t, pull(payeeCtx, coins(50, "stake")), "exceeds the amount left in the period")
+	require.ErrorContains(t, pull(payeeCtx, coins(10, "atom")), "exceeds the amount left in the period")
+	require.ErrorContains(t, pull(payeeCtx, nil), "amount cannot be empty")
+
+	// only payees can pull
+	require.ErrorContains(t, pull(accountstd.SetSender(ctx, []byte("someone")), coins(10, "stake")), "sender has no subscription")
+
+	// the limit is not refilled within the period
+	currentTime = start.Add(30 * time.Minute)
+	require.Equal(t, coins(40, "stake"), canSpend("payee"))
+	require.ErrorContains(t, pull(payeeCtx, coins(50, "stake")), "exceeds the amount left in the period")
+
+	// the limit is refilled once the period ends, without carrying over the
+	// unused amounts
+	currentTime = start.Add(3*time.Hour + 30*time.Minute)
+	require.Equal(t, coins(100, "stake"), canSpend("payee"))
+	require.ErrorContains(t, pull(payeeCtx, coins(101, "stake")), "exceeds the amount left in the period")
+	require.NoError(t, pull(payeeCtx, coins(100, "stake")))
+	require.Len(t, execMsgs, 2)
+
+	// the periods stay aligned on the creation of the subscription
+	resp, err := acc.QuerySubscription(ctx, &v1.QuerySubscription{Payee: "payee"})
+	require.NoError(t, err)
+	require.True(t, resp.Subscription.PeriodReset.Equal(start.Add(4*time.Hour)))
+	require.True(t, resp.Subscription.PeriodCanSpend.IsZero())
+
+	// expired subscriptions cannot be pulled
+	require.ErrorContains(t, pull(accountstd.SetSender(ctx, []byte("expiring_payee")), coins(10, "stake")), "subscription expired")
+	require.Len(t, execMsgs, 2)
+
+	subs, err := acc.QuerySubscriptions(ctx, &v1.QuerySubscriptions{})
+	require.NoError(t, err)
+	require.Len(t, subs.Subscriptions, 2)
+}
+
+func TestCancelSubscription(t *testing.T) {
+	ctx, ss := newMockContext(t, &[]transaction.Msg{})
+	acc := setupSubscriptionAccount(t, ss, time.Now)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+
+	for _, payee := range []string{"payee1", "payee2"} {
+		_, err = acc.Subscribe(accountstd.SetSender(ctx, []byte(self)), &v1.MsgSubscribe{
+			Payee:            payee,
+			PeriodSpendLimit: coins(100, "stake"),
+			Period:           time.Hour,
+		})
+		require.NoError(t, err)
+	}
+
+	// someone else cannot cancel a subscription
+	_, err = acc.CancelSubscription(accountstd.SetSender(ctx, []byte("payee2")), &v1.MsgCancelSubscription{Payee: "payee1"})
+	require.ErrorContains(t, err, "only the account itself or the payee can cancel a subscription")
+
+	// the owner and the payee can cancel a subscription
+	_, err = acc.CancelSubscription(accountstd.SetSender(ctx, []byte(self)), &v1.MsgCancelSubscription{Payee: "payee1"})
+	require.NoError(t, err)
+	_, err = acc.CancelSubscription(accountstd.SetSender(ctx, []byte("payee2")), &v1.MsgCancelSubscription{Payee: "payee2"})
+	require.NoError(t, err)
+
+	_, err = acc.CancelSubscription(accountstd.SetSender(ctx, []byte(self)), &v1.MsgCancelSubscription{Payee: "payee1"})
+	require.ErrorContains(t, err, "subscription of payee1 not found")
+
+	_, err = acc.Pull(accountstd.SetSender(ctx, []byte("payee1")), &v1.MsgPull{Amount: coins(10, "stake")})
+	require.ErrorContains(t, err, "sender has no subscription")
+
+	subs, err := acc.QuerySubscriptions(ctx, &v1.QuerySubscriptions{})
+	require.NoError(t, err)
+	require.Empty(t, subs.Subscriptions)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t, &[]transaction.Msg{})
+	acc := setupSubscriptionAccount(t, ss, time.Now)
+	ownerKey := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: ownerKey.PubKey().Bytes()})
+	require.NoError(t, err)
+
+	authenticate := func(privKey *secp256k1.PrivKey) error {
+		seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+		require.NoError(t, err)
+
+		transaction := tx.Tx{
+			Body: &tx.TxBody{},
+			AuthInfo: &tx.AuthInfo{
+				SignerInfos: []*tx.SignerInfo{
+					{
+						ModeInfo: &tx.ModeInfo{
+							Sum: &tx.ModeInfo_Single_{
+								Single: &tx.ModeInfo_Single{
+									Mode: 1,
+								},
+							},
+						},
+						Sequence: seq.Sequence,
+					},
+				},
+			},
+		}
+
+		bodyByte, err := transaction.Body.Marshal()
+		require.NoError(t, err)
+		authByte, err := transaction.AuthInfo.Marshal()
+		require.NoError(t, err)
+
+		txDoc := tx.SignDoc{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			ChainId:       "test",
+			AccountNumber: 1,
+		}
+		signBytes, err := txDoc.Marshal()
+		require.NoError(t, err)
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		transaction.Signatures = [][]byte{sig}
+
+		_, err = acc.Authenticate(accountstd.SetSender(ctx, address.Module("accounts")), &aa_interface_v1.MsgAuthenticate{
+			RawTx: &tx.TxRaw{
+				BodyBytes:     bodyByte,
+				AuthInfoBytes: authByte,
+				Signatures:    transaction.Signatures,
+			},
+			Tx:          &transaction,
+			SignerIndex: 0,
+		})
+		return err
+	}
+
+	require.NoError(t, authenticate(ownerKey))
+
+	// payees cannot sign the transactions of the account
+	payeeKey := secp256k1.GenPrivKey()
+	require.ErrorContains(t, authenticate(payeeKey), "signature verification failed")
+
+	// the owner rotates its key
+	newKey := secp256k1.GenPrivKey()
+	_, err = acc.SwapPubKey(accountstd.SetSender(ctx, []byte(self)), &v1.MsgSwapPubKey{NewPubKey: newKey.PubKey().Bytes()})
+	require.NoError(t, err)
+
+	require.ErrorContains(t, authenticate(ownerKey), "signature verification failed")
+	require.NoError(t, authenticate(newKey))
+}
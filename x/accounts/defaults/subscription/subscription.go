@@ -0,0 +1,179 @@
+package subscription
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/subscription/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+)
+
+// Subscribe authorizes a payee to pull coins from the account every period,
+// replacing the subscription of the payee if any. It can only be executed by
+// the account itself, i.e. the owner.
+func (a Account) Subscribe(ctx context.Context, msg *v1.MsgSubscribe) (*v1.MsgSubscribeResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("only the account itself can add a subscription")
+	}
+
+	payee, err := a.addrCodec.StringToBytes(msg.Payee)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(payee, accountstd.Whoami(ctx)) {
+		return nil, errors.New("the account cannot be its own payee")
+	}
+
+	if msg.PeriodSpendLimit.Empty() {
+		return nil, errors.New("period spend limit cannot be empty")
+	}
+	if err := msg.PeriodSpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid period spend limit: %w", err)
+	}
+	if msg.Period <= 0 {
+		return nil, errors.New("period must be greater than zero")
+	}
+
+	now := a.hs.HeaderInfo(ctx).Time
+	if msg.Expiration != nil && !msg.Expiration.After(now) {
+		return nil, fmt.Errorf("expiration %s must be after the block time %s", msg.Expiration, now)
+	}
+
+	// the first period starts now
+	sub := v1.Subscription{
+		PeriodSpendLimit: msg.PeriodSpendLimit,
+		Period:           msg.Period,
+		PeriodCanSpend:   msg.PeriodSpendLimit,
+		PeriodReset:      now.Add(msg.Period),
+		Expiration:       msg.Expiration,
+	}
+	if err := a.Subscriptions.Set(ctx, payee, sub); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("subscription_added",
+		event.NewAttribute("payee", msg.Payee),
+		event.NewAttribute("period_spend_limit", msg.PeriodSpendLimit.String()),
+		event.NewAttribute("period", msg.Period.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSubscribeResponse{}, nil
+}
+
+// CancelSubscription deletes the subscription of a payee, it can be executed by
+// the account itself or by the payee.
+func (a Account) CancelSubscription(ctx context.Context, msg *v1.MsgCancelSubscription) (*v1.MsgCancelSubscriptionResponse, error) {
+	payee, err := a.addrCodec.StringToBytes(msg.Payee)
+	if err != nil {
+		return nil, err
+	}
+
+	if !accountstd.SenderIsSelf(ctx) && !accountstd.HasSender(ctx, payee) {
+		return nil, errors.New("only the account itself or the payee can cancel a subscription")
+	}
+
+	has, err := a.Subscriptions.Has(ctx, payee)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("subscription of %s not found", msg.Payee)
+	}
+
+	if err := a.Subscriptions.Remove(ctx, payee); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("subscription_canceled",
+		event.NewAttribute("payee", msg.Payee),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelSubscriptionResponse{}, nil
+}
+
+// Pull sends coins from the account to the payee sending the message, within
+// the amount left in the current period of its subscription.
+func (a Account) Pull(ctx context.Context, msg *v1.MsgPull) (*v1.MsgPullResponse, error) {
+	payee := accountstd.Sender(ctx)
+
+	sub, err := a.Subscriptions.Get(ctx, payee)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errors.New("sender has no subscription")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Empty() {
+		return nil, errors.New("amount cannot be empty")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	now := a.hs.HeaderInfo(ctx).Time
+	if sub.Expiration != nil && !now.Before(*sub.Expiration) {
+		return nil, fmt.Errorf("subscription expired at %s", sub.Expiration)
+	}
+
+	tryResetPeriod(&sub, now)
+
+	left, isNeg := sub.PeriodCanSpend.SafeSub(msg.Amount...)
+	if isNeg {
+		return nil, fmt.Errorf("pull of %s exceeds the amount left in the period %s", msg.Amount, sub.PeriodCanSpend)
+	}
+	sub.PeriodCanSpend = left
+	if err := a.Subscriptions.Set(ctx, payee, sub); err != nil {
+		return nil, err
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	payeeStr, err := a.addrCodec.BytesToString(payee)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = accountstd.ExecModule(ctx, &banktypes.MsgSend{
+		FromAddress: self,
+		ToAddress:   payeeStr,
+		Amount:      msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("subscription_pulled",
+		event.NewAttribute("payee", payeeStr),
+		event.NewAttribute("amount", msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgPullResponse{}, nil
+}
+
+// tryResetPeriod refills the amount the payee can pull once the current period
+// has ended. Unlike x/feegrant periodic allowances, the periods stay aligned on
+// the creation of the subscription when the payee skips some of them, and the
+// unused amounts are not carried over.
+func tryResetPeriod(sub *v1.Subscription, now time.Time) {
+	if now.Before(sub.PeriodReset) {
+		return
+	}
+
+	sub.PeriodCanSpend = sub.PeriodSpendLimit
+	elapsedPeriods := now.Sub(sub.PeriodReset)/sub.Period + 1
+	sub.PeriodReset = sub.PeriodReset.Add(elapsedPeriods * sub.Period)
+}
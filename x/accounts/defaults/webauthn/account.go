@@ -0,0 +1,257 @@
+package webauthn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	secp256r1v1 "cosmossdk.io/api/cosmos/crypto/secp256r1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+var WEBAUTHN_ACCOUNT = "webauthn-account"
+
+var (
+	PubKeyPrefix    = collections.NewPrefix(0)
+	SequencePrefix  = collections.NewPrefix(1)
+	RpIDPrefix      = collections.NewPrefix(2)
+	OriginPrefix    = collections.NewPrefix(3)
+	SignCountPrefix = collections.NewPrefix(4)
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*Account)(nil)
+)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256r1.PubKey](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			RpID:            collections.NewItem(deps.SchemaBuilder, RpIDPrefix, "rp_id", collections.StringValue),
+			Origin:          collections.NewItem(deps.SchemaBuilder, OriginPrefix, "origin", collections.StringValue),
+			SignCount:       collections.NewItem(deps.SchemaBuilder, SignCountPrefix, "sign_count", collections.Uint32Value),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by WebAuthn assertions of a
+// passkey, i.e. a secp256r1 key held by the authenticator of a device.
+type Account struct {
+	PubKey   collections.Item[secp256r1.PubKey]
+	Sequence collections.Sequence
+	// RpID is the relying party id the passkey credential is scoped to.
+	RpID collections.Item[string]
+	// Origin is the origin of the assertions.
+	Origin collections.Item[string]
+	// SignCount is the signature counter of the last assertion, used to detect
+	// cloned authenticators.
+	SignCount collections.Item[uint32]
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+// credential is the passkey credential of the account.
+type credential struct {
+	pubKey    *secp256r1.PubKey
+	rpID      string
+	origin    string
+	signCount uint32
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.RpId == "" {
+		return nil, errors.New("rp id must be specified")
+	}
+	if msg.Origin == "" {
+		return nil, errors.New("origin must be specified")
+	}
+	if err := a.RpID.Set(ctx, msg.RpId); err != nil {
+		return nil, err
+	}
+	if err := a.Origin.Set(ctx, msg.Origin); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+}
+
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+}
+
+// verifyAndSetPubKey sets the pubkey of a new passkey, whose signature counter
+// starts over.
+func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
+	pubKey, err := parsePubKey(key)
+	if err != nil {
+		return err
+	}
+	if err := a.SignCount.Set(ctx, 0); err != nil {
+		return err
+	}
+	return a.PubKey.Set(ctx, *pubKey)
+}
+
+// parsePubKey parses a compressed secp256r1 pubkey.
+func parsePubKey(key []byte) (*secp256r1.PubKey, error) {
+	bz, err := proto.Marshal(&secp256r1v1.PubKey{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	pubKey := &secp256r1.PubKey{}
+	if err := pubKey.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+// Authenticate implements the authentication flow of an abstracted webauthn account.
+// The signature of the transaction is an encoded v1.Assertion whose challenge
+// is the SHA-256 hash of the sign bytes of the transaction.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	cred, signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := base.GetTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := base.ParseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	assertion := &v1.Assertion{}
+	if err := assertion.Unmarshal(msg.Tx.Signatures[msg.SignerIndex]); err != nil {
+		return nil, fmt.Errorf("invalid webauthn assertion: %w", err)
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	signCount, err := verifyAssertion(assertion, signBytes, cred)
+	if err != nil {
+		return nil, err
+	}
+	if signCount != 0 {
+		if err := a.SignCount.Set(ctx, signCount); err != nil {
+			return nil, err
+		}
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// getCredential returns the passkey credential of the account.
+func (a Account) getCredential(ctx context.Context) (credential, error) {
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return credential{}, err
+	}
+	rpID, err := a.RpID.Get(ctx)
+	if err != nil {
+		return credential{}, err
+	}
+	origin, err := a.Origin.Get(ctx)
+	if err != nil {
+		return credential{}, err
+	}
+	signCount, err := a.SignCount.Get(ctx)
+	if err != nil {
+		return credential{}, err
+	}
+
+	return credential{pubKey: &pk, rpID: rpID, origin: origin, signCount: signCount}, nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context) (credential, signing.SignerData, error) {
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return credential{}, signing.SignerData{}, err
+	}
+
+	cred, err := a.getCredential(ctx)
+	if err != nil {
+		return credential{}, signing.SignerData{}, err
+	}
+
+	signerData, err := base.NewSignerData(ctx, a.addrCodec, a.hs.HeaderInfo(ctx).ChainID, wantSequence, cred.pubKey)
+	if err != nil {
+		return credential{}, signing.SignerData{}, err
+	}
+	return cred, signerData, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryCredential(ctx context.Context, _ *v1.QueryCredential) (*v1.QueryCredentialResponse, error) {
+	cred, err := a.getCredential(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryCredentialResponse{
+		PubKey:    cred.pubKey.Bytes(),
+		RpId:      cred.rpID,
+		Origin:    cred.origin,
+		SignCount: cred.signCount,
+	}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryCredential)
+}
@@ -0,0 +1,366 @@
+package webauthn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	testRpID   = "example.com"
+	testOrigin = "https://example.com"
+)
+
+func setupWebAuthnAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("webauthn", signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	webAuthnAcc := acc.(Account)
+	require.NoError(t, err)
+
+	return webAuthnAcc
+}
+
+// passkey mocks the authenticator holding the secp256r1 key of a credential.
+type passkey struct {
+	key       *ecdsa.PrivateKey
+	rpID      string
+	origin    string
+	signCount uint32
+	// challenge overrides the challenge derived from the sign bytes.
+	challenge []byte
+}
+
+func newPasskey(t *testing.T) *passkey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &passkey{key: key, rpID: testRpID, origin: testOrigin}
+}
+
+func (p *passkey) pubKey() []byte {
+	return elliptic.MarshalCompressed(elliptic.P256(), p.key.X, p.key.Y)
+}
+
+// assert returns the encoded assertion of the passkey for the given sign bytes.
+func (p *passkey) assert(t *testing.T, signBytes []byte) []byte {
+	t.Helper()
+	challenge := sha256.Sum256(signBytes)
+	if p.challenge != nil {
+		copy(challenge[:], p.challenge)
+	}
+	clientDataJSON, err := json.Marshal(clientData{
+		Type:      clientDataTypeGet,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge[:]),
+		Origin:    p.origin,
+	})
+	require.NoError(t, err)
+
+	rpIDHash := sha256.Sum256([]byte(p.rpID))
+	authData := append(rpIDHash[:], flagUserPresent)
+	authData = binary.BigEndian.AppendUint32(authData, p.signCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, p.key, digest[:])
+	require.NoError(t, err)
+
+	assertion := &v1.Assertion{
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}
+	bz, err := assertion.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+
+	testcases := []struct {
+		name     string
+		msg      *v1.MsgInit
+		isExpErr bool
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{
+				PubKey: newPasskey(t).pubKey(),
+				RpId:   testRpID,
+				Origin: testOrigin,
+			},
+			false,
+		},
+		{
+			"missing origin",
+			&v1.MsgInit{
+				PubKey: newPasskey(t).pubKey(),
+				RpId:   testRpID,
+			},
+			true,
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{
+				PubKey: []byte("invalid_pk"),
+				RpId:   testRpID,
+				Origin: testOrigin,
+			},
+			true,
+		},
+		{
+			"missing rp id",
+			&v1.MsgInit{
+				PubKey: newPasskey(t).pubKey(),
+				Origin: testOrigin,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.isExpErr {
+				require.NotNil(t, err, tc.name)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := acc.QueryCredential(ctx, &v1.QueryCredential{})
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.PubKey, resp.PubKey)
+			require.Equal(t, tc.msg.RpId, resp.RpId)
+			require.Equal(t, tc.msg.Origin, resp.Origin)
+			require.Equal(t, uint32(0), resp.SignCount)
+		})
+	}
+}
+
+func TestSwapKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey: newPasskey(t).pubKey(),
+		RpId:   testRpID,
+		Origin: testOrigin,
+	})
+	require.NoError(t, err)
+	require.NoError(t, acc.SignCount.Set(ctx, 10))
+
+	testcases := []struct {
+		name     string
+		genCtx   func(ctx context.Context) context.Context
+		msg      *v1.MsgSwapPubKey
+		isExpErr bool
+		expErr   error
+	}{
+		{
+			"invalid transaction, sender is not self",
+			func(ctx context.Context) context.Context {
+				return accountstd.SetSender(ctx, []byte("sender"))
+			},
+			&v1.MsgSwapPubKey{
+				NewPubKey: newPasskey(t).pubKey(),
+			},
+			true,
+			errors.New("unauthorized"),
+		},
+		{
+			"invalid transaction, invalid pubkey",
+			func(ctx context.Context) context.Context {
+				return accountstd.SetSender(ctx, []byte("mock_webauthn_account"))
+			},
+			&v1.MsgSwapPubKey{
+				NewPubKey: []byte("invalid_pk"),
+			},
+			true,
+			nil,
+		},
+		{
+			"valid transaction",
+			func(ctx context.Context) context.Context {
+				return accountstd.SetSender(ctx, []byte("mock_webauthn_account"))
+			},
+			&v1.MsgSwapPubKey{
+				NewPubKey: newPasskey(t).pubKey(),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.genCtx != nil {
+				ctx = tc.genCtx(ctx)
+			}
+			_, err := acc.SwapPubKey(ctx, tc.msg)
+			if tc.isExpErr {
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, err, tc.name)
+				} else {
+					require.NotNil(t, err, tc.name)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			// the sign count of the new passkey starts over
+			resp, err := acc.QueryCredential(ctx, &v1.QueryCredential{})
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.NewPubKey, resp.PubKey)
+			require.Equal(t, uint32(0), resp.SignCount)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	key := newPasskey(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey: key.pubKey(),
+		RpId:   testRpID,
+		Origin: testOrigin,
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	// authenticate builds a transaction with the given sequence, signed by the
+	// passkey after applying the given mutation to it.
+	authenticate := func(sequence uint64, mutate func(p passkey) passkey) error {
+		transaction := tx.Tx{
+			Body: &tx.TxBody{},
+			AuthInfo: &tx.AuthInfo{
+				SignerInfos: []*tx.SignerInfo{
+					{
+						ModeInfo: &tx.ModeInfo{
+							Sum: &tx.ModeInfo_Single_{
+								Single: &tx.ModeInfo_Single{
+									Mode: 1,
+								},
+							},
+						},
+						Sequence: sequence,
+					},
+				},
+			},
+		}
+
+		bodyByte, err := transaction.Body.Marshal()
+		require.NoError(t, err)
+		authByte, err := transaction.AuthInfo.Marshal()
+		require.NoError(t, err)
+
+		txDoc := tx.SignDoc{
+			BodyBytes:     bodyByte,
+			AuthInfoBytes: authByte,
+			ChainId:       "test",
+			AccountNumber: 1,
+		}
+		signBytes, err := txDoc.Marshal()
+		require.NoError(t, err)
+
+		signer := *key
+		if mutate != nil {
+			signer = mutate(signer)
+		}
+		transaction.Signatures = [][]byte{signer.assert(t, signBytes)}
+
+		_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+			RawTx: &tx.TxRaw{
+				BodyBytes:     bodyByte,
+				AuthInfoBytes: authByte,
+				Signatures:    transaction.Signatures,
+			},
+			Tx:          &transaction,
+			SignerIndex: 0,
+		})
+		return err
+	}
+
+	// authenticators without signature counters always use 0
+	require.NoError(t, authenticate(0, nil))
+
+	key.signCount = 5
+	require.NoError(t, authenticate(1, nil))
+	resp, err := acc.QueryCredential(ctx, &v1.QueryCredential{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), resp.SignCount)
+
+	testcases := []struct {
+		name   string
+		mutate func(p passkey) passkey
+	}{
+		{
+			"replayed sign count",
+			func(p passkey) passkey { return p },
+		},
+		{
+			"wrong rp id",
+			func(p passkey) passkey {
+				p.signCount++
+				p.rpID = "evil.com"
+				return p
+			},
+		},
+		{
+			"wrong origin",
+			func(p passkey) passkey {
+				p.signCount++
+				p.origin = "https://evil.com"
+				return p
+			},
+		},
+		{
+			"challenge of other sign bytes",
+			func(p passkey) passkey {
+				p.signCount++
+				challenge := sha256.Sum256([]byte("other_sign_bytes"))
+				p.challenge = challenge[:]
+				return p
+			},
+		},
+		{
+			"wrong key",
+			func(p passkey) passkey {
+				p.signCount++
+				p.key = newPasskey(t).key
+				return p
+			},
+		},
+	}
+
+	sequence := uint64(2)
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, authenticate(sequence, tc.mutate))
+			sequence++
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+)
+
+const (
+	// clientDataTypeGet is the type of the client data of an assertion.
+	clientDataTypeGet = "webauthn.get"
+
+	// authenticatorDataMinLen is the length of the rpIdHash, flags and signCount
+	// fields of the authenticator data, which are followed by the optional
+	// extensions.
+	authenticatorDataMinLen = sha256.Size + 1 + 4
+
+	// flagUserPresent is the flag of the authenticator data set when the user was
+	// present during the assertion.
+	flagUserPresent = 0x01
+)
+
+// clientData is the client data of an assertion, of which only the fields
+// checked by the account are decoded.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// authenticatorData is the authenticator data of an assertion.
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+}
+
+func parseAuthenticatorData(bz []byte) (authenticatorData, error) {
+	if len(bz) < authenticatorDataMinLen {
+		return authenticatorData{}, fmt.Errorf("authenticator data is too short: %d bytes", len(bz))
+	}
+
+	return authenticatorData{
+		rpIDHash:  bz[:sha256.Size],
+		flags:     bz[sha256.Size],
+		signCount: binary.BigEndian.Uint32(bz[sha256.Size+1 : authenticatorDataMinLen]),
+	}, nil
+}
+
+// verifyAssertion verifies that the assertion was made by the passkey of the
+// credential for the given sign bytes, and returns its signature counter.
+// The challenge of the assertion must be the SHA-256 hash of the sign bytes.
+func verifyAssertion(assertion *v1.Assertion, signBytes []byte, credential credential) (uint32, error) {
+	var data clientData
+	if err := json.Unmarshal(assertion.ClientDataJson, &data); err != nil {
+		return 0, fmt.Errorf("invalid client data: %w", err)
+	}
+	if data.Type != clientDataTypeGet {
+		return 0, fmt.Errorf("unexpected client data type: %s", data.Type)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(data.Challenge)
+	if err != nil {
+		return 0, fmt.Errorf("invalid client data challenge: %w", err)
+	}
+	signBytesHash := sha256.Sum256(signBytes)
+	if !bytes.Equal(challenge, signBytesHash[:]) {
+		return 0, errors.New("client data challenge does not match the sign bytes")
+	}
+	if data.Origin != credential.origin {
+		return 0, fmt.Errorf("unexpected client data origin: %s", data.Origin)
+	}
+
+	authData, err := parseAuthenticatorData(assertion.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+	rpIDHash := sha256.Sum256([]byte(credential.rpID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return 0, errors.New("authenticator data rp id hash does not match the rp id")
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return 0, errors.New("user was not present during the assertion")
+	}
+	// authenticators which do not support signature counters always return 0
+	if authData.signCount != 0 && authData.signCount <= credential.signCount {
+		return 0, fmt.Errorf("signature counter %d is not greater than %d, the authenticator may be cloned", authData.signCount, credential.signCount)
+	}
+
+	sig, err := rawSignature(assertion.Signature)
+	if err != nil {
+		return 0, err
+	}
+	clientDataHash := sha256.Sum256(assertion.ClientDataJson)
+	signedData := append(bytes.Clone(assertion.AuthenticatorData), clientDataHash[:]...)
+	if !credential.pubKey.VerifySignature(signedData, sig) {
+		return 0, errors.New("signature verification failed")
+	}
+
+	return authData.signCount, nil
+}
+
+// rawSignature converts an ASN.1 DER encoded ECDSA signature into the R || S
+// encoding verified by the secp256r1 keys, with the S component normalized to
+// the lower half of the curve order, which authenticators do not enforce.
+func rawSignature(der []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("invalid signature: trailing bytes")
+	}
+
+	order := elliptic.P256().Params().N
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.Cmp(order) >= 0 || sig.S.Cmp(order) >= 0 {
+		return nil, errors.New("invalid signature: out of range")
+	}
+	if sig.S.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+		sig.S = new(big.Int).Sub(order, sig.S)
+	}
+
+	raw := make([]byte, 64)
+	sig.R.FillBytes(raw[:32])
+	sig.S.FillBytes(raw[32:])
+	return raw, nil
+}
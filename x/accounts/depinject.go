@@ -13,6 +13,10 @@ import (
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	"cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/multisig"
+	recoveryaccount "cosmossdk.io/x/accounts/defaults/recovery"
+	sessionkeyaccount "cosmossdk.io/x/accounts/defaults/sessionkey"
+	subscriptionaccount "cosmossdk.io/x/accounts/defaults/subscription"
+	webauthnaccount "cosmossdk.io/x/accounts/defaults/webauthn"
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -72,6 +76,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		accountstd.AddAccount(multisig.MULTISIG_ACCOUNT, multisig.NewAccount),
+		webauthnaccount.NewAccount(webauthnaccount.WEBAUTHN_ACCOUNT, signing.NewHandlerMap(handler)),
+		sessionkeyaccount.NewAccount(sessionkeyaccount.SESSION_KEY_ACCOUNT, signing.NewHandlerMap(handler)),
+		recoveryaccount.NewAccount(recoveryaccount.SOCIAL_RECOVERY_ACCOUNT, signing.NewHandlerMap(handler)),
+		subscriptionaccount.NewAccount(subscriptionaccount.SUBSCRIPTION_ACCOUNT, signing.NewHandlerMap(handler)),
 	)
 	if err != nil {
 		panic(err)
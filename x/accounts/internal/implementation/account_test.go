@@ -8,7 +8,7 @@ import (
 	"cosmossdk.io/collections"
 )
 
-var _ Account = (*TestAccount)(nil)
+var _ MigratableAccount = (*TestAccount)(nil)
 
 var itemPrefix = collections.NewPrefix([]byte{0})
 
@@ -30,6 +30,13 @@ func (TestAccount) RegisterInitHandler(builder *InitBuilder) {
 	})
 }
 
+func (TestAccount) RegisterMigrateHandler(builder *MigrateBuilder) {
+	builder.AllowMigrationTo("test-v2")
+	RegisterMigrateHandler(builder, func(_ context.Context, req *types.StringValue) (*types.StringValue, error) {
+		return &types.StringValue{Value: req.Value + "migrate-echo"}, nil
+	})
+}
+
 func (t TestAccount) RegisterExecuteHandlers(builder *ExecuteBuilder) {
 	RegisterExecuteHandler(builder, func(_ context.Context, req *types.StringValue) (*types.StringValue, error) {
 		return &types.StringValue{Value: req.Value + "execute-echo"}, nil
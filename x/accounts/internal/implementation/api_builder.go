@@ -11,6 +11,7 @@ import (
 var (
 	errNoInitHandler    = errors.New("no init handler")
 	errNoExecuteHandler = errors.New("account does not accept messages")
+	errNoMigrateHandler = errors.New("account does not accept migrations")
 	errInvalidMessage   = errors.New("invalid message")
 )
 
@@ -39,6 +40,43 @@ func (i *InitBuilder) makeHandler() (func(ctx context.Context, initRequest trans
 	return i.handler, nil
 }
 
+// NewMigrateBuilder creates a new MigrateBuilder instance.
+func NewMigrateBuilder() *MigrateBuilder {
+	return &MigrateBuilder{}
+}
+
+// MigrateBuilder defines a smart account's migration handler builder.
+type MigrateBuilder struct {
+	// handler is the handler function that will be called when an account of another type
+	// is migrated to the smart account. The handler runs against the state of the migrated
+	// account, so it can read the state written by the previous implementation.
+	handler func(ctx context.Context, migrateRequest transaction.Msg) (migrateResponse transaction.Msg, err error)
+
+	// schema is the schema of the message that will be passed to the handler function.
+	schema HandlerSchema
+
+	// targets are the account types the smart account can be migrated to.
+	targets []string
+}
+
+// AllowMigrationTo allows the accounts of the smart account to be migrated to the given
+// account types. Accounts cannot be migrated to an account type which is not allowed,
+// even when they are the sender of the migration.
+func (m *MigrateBuilder) AllowMigrationTo(accountTypes ...string) {
+	m.targets = append(m.targets, accountTypes...)
+}
+
+// makeHandler returns the handler function that will be called when an account is migrated
+// to the smart account. If no handler was registered, the account does not accept migrations.
+func (m *MigrateBuilder) makeHandler() func(ctx context.Context, migrateRequest transaction.Msg) (migrateResponse transaction.Msg, err error) {
+	if m.handler == nil {
+		return func(_ context.Context, _ transaction.Msg) (transaction.Msg, error) {
+			return nil, errNoMigrateHandler
+		}
+	}
+	return m.handler
+}
+
 // NewExecuteBuilder creates a new ExecuteBuilder instance.
 func NewExecuteBuilder() *ExecuteBuilder {
 	return &ExecuteBuilder{
@@ -1,6 +1,7 @@
 package implementation
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 
@@ -110,6 +111,31 @@ func QueryModule(ctx context.Context, req transaction.Msg) (transaction.Msg, err
 // openKVStore returns the prefixed store for the account given the context.
 func openKVStore(ctx context.Context) store.KVStore { return getCtx(ctx).store }
 
+// ClearState removes the state of the account stored under the prefix, or all of
+// it if the prefix is empty.
+func ClearState(ctx context.Context, prefix []byte) error {
+	kv := openKVStore(ctx)
+	it, err := kv.Iterator(prefix, nil)
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid() && bytes.HasPrefix(it.Key(), prefix); it.Next() {
+		keys = append(keys, bytes.Clone(it.Key()))
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := kv.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Sender returns the address of the entity invoking the account action.
 func Sender(ctx context.Context) []byte {
 	return getCtx(ctx).sender
@@ -65,3 +65,38 @@ func TestMakeAccountContext(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, Equal(&types.StringValue{Value: "module query was called"}, resp))
 }
+
+func TestClearState(t *testing.T) {
+	originalContext := coretesting.Context()
+	storeService := coretesting.KVStoreService(originalContext, "test")
+	accountCtx := MakeAccountContext(originalContext, storeService, 1, []byte("accountAddr"), nil, nil, nil, nil)
+	otherCtx := MakeAccountContext(originalContext, storeService, 2, []byte("otherAddr"), nil, nil, nil, nil)
+
+	for _, ctx := range []context.Context{accountCtx, otherCtx} {
+		kv := openKVStore(ctx)
+		require.NoError(t, kv.Set([]byte{1, 0}, []byte("a")))
+		require.NoError(t, kv.Set([]byte{1, 1}, []byte("b")))
+		require.NoError(t, kv.Set([]byte{2, 0}, []byte("c")))
+	}
+
+	// only the state of the account under the prefix is removed
+	require.NoError(t, ClearState(accountCtx, []byte{1}))
+	kv := openKVStore(accountCtx)
+	for key, has := range map[string]bool{"\x01\x00": false, "\x01\x01": false, "\x02\x00": true} {
+		got, err := kv.Has([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, has, got, "key %X", key)
+	}
+	got, err := openKVStore(otherCtx).Has([]byte{1, 0})
+	require.NoError(t, err)
+	require.True(t, got)
+
+	// an empty prefix removes all the state of the account
+	require.NoError(t, ClearState(accountCtx, nil))
+	got, err = kv.Has([]byte{2, 0})
+	require.NoError(t, err)
+	require.False(t, got)
+	got, err = openKVStore(otherCtx).Has([]byte{2, 0})
+	require.NoError(t, err)
+	require.True(t, got)
+}
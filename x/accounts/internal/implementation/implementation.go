@@ -88,6 +88,13 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 		return Implementation{}, err
 	}
 
+	// make migrate handler and targets, if the account takes part in migrations
+	mr := NewMigrateBuilder()
+	if migratable, ok := account.(MigratableAccount); ok {
+		migratable.RegisterMigrateHandler(mr)
+	}
+	migrateHandler := mr.makeHandler()
+
 	// build schema
 	schema, err := schemaBuilder.Build()
 	if err != nil {
@@ -97,8 +104,11 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 		Init:                  initHandler,
 		Execute:               executeHandler,
 		Query:                 queryHandler,
+		Migrate:               migrateHandler,
 		CollectionsSchema:     schema,
 		InitHandlerSchema:     ir.schema,
+		MigrateHandlerSchema:  mr.schema,
+		MigrationTargets:      mr.targets,
 		QueryHandlersSchema:   qr.er.handlersSchema,
 		ExecuteHandlersSchema: er.handlersSchema,
 	}, nil
@@ -113,10 +123,17 @@ type Implementation struct {
 	Execute func(ctx context.Context, msg transaction.Msg) (resp transaction.Msg, err error)
 	// Query defines the query handler for the smart account.
 	Query func(ctx context.Context, msg transaction.Msg) (resp transaction.Msg, err error)
+	// Migrate defines the migration handler for the smart account.
+	Migrate func(ctx context.Context, msg transaction.Msg) (resp transaction.Msg, err error)
 	// CollectionsSchema represents the state schema.
 	CollectionsSchema collections.Schema
 	// InitHandlerSchema represents the init handler schema.
 	InitHandlerSchema HandlerSchema
+	// MigrateHandlerSchema represents the migrate handler schema, it is empty
+	// if the account does not accept migrations.
+	MigrateHandlerSchema HandlerSchema
+	// MigrationTargets are the account types the smart account can be migrated to.
+	MigrationTargets []string
 	// QueryHandlersSchema is the schema of the query handlers.
 	QueryHandlersSchema map[string]HandlerSchema
 	// ExecuteHandlersSchema is the schema of the execute handlers.
@@ -140,6 +157,12 @@ func (i Implementation) HasInit(m transaction.Msg) bool {
 	return i.InitHandlerSchema.RequestSchema.Name == MessageName(m)
 }
 
+// HasMigrate returns true if other accounts can be migrated to the account
+// using the provided migrate message.
+func (i Implementation) HasMigrate(m transaction.Msg) bool {
+	return i.MigrateHandlerSchema.RequestSchema.Name == MessageName(m)
+}
+
 // MessageSchema defines the schema of a message.
 // A message can also define a state schema.
 type MessageSchema struct {
@@ -57,6 +57,32 @@ func TestImplementation(t *testing.T) {
 		require.ErrorIs(t, err, errInvalidMessage)
 	})
 
+	t.Run("migrate ok", func(t *testing.T) {
+		resp, err := impl.Migrate(ctx, &types.StringValue{Value: "test"})
+		require.NoError(t, err)
+		require.Equal(t, "testmigrate-echo", resp.(*types.StringValue).Value)
+	})
+
+	t.Run("migrate - unknown message", func(t *testing.T) {
+		_, err := impl.Migrate(ctx, &types.Int32Value{Value: 1})
+		require.ErrorIs(t, err, errInvalidMessage)
+	})
+
+	t.Run("migrate - no migrate handler", func(t *testing.T) {
+		// embedding the Account interface hides the migrate handler of TestAccount.
+		noMigrateImpl, err := newImplementation(collections.NewSchemaBuilderFromAccessor(openKVStore), struct{ Account }{TestAccount{}})
+		require.NoError(t, err)
+
+		_, err = noMigrateImpl.Migrate(ctx, &types.StringValue{Value: "test"})
+		require.ErrorIs(t, err, errNoMigrateHandler)
+		require.False(t, noMigrateImpl.HasMigrate(&types.StringValue{}))
+		require.Empty(t, noMigrateImpl.MigrationTargets)
+	})
+
+	t.Run("migration targets", func(t *testing.T) {
+		require.Equal(t, []string{"test-v2"}, impl.MigrationTargets)
+	})
+
 	t.Run("Has* methods", func(t *testing.T) {
 		ok := impl.HasExec(&types.StringValue{})
 		require.True(t, ok)
@@ -75,5 +101,11 @@ func TestImplementation(t *testing.T) {
 
 		ok = impl.HasInit(&types.Duration{})
 		require.False(t, ok)
+
+		ok = impl.HasMigrate(&types.StringValue{})
+		require.True(t, ok)
+
+		ok = impl.HasMigrate(&types.Duration{})
+		require.False(t, ok)
 	})
 }
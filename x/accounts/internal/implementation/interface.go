@@ -15,3 +15,15 @@ type Account interface {
 	// might also decide to not register any query handler.
 	RegisterQueryHandlers(builder *QueryBuilder)
 }
+
+// MigratableAccount defines a smart account which takes part in migrations.
+type MigratableAccount interface {
+	Account
+
+	// RegisterMigrateHandler allows the smart account to register a migration handler, using
+	// the provided MigrateBuilder. The handler will be called when an account of another type
+	// is migrated to the smart account. The smart account also allows, using the builder, the
+	// account types its accounts can be migrated to, an account can only be migrated if both
+	// the previous account type allows it and the new one registers a migration handler.
+	RegisterMigrateHandler(builder *MigrateBuilder)
+}
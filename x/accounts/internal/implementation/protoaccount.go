@@ -30,6 +30,27 @@ func RegisterInitHandler[
 	}
 }
 
+// RegisterMigrateHandler registers a migration handler for a smart account that uses protobuf.
+func RegisterMigrateHandler[
+	Req any, ProtoReq ProtoMsgG[Req], Resp any, ProtoResp ProtoMsgG[Resp],
+](router *MigrateBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	reqName := MessageName(ProtoReq(new(Req)))
+
+	router.handler = func(ctx context.Context, migrateRequest transaction.Msg) (migrateResponse transaction.Msg, err error) {
+		concrete, ok := migrateRequest.(ProtoReq)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %s, got %T", errInvalidMessage, reqName, migrateRequest)
+		}
+		return handler(ctx, concrete)
+	}
+
+	router.schema = HandlerSchema{
+		RequestSchema:  *NewProtoMessageSchema[Req, ProtoReq](),
+		ResponseSchema: *NewProtoMessageSchema[Resp, ProtoResp](),
+	}
+}
+
 // RegisterExecuteHandler registers an execution handler for a smart account that uses protobuf.
 func RegisterExecuteHandler[
 	Req any, ProtoReq ProtoMsgG[Req], Resp any, ProtoResp ProtoMsgG[Resp],
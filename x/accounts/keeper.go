@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"slices"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
@@ -229,6 +230,66 @@ func (k Keeper) MigrateLegacyAccount(
 	return k.init(ctx, accType, addr, accNum, addr, msg, nil)
 }
 
+// Migrate migrates the given account to another account type. The account can only be
+// migrated by itself, and to the account types allowed by its current account type. The
+// migration handler of the new account type is executed against the state of the account,
+// so it can read the state written by the previous implementation. The state is kept as is,
+// so the migration handler must rewrite or clear, e.g. with accountstd.ClearState, the
+// prefixes of the previous implementation which the new one uses differently or not at all.
+func (k Keeper) Migrate(
+	ctx context.Context,
+	accountAddr []byte,
+	sender []byte,
+	accountType string,
+	migrateRequest transaction.Msg,
+) (transaction.Msg, error) {
+	if !bytes.Equal(accountAddr, sender) {
+		return nil, fmt.Errorf("%w: an account can only be migrated by itself", ErrUnauthorized)
+	}
+
+	currentType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	if currentType == accountType {
+		return nil, fmt.Errorf("account is already of type %s", accountType)
+	}
+
+	impl, ok := k.accounts[accountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, accountType)
+	}
+
+	// the current account type must opt in the migration, otherwise any account able to
+	// send messages on its own behalf could move to an account type reading its state.
+	currentImpl, ok := k.accounts[currentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, currentType)
+	}
+	if !slices.Contains(currentImpl.MigrationTargets, accountType) {
+		return nil, fmt.Errorf("%w: account type %s cannot be migrated to %s", ErrUnauthorized, currentType, accountType)
+	}
+
+	accountNum, err := k.AccountByNumber.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the context and migrate the account, the account number is unchanged
+	// so the new implementation shares the state of the previous one.
+	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, sender, nil, false)
+	resp, err := impl.Migrate(ctx, migrateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// map account address to the new account type
+	if err := k.AccountsByType.Set(ctx, accountAddr, accountType); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Execute executes a state transition on the given account.
 func (k Keeper) Execute(
 	ctx context.Context,
@@ -445,6 +506,10 @@ func registerToInterfaceRegistry(ir InterfaceRegistry, accMap map[string]impleme
 	for _, acc := range accMap {
 		// register init
 		ir.RegisterImplementations(msgInterfaceType, acc.InitHandlerSchema.RequestSchema.New(), acc.InitHandlerSchema.ResponseSchema.New())
+		// register migrate
+		if acc.MigrateHandlerSchema.RequestSchema.New != nil {
+			ir.RegisterImplementations(msgInterfaceType, acc.MigrateHandlerSchema.RequestSchema.New(), acc.MigrateHandlerSchema.ResponseSchema.New())
+		}
 		// register exec
 		for _, exec := range acc.ExecuteHandlersSchema {
 			ir.RegisterImplementations(msgInterfaceType, exec.RequestSchema.New(), exec.ResponseSchema.New())
@@ -90,3 +90,61 @@ func TestKeeper_Query(t *testing.T) {
 		require.True(t, implementation.Equal(&types.Int64Value{Value: 1000}, resp))
 	})
 }
+
+func TestKeeper_Migrate(t *testing.T) {
+	m, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("test-v2", NewTestAccount),
+		accountstd.AddAccount("test-v3", NewTestAccount),
+	)
+
+	// create account
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil)
+	require.NoError(t, err)
+
+	// set some state
+	_, err = m.Execute(ctx, accAddr, sender, &types.UInt64Value{Value: 5}, nil)
+	require.NoError(t, err)
+
+	t.Run("not the account itself", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, sender, "test-v2", &types.UInt64Value{Value: 1})
+		require.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "unknown", &types.UInt64Value{Value: 1})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("same account type", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "test", &types.UInt64Value{Value: 1})
+		require.ErrorContains(t, err, "account is already of type test")
+	})
+
+	t.Run("account type not allowed by the current one", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "test-v3", &types.UInt64Value{Value: 1})
+		require.ErrorIs(t, err, ErrUnauthorized)
+		require.ErrorContains(t, err, "account type test cannot be migrated to test-v3")
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		_, err := m.Migrate(ctx, []byte("unknown"), []byte("unknown"), "test-v2", &types.UInt64Value{Value: 1})
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := m.Migrate(ctx, accAddr, accAddr, "test-v2", &types.UInt64Value{Value: 1})
+		require.NoError(t, err)
+		// the migration handler reads the state of the previous account type
+		require.Equal(t, &types.UInt64Value{Value: 5}, resp)
+
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test-v2", accType)
+
+		resp, err = m.Query(ctx, accAddr, &types.DoubleValue{})
+		require.NoError(t, err)
+		require.Equal(t, &types.UInt64Value{Value: 6}, resp)
+	})
+}
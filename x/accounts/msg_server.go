@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/core/event"
@@ -84,6 +85,49 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 	}, nil
 }
 
+func (m msgServer) Migrate(ctx context.Context, request *v1.MsgMigrate) (*v1.MsgMigrateResponse, error) {
+	// decode sender address, which is the account being migrated
+	accAddr, err := m.k.addressCodec.StringToBytes(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Message == nil {
+		return nil, errors.New("migrate message cannot be empty")
+	}
+
+	// decode message bytes into the concrete boxed message type
+	req, err := implementation.UnpackAnyRaw(request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	// run account migration logic
+	resp, err := m.k.Migrate(ctx, accAddr, accAddr, request.AccountType, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate account: %w", err)
+	}
+
+	eventManager := m.k.EventService.EventManager(ctx)
+	err = eventManager.EmitKV(
+		"account_migration",
+		event.NewAttribute("address", request.Sender),
+		event.NewAttribute("account_type", request.AccountType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// encode the response
+	respAny, err := implementation.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgMigrateResponse{
+		Response: respAny,
+	}, nil
+}
+
 func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle) (*v1.MsgExecuteBundleResponse, error) {
 	panic("impl")
 }
@@ -13,7 +13,7 @@ import (
 )
 
 func TestMsgServer(t *testing.T) {
-	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test-v2", NewTestAccount))
 	s := NewMsgServer(k)
 
 	// create
@@ -42,4 +42,26 @@ func TestMsgServer(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.NotNil(t, execResp)
+
+	// migrate
+	migrateMsgAny, err := implementation.PackAny(&wrapperspb.UInt64Value{Value: 1})
+	require.NoError(t, err)
+
+	_, err = s.Migrate(ctx, &v1.MsgMigrate{
+		Sender:      initResp.AccountAddress,
+		AccountType: "test-v2",
+	})
+	require.ErrorContains(t, err, "migrate message cannot be empty")
+
+	migrateResp, err := s.Migrate(ctx, &v1.MsgMigrate{
+		Sender:      initResp.AccountAddress,
+		AccountType: "test-v2",
+		Message:     migrateMsgAny,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, migrateResp)
+
+	accType, err := k.AccountsByType.Get(ctx, []byte(initResp.AccountAddress))
+	require.NoError(t, err)
+	require.Equal(t, "test-v2", accType)
 }
@@ -0,0 +1,120 @@
+// Package lockupv2 implements v2 lockup accounts, which the lockup accounts can be migrated to
+// through the x/accounts MsgMigrate. They are the same lockup accounts and only exercise the
+// migration, so they must not be registered outside of tests.
+package lockupv2
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/lockup"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+)
+
+var (
+	_ accountstd.MigratableInterface = (*ContinuousLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*DelayedLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*PeriodicLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*PermanentLockingAccount)(nil)
+)
+
+// NewContinuousLockingAccount creates a new ContinuousLockingAccount object.
+func NewContinuousLockingAccount(d accountstd.Dependencies) (*ContinuousLockingAccount, error) {
+	cva, err := lockup.NewContinuousLockingAccount(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContinuousLockingAccount{cva}, nil
+}
+
+// ContinuousLockingAccount is the v2 continuous lockup account, registered as
+// lockup.CONTINUOUS_LOCKING_ACCOUNT_V2.
+type ContinuousLockingAccount struct {
+	*lockup.ContinuousLockingAccount
+}
+
+func (cva ContinuousLockingAccount) Migrate(ctx context.Context, msg *lockuptypes.MsgMigrateLockupAccount) (
+	*lockuptypes.MsgMigrateLockupAccountResponse, error,
+) {
+	if _, err := cva.StartTime.Get(ctx); err != nil {
+		return nil, fmt.Errorf("account is not a continuous lockup account, start time: %w", err)
+	}
+
+	return cva.BaseLockup.Migrate(ctx, msg)
+}
+
+func (cva ContinuousLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	accountstd.RegisterMigrateHandler(builder, cva.Migrate)
+}
+
+// NewDelayedLockingAccount creates a new DelayedLockingAccount object.
+func NewDelayedLockingAccount(d accountstd.Dependencies) (*DelayedLockingAccount, error) {
+	dva, err := lockup.NewDelayedLockingAccount(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DelayedLockingAccount{dva}, nil
+}
+
+// DelayedLockingAccount is the v2 delayed lockup account, registered as
+// lockup.DELAYED_LOCKING_ACCOUNT_V2.
+type DelayedLockingAccount struct {
+	*lockup.DelayedLockingAccount
+}
+
+func (dva DelayedLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	accountstd.RegisterMigrateHandler(builder, dva.BaseLockup.Migrate)
+}
+
+// NewPeriodicLockingAccount creates a new PeriodicLockingAccount object.
+func NewPeriodicLockingAccount(d accountstd.Dependencies) (*PeriodicLockingAccount, error) {
+	pva, err := lockup.NewPeriodicLockingAccount(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PeriodicLockingAccount{pva}, nil
+}
+
+// PeriodicLockingAccount is the v2 periodic lockup account, registered as
+// lockup.PERIODIC_LOCKING_ACCOUNT_V2.
+type PeriodicLockingAccount struct {
+	*lockup.PeriodicLockingAccount
+}
+
+func (pva PeriodicLockingAccount) Migrate(ctx context.Context, msg *lockuptypes.MsgMigrateLockupAccount) (
+	*lockuptypes.MsgMigrateLockupAccountResponse, error,
+) {
+	if _, err := pva.StartTime.Get(ctx); err != nil {
+		return nil, fmt.Errorf("account is not a periodic lockup account, start time: %w", err)
+	}
+
+	return pva.BaseLockup.Migrate(ctx, msg)
+}
+
+func (pva PeriodicLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	accountstd.RegisterMigrateHandler(builder, pva.Migrate)
+}
+
+// NewPermanentLockingAccount creates a new PermanentLockingAccount object.
+func NewPermanentLockingAccount(d accountstd.Dependencies) (*PermanentLockingAccount, error) {
+	plva, err := lockup.NewPermanentLockingAccount(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PermanentLockingAccount{plva}, nil
+}
+
+// PermanentLockingAccount is the v2 permanent lockup account, registered as
+// lockup.PERMANENT_LOCKING_ACCOUNT_V2.
+type PermanentLockingAccount struct {
+	*lockup.PermanentLockingAccount
+}
+
+func (plva PermanentLockingAccount) RegisterMigrateHandler(builder *accountstd.MigrateBuilder) {
+	accountstd.RegisterMigrateHandler(builder, plva.BaseLockup.Migrate)
+}
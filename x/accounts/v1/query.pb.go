@@ -183,6 +183,9 @@ type SchemaResponse struct {
 	ExecuteHandlers []*SchemaResponse_Handler `protobuf:"bytes,2,rep,name=execute_handlers,json=executeHandlers,proto3" json:"execute_handlers,omitempty"`
 	// query_handlers defines the schema descriptor for the Query account method.
 	QueryHandlers []*SchemaResponse_Handler `protobuf:"bytes,3,rep,name=query_handlers,json=queryHandlers,proto3" json:"query_handlers,omitempty"`
+	// migrate_schema defines the schema descriptor for the Migrate account method,
+	// it is empty if the account type cannot be migrated to.
+	MigrateSchema *SchemaResponse_Handler `protobuf:"bytes,4,opt,name=migrate_schema,json=migrateSchema,proto3" json:"migrate_schema,omitempty"`
 }
 
 func (m *SchemaResponse) Reset()         { *m = SchemaResponse{} }
@@ -239,6 +242,13 @@ func (m *SchemaResponse) GetQueryHandlers() []*SchemaResponse_Handler {
 	return nil
 }
 
+func (m *SchemaResponse) GetMigrateSchema() *SchemaResponse_Handler {
+	if m != nil {
+		return m.MigrateSchema
+	}
+	return nil
+}
+
 // Handler defines a schema descriptor for a handler.
 // Where request and response are names that can be used to lookup the
 // reflection descriptor.
@@ -496,39 +506,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/query.proto", fileDescriptor_16ad14c22e3080d2) }
 
 var fileDescriptor_16ad14c22e3080d2 = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0x48, 0x60, 0xd2, 0x14, 0x34, 0x94, 0xca, 0xf8, 0x60, 0xa5, 0x3e, 0xd0, 0xc0,
-	0x61, 0xdd, 0x06, 0x0e, 0xdc, 0x50, 0x38, 0x55, 0x42, 0x42, 0x8a, 0x81, 0x0b, 0x12, 0x0a, 0x8e,
-	0xb3, 0x4d, 0x23, 0x1a, 0x6f, 0xea, 0xb5, 0xab, 0xe6, 0x13, 0xb8, 0xf1, 0x59, 0x3d, 0xf6, 0xc8,
-	0x11, 0x25, 0x3f, 0x82, 0xba, 0x3b, 0x9b, 0x38, 0x50, 0xe2, 0xf6, 0xe6, 0xd9, 0x79, 0xf3, 0xde,
-	0xfa, 0xbd, 0xb1, 0xc1, 0x8b, 0x85, 0x9c, 0x08, 0x19, 0x44, 0x71, 0x2c, 0xf2, 0x24, 0x93, 0xc1,
-	0xf9, 0x61, 0x70, 0x96, 0xf3, 0x74, 0xc6, 0xa6, 0xa9, 0xc8, 0x04, 0xa2, 0xee, 0x33, 0xd3, 0x67,
-	0xe7, 0x87, 0xee, 0xb3, 0x91, 0x10, 0xa3, 0x53, 0x1e, 0x28, 0xc4, 0x20, 0x3f, 0x0e, 0xa2, 0x84,
-	0xe0, 0xfe, 0x57, 0x78, 0xd2, 0xd5, 0xc8, 0xde, 0x35, 0x49, 0xc8, 0xcf, 0x72, 0x2e, 0x33, 0xdc,
-	0x85, 0x5a, 0x16, 0xa5, 0x23, 0x9e, 0x39, 0x76, 0xcb, 0x6e, 0x3f, 0x0c, 0xa9, 0x42, 0x06, 0xf5,
-	0x54, 0x43, 0x9c, 0x4a, 0xcb, 0x6e, 0x37, 0x3a, 0x3b, 0x4c, 0x73, 0x33, 0xc3, 0xcd, 0xba, 0xc9,
-	0x2c, 0x34, 0x20, 0xff, 0x08, 0x76, 0xd6, 0xe9, 0xe5, 0x54, 0x24, 0x92, 0xe3, 0x01, 0x3c, 0x48,
-	0xe9, 0x59, 0x29, 0xfc, 0x8f, 0x68, 0x89, 0xf2, 0x3b, 0xd0, 0xfc, 0x18, 0x9f, 0xf0, 0x49, 0x64,
-	0xae, 0xb8, 0x07, 0x5b, 0xf4, 0x8e, 0xfd, 0x6c, 0x36, 0xe5, 0x74, 0xd1, 0x06, 0x9d, 0x7d, 0x9a,
-	0x4d, 0xb9, 0x7f, 0x59, 0x81, 0x6d, 0x33, 0x44, 0xc2, 0xef, 0xa1, 0x31, 0x4e, 0xc6, 0x59, 0x5f,
-	0xaa, 0x63, 0xd2, 0x7e, 0xc9, 0xfe, 0x35, 0x8d, 0xad, 0x0f, 0xb2, 0xa3, 0x28, 0x19, 0x9e, 0xf2,
-	0x34, 0x84, 0xeb, 0x71, 0xdd, 0xc3, 0xcf, 0xf0, 0x98, 0x5f, 0xf0, 0x38, 0xcf, 0x78, 0xff, 0x44,
-	0xb7, 0xa5, 0x53, 0x69, 0x55, 0xef, 0xc8, 0xf8, 0x88, 0x38, 0xa8, 0x96, 0xd8, 0x83, 0x6d, 0x95,
-	0xe8, 0x8a, 0xb4, 0x7a, 0x67, 0xd2, 0xa6, 0x62, 0x30, 0x94, 0xee, 0x5b, 0xa8, 0xd3, 0x33, 0x3a,
-	0xab, 0x08, 0xb5, 0x65, 0xa6, 0x44, 0xb7, 0x10, 0x4a, 0x45, 0xb5, 0x56, 0xf6, 0x33, 0xc0, 0xee,
-	0xca, 0x59, 0x93, 0x81, 0x03, 0xf5, 0x68, 0x38, 0x4c, 0xb9, 0x94, 0x86, 0x8b, 0x4a, 0xff, 0xcd,
-	0x72, 0xaf, 0x34, 0x9e, 0xec, 0xbf, 0x45, 0x68, 0x07, 0xcb, 0x95, 0xf9, 0x90, 0x4f, 0x06, 0x3c,
-	0x2d, 0xd7, 0x0a, 0xe0, 0xe9, 0x5f, 0x13, 0xa4, 0xb6, 0x0b, 0xb5, 0x44, 0x9d, 0xa8, 0x89, 0x7b,
-	0x21, 0x55, 0x9d, 0x1f, 0x55, 0xb8, 0xaf, 0xf6, 0x11, 0x63, 0xd8, 0x2a, 0xee, 0x27, 0xee, 0xdf,
-	0x64, 0xf1, 0x0d, 0x1f, 0x88, 0xdb, 0x2e, 0x07, 0x92, 0x73, 0x16, 0xf6, 0xa0, 0x46, 0x0b, 0xb3,
-	0xb7, 0x29, 0x41, 0x4d, 0xec, 0x97, 0x87, 0xec, 0x5b, 0xf8, 0x0d, 0x1a, 0x05, 0x7b, 0xf1, 0xf9,
-	0x86, 0xdb, 0x14, 0xf2, 0x72, 0xf7, 0x4b, 0x71, 0x4b, 0x85, 0x63, 0x68, 0xae, 0x99, 0x8a, 0x9b,
-	0xde, 0x78, 0x2d, 0x29, 0xf7, 0xc5, 0x2d, 0x90, 0x46, 0xe7, 0xdd, 0xeb, 0xcb, 0xb9, 0x67, 0x5f,
-	0xcd, 0x3d, 0xfb, 0xf7, 0xdc, 0xb3, 0x7f, 0x2e, 0x3c, 0xeb, 0x6a, 0xe1, 0x59, 0xbf, 0x16, 0x9e,
-	0xf5, 0xc5, 0xd5, 0x2c, 0x72, 0xf8, 0x9d, 0x8d, 0x45, 0x70, 0x51, 0xfc, 0xe3, 0x0d, 0x6a, 0xea,
-	0x2f, 0xf1, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x75, 0x93, 0xfa, 0x0e, 0x05, 0x00,
-	0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x59, 0xa8, 0xa0, 0x8f, 0x52, 0xcd, 0xb4, 0x36, 0xeb, 0x1e, 0x36, 0x74, 0x0f, 0x16,
+	0x3d, 0xcc, 0xb6, 0xe8, 0xc1, 0x9b, 0xc1, 0x53, 0x13, 0x13, 0x13, 0x56, 0xbd, 0x98, 0x18, 0x5c,
+	0x96, 0x29, 0x25, 0x96, 0x1d, 0x3a, 0x33, 0xdb, 0x94, 0x8f, 0xe0, 0xcd, 0xbb, 0x5f, 0xc8, 0x63,
+	0x8f, 0x1e, 0x0d, 0x7c, 0x11, 0xd3, 0x99, 0x37, 0xb0, 0x6b, 0x1b, 0x28, 0x37, 0xde, 0xbc, 0xff,
+	0xfb, 0xbd, 0x99, 0xf7, 0x7f, 0x2c, 0xf8, 0x09, 0x97, 0x63, 0x2e, 0xc3, 0x38, 0x49, 0x78, 0x96,
+	0x2a, 0x19, 0x5e, 0x1e, 0x87, 0x17, 0x19, 0x13, 0x53, 0x3a, 0x11, 0x5c, 0x71, 0x42, 0x4c, 0x9e,
+	0xda, 0x3c, 0xbd, 0x3c, 0xf6, 0x9e, 0x0d, 0x39, 0x1f, 0x9e, 0xb3, 0x50, 0x2b, 0xfa, 0xd9, 0x69,
+	0x18, 0xa7, 0x28, 0x0f, 0xbe, 0xc2, 0x6e, 0xc7, 0x28, 0xbb, 0x37, 0x90, 0x88, 0x5d, 0x64, 0x4c,
+	0x2a, 0xb2, 0x0f, 0x55, 0x15, 0x8b, 0x21, 0x53, 0xae, 0xd3, 0x74, 0x5a, 0x8f, 0x22, 0x8c, 0x08,
+	0x85, 0x9a, 0x30, 0x12, 0xb7, 0xdc, 0x74, 0x5a, 0xf5, 0xf6, 0x1e, 0x35, 0x6c, 0x6a, 0xd9, 0xb4,
+	0x93, 0x4e, 0x23, 0x2b, 0x0a, 0x4e, 0x60, 0xaf, 0x88, 0x97, 0x13, 0x9e, 0x4a, 0x46, 0x8e, 0xe0,
+	0xa1, 0xc0, 0xdf, 0xae, 0xb3, 0x02, 0xb4, 0x50, 0x05, 0x6d, 0x68, 0x7c, 0x4c, 0xce, 0xd8, 0x38,
+	0xb6, 0x57, 0x3c, 0x80, 0x6d, 0x7c, 0x63, 0x4f, 0x4d, 0x27, 0x0c, 0x2f, 0x5a, 0xc7, 0xb3, 0x4f,
+	0xd3, 0x09, 0x0b, 0x7e, 0x55, 0x60, 0xc7, 0x16, 0x61, 0xe3, 0xf7, 0x50, 0x1f, 0xa5, 0x23, 0xd5,
+	0x93, 0xfa, 0x18, 0x7b, 0xbf, 0xa4, 0xb7, 0x87, 0x46, 0x8b, 0x85, 0xf4, 0x24, 0x4e, 0x07, 0xe7,
+	0x4c, 0x44, 0x70, 0x53, 0x6e, 0x72, 0xe4, 0x33, 0x3c, 0x61, 0x57, 0x2c, 0xc9, 0x14, 0xeb, 0x9d,
+	0x99, 0xb4, 0x74, 0xcb, 0xcd, 0xca, 0x86, 0xc4, 0xc7, 0xc8, 0xc0, 0x58, 0x92, 0x2e, 0xec, 0x68,
+	0x47, 0x97, 0xd0, 0xca, 0xc6, 0xd0, 0x86, 0x26, 0xe4, 0x91, 0xe3, 0xd1, 0x50, 0xc4, 0x8a, 0xd9,
+	0x97, 0x6f, 0x6d, 0xfc, 0xf2, 0x06, 0x12, 0x4c, 0xda, 0x7b, 0x0b, 0x35, 0xcc, 0x10, 0x77, 0xb9,
+	0x15, 0xc6, 0x05, 0x1b, 0x12, 0x2f, 0xe7, 0x73, 0x59, 0xa7, 0x96, 0x8e, 0x52, 0x20, 0x9d, 0xa5,
+	0x59, 0xd6, 0x56, 0x17, 0x6a, 0xf1, 0x60, 0x20, 0x98, 0x94, 0x96, 0x85, 0x61, 0xf0, 0x06, 0x76,
+	0x0b, 0x7a, 0x74, 0xf4, 0x1e, 0x7b, 0x70, 0xb4, 0xd8, 0xc2, 0x0f, 0xd9, 0xb8, 0xcf, 0xc4, 0xfa,
+	0x5e, 0x21, 0x3c, 0xfd, 0xaf, 0x02, 0xbb, 0xed, 0x43, 0x35, 0xd5, 0x27, 0xba, 0x62, 0x2b, 0xc2,
+	0xa8, 0xfd, 0xa3, 0x02, 0x0f, 0xf4, 0x8a, 0x93, 0x04, 0xb6, 0xf3, 0x2b, 0x4f, 0x0e, 0xef, 0x1a,
+	0xf1, 0x1d, 0xff, 0x39, 0xaf, 0xb5, 0x5e, 0x88, 0x93, 0x2b, 0x91, 0x2e, 0x54, 0x71, 0x07, 0x0f,
+	0x56, 0x39, 0x68, 0xc0, 0xc1, 0x7a, 0x93, 0x83, 0x12, 0xf9, 0x06, 0xf5, 0xdc, 0x78, 0xc9, 0xf3,
+	0x15, 0xb7, 0xc9, 0xf9, 0xe5, 0x1d, 0xae, 0xd5, 0x2d, 0x3a, 0x9c, 0x42, 0xa3, 0x30, 0x54, 0xb2,
+	0xea, 0xc5, 0x05, 0xa7, 0xbc, 0x17, 0xf7, 0x50, 0xda, 0x3e, 0xef, 0x5e, 0xff, 0x9e, 0xf9, 0xce,
+	0xf5, 0xcc, 0x77, 0xfe, 0xce, 0x7c, 0xe7, 0xe7, 0xdc, 0x2f, 0x5d, 0xcf, 0xfd, 0xd2, 0x9f, 0xb9,
+	0x5f, 0xfa, 0xe2, 0x19, 0x8a, 0x1c, 0x7c, 0xa7, 0x23, 0x1e, 0x5e, 0xe5, 0x3f, 0xa2, 0xfd, 0xaa,
+	0xfe, 0xf0, 0xbc, 0xfa, 0x37, 0x00, 0x2b, 0x1e, 0x6b, 0x67, 0x61, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -855,6 +865,18 @@ func (m *SchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MigrateSchema != nil {
+		{
+			size, err := m.MigrateSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.QueryHandlers) > 0 {
 		for iNdEx := len(m.QueryHandlers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1129,6 +1151,10 @@ func (m *SchemaResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.MigrateSchema != nil {
+		l = m.MigrateSchema.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1625,6 +1651,42 @@ func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrateSchema == nil {
+				m.MigrateSchema = &SchemaResponse_Handler{}
+			}
+			if err := m.MigrateSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18,6 +18,13 @@ func MakeAccountsSchemas(impls map[string]implementation.Implementation) map[str
 }
 
 func makeAccountSchema(impl implementation.Implementation) *SchemaResponse {
+	var migrateSchema *SchemaResponse_Handler
+	if impl.MigrateHandlerSchema.RequestSchema.Name != "" {
+		migrateSchema = &SchemaResponse_Handler{
+			Request:  impl.MigrateHandlerSchema.RequestSchema.Name,
+			Response: impl.MigrateHandlerSchema.ResponseSchema.Name,
+		}
+	}
 	return &SchemaResponse{
 		InitSchema: &SchemaResponse_Handler{
 			Request:  impl.InitHandlerSchema.RequestSchema.Name,
@@ -25,6 +32,7 @@ func makeAccountSchema(impl implementation.Implementation) *SchemaResponse {
 		},
 		ExecuteHandlers: makeHandlersSchema(impl.ExecuteHandlersSchema),
 		QueryHandlers:   makeHandlersSchema(impl.QueryHandlersSchema),
+		MigrateSchema:   migrateSchema,
 	}
 }
 
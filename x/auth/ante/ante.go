@@ -21,6 +21,7 @@ type HandlerOptions struct {
 	BankKeeper               types.BankKeeper
 	ExtensionOptionChecker   ExtensionOptionChecker
 	FeegrantKeeper           FeegrantKeeper
+	FeeMarketKeeper          FeeMarketKeeper
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter gas.Meter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
@@ -50,7 +51,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(options.Environment),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker).WithFeeMarketKeeper(options.FeeMarketKeeper),
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
 	}
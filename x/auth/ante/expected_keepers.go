@@ -28,6 +28,17 @@ type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
+// FeeMarketKeeper defines the expected fee market keeper, charging a dynamic
+// base fee per unit of gas.
+type FeeMarketKeeper interface {
+	// GetBaseFee returns the base fee per unit of gas. A zero base fee is not charged.
+	GetBaseFee(ctx context.Context) (sdk.DecCoin, error)
+	// BurnBaseFee burns the base fee paid by a tx from the given account.
+	BurnBaseFee(ctx context.Context, from sdk.AccAddress, fee sdk.Coins) error
+	// ConsumeBlockGas records the gas consumed by a tx in the current block.
+	ConsumeBlockGas(ctx context.Context, gas uint64) error
+}
+
 type ConsensusKeeper interface {
 	Params(context.Context, *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error)
 }
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,10 +22,11 @@ type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 // Call next AnteHandler if fees are successfully deducted.
 // CONTRACT: The Tx must implement the FeeTx interface to use DeductFeeDecorator.
 type DeductFeeDecorator struct {
-	accountKeeper  AccountKeeper
-	bankKeeper     types.BankKeeper
-	feegrantKeeper FeegrantKeeper
-	txFeeChecker   TxFeeChecker
+	accountKeeper   AccountKeeper
+	bankKeeper      types.BankKeeper
+	feegrantKeeper  FeegrantKeeper
+	feeMarketKeeper FeeMarketKeeper
+	txFeeChecker    TxFeeChecker
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
@@ -40,6 +42,14 @@ func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKee
 	}
 }
 
+// WithFeeMarketKeeper returns a copy of the decorator charging the base fee of
+// the given fee market. The base fee portion of the fee is burned and only the
+// remaining tip is sent to the fee collector. A nil keeper disables it.
+func (dfd DeductFeeDecorator) WithFeeMarketKeeper(fmk FeeMarketKeeper) DeductFeeDecorator {
+	dfd.feeMarketKeeper = fmk
+	return dfd
+}
+
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -113,9 +123,19 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		deductFeesFrom = feeGranterAddr
 	}
 
+	// burn the base fee, only the remaining tip goes to the fee collector
+	tip := fee
+	if dfd.feeMarketKeeper != nil {
+		baseFee, err := dfd.chargeBaseFee(ctx, feeTx, deductFeesFrom, fee)
+		if err != nil {
+			return err
+		}
+		tip = fee.Sub(baseFee...)
+	}
+
 	// deduct the fees
-	if !fee.IsZero() {
-		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFrom, fee)
+	if !tip.IsZero() {
+		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFrom, tip)
 		if err != nil {
 			return err
 		}
@@ -133,6 +153,43 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	return nil
 }
 
+// chargeBaseFee checks that the fee covers the fee market base fee for the gas
+// limit of the tx and burns the base fee from the given account. It returns the
+// burned amount. The gas limit is recorded as the gas consumed by the tx in the
+// current block. No base fee is charged when simulating.
+func (dfd DeductFeeDecorator) chargeBaseFee(ctx sdk.Context, feeTx sdk.FeeTx, acc []byte, fee sdk.Coins) (sdk.Coins, error) {
+	execMode := dfd.accountKeeper.GetEnvironment().TransactionService.ExecMode(ctx)
+	if execMode == transaction.ExecModeSimulate {
+		return sdk.NewCoins(), nil
+	}
+
+	baseFee, err := dfd.feeMarketKeeper.GetBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !baseFee.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(feeTx.GetGas()))
+	required := sdk.NewCoins(sdk.NewCoin(baseFee.Denom, baseFee.Amount.Mul(gas).Ceil().RoundInt()))
+	if !fee.IsAllGTE(required) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fee to cover the base fee; got: %s required: %s", fee, required)
+	}
+
+	if err := dfd.feeMarketKeeper.BurnBaseFee(ctx, acc, required); err != nil {
+		return nil, fmt.Errorf("failed to burn base fee: %w", err)
+	}
+
+	if execMode == transaction.ExecModeFinalize {
+		if err := dfd.feeMarketKeeper.ConsumeBlockGas(ctx, feeTx.GetGas()); err != nil {
+			return nil, err
+		}
+	}
+
+	return required, nil
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
+	antetestutil "cosmossdk.io/x/auth/ante/testutil"
 	authtypes "cosmossdk.io/x/auth/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -137,3 +138,43 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFees_FeeMarket(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	s.ctx = s.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	// keys and addresses
+	accs := s.CreateTestAccounts(1)
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	feeMarketKeeper := antetestutil.NewMockFeeMarketKeeper(gomock.NewController(t))
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil).WithFeeMarketKeeper(feeMarketKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// the base fee is higher than the fee
+	feeMarketKeeper.EXPECT().GetBaseFee(gomock.Any()).Return(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(1, 2)), nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the base fee is burned and only the tip goes to the fee collector
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	tip := feeAmount.Sub(baseFee...)
+	feeMarketKeeper.EXPECT().GetBaseFee(gomock.Any()).Return(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(5, 4)), nil)
+	feeMarketKeeper.EXPECT().BurnBaseFee(gomock.Any(), accs[0].acc.GetAddress(), baseFee).Return(nil)
+	feeMarketKeeper.EXPECT().ConsumeBlockGas(gomock.Any(), gasLimit).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, tip).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+}
@@ -172,6 +172,72 @@ func (mr *MockFeegrantKeeperMockRecorder) UseGrantedFees(ctx, granter, grantee,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseGrantedFees", reflect.TypeOf((*MockFeegrantKeeper)(nil).UseGrantedFees), ctx, granter, grantee, fee, msgs)
 }
 
+// MockFeeMarketKeeper is a mock of FeeMarketKeeper interface.
+type MockFeeMarketKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeMarketKeeperMockRecorder
+}
+
+// MockFeeMarketKeeperMockRecorder is the mock recorder for MockFeeMarketKeeper.
+type MockFeeMarketKeeperMockRecorder struct {
+	mock *MockFeeMarketKeeper
+}
+
+// NewMockFeeMarketKeeper creates a new mock instance.
+func NewMockFeeMarketKeeper(ctrl *gomock.Controller) *MockFeeMarketKeeper {
+	mock := &MockFeeMarketKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeMarketKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
+	return m.recorder
+}
+
+// BurnBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) BurnBaseFee(ctx context.Context, from types1.AccAddress, fee types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnBaseFee", ctx, from, fee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnBaseFee indicates an expected call of BurnBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) BurnBaseFee(ctx, from, fee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).BurnBaseFee), ctx, from, fee)
+}
+
+// ConsumeBlockGas mocks base method.
+func (m *MockFeeMarketKeeper) ConsumeBlockGas(ctx context.Context, gas uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeBlockGas", ctx, gas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeBlockGas indicates an expected call of ConsumeBlockGas.
+func (mr *MockFeeMarketKeeperMockRecorder) ConsumeBlockGas(ctx, gas interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeBlockGas", reflect.TypeOf((*MockFeeMarketKeeper)(nil).ConsumeBlockGas), ctx, gas)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) GetBaseFee(ctx context.Context) (types1.DecCoin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee", ctx)
+	ret0, _ := ret[0].(types1.DecCoin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) GetBaseFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetBaseFee), ctx)
+}
+
 // MockConsensusKeeper is a mock of ConsensusKeeper interface.
 type MockConsensusKeeper struct {
 	ctrl     *gomock.Controller
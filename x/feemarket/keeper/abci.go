@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker computes the base fee of the next block from the gas consumed by
+// the current block.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	gasUsed, err := k.BlockGasUsed.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.BlockGasUsed.Remove(ctx); err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	nextBaseFee := params.NextBaseFee(baseFee, gasUsed)
+	if err := k.BaseFee.Set(ctx, nextBaseFee); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBaseFee,
+		event.NewAttribute(types.AttributeKeyBaseFee, nextBaseFee.String()),
+		event.NewAttribute(types.AttributeKeyBlockGasUsed, strconv.FormatUint(gasUsed, 10)),
+	)
+}
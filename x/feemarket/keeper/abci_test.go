@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+
+	// a full block raises the base fee by 1/8
+	s.Require().NoError(s.feeMarketKeeper.ConsumeBlockGas(s.ctx, 2*params.TargetBlockGas))
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+
+	baseFee, err := s.feeMarketKeeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(math.LegacyNewDecWithPrec(1125, 3).Equal(baseFee))
+
+	has, err := s.feeMarketKeeper.BlockGasUsed.Has(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	events := s.ctx.EventManager().Events()
+	s.Require().NotEmpty(events)
+	s.Require().Equal(types.EventTypeBaseFee, events[len(events)-1].Type)
+	s.Require().Equal(strconv.FormatUint(2*params.TargetBlockGas, 10), events[len(events)-1].Attributes[1].Value)
+
+	// an empty block lowers it by 1/8
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+	baseFee, err = s.feeMarketKeeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(math.LegacyNewDecWithPrec(984375, 6).Equal(baseFee))
+
+	// a disabled fee market keeps the base fee
+	params.Enabled = false
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx))
+	newBaseFee, err := s.feeMarketKeeper.BaseFee.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(baseFee.Equal(newBaseFee))
+}
@@ -0,0 +1,17 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	genesisState := types.NewGenesisState(types.DefaultParams(), math.LegacyNewDecWithPrec(5, 3))
+
+	s.Require().NoError(s.feeMarketKeeper.InitGenesis(s.ctx, genesisState))
+
+	exported, err := s.feeMarketKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState.Params.String(), exported.Params.String())
+	s.Require().True(genesisState.BaseFee.Equal(exported.BaseFee))
+}
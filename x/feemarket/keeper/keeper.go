@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc        codec.BinaryCodec
+	bankKeeper types.BankKeeper
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema       collections.Schema
+	Params       collections.Item[types.Params]
+	BaseFee      collections.Item[sdkmath.LegacyDec]
+	BlockGasUsed collections.Item[uint64]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		Environment:  env,
+		cdc:          cdc,
+		bankKeeper:   bk,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFee:      collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.LegacyDecValue),
+		BlockGasUsed: collections.NewItem(sb, types.BlockGasUsedKey, "block_gas_used", collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetBaseFee returns the base fee per unit of gas charged in the current block.
+// A zero base fee is returned when the fee market is disabled.
+func (k Keeper) GetBaseFee(ctx context.Context) (sdk.DecCoin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if !params.Enabled {
+		return sdk.NewDecCoinFromDec(params.FeeDenom, sdkmath.LegacyZeroDec()), nil
+	}
+
+	baseFee, err := k.BaseFee.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, baseFee), nil
+}
+
+// BurnBaseFee burns the base fee paid by a transaction from the payer account.
+func (k Keeper) BurnBaseFee(ctx context.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, payer, fee)
+}
+
+// ConsumeBlockGas records the gas consumed by a transaction in the current
+// block. The block gas usage determines the base fee of the next block.
+func (k Keeper) ConsumeBlockGas(ctx context.Context, gas uint64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	used, err := k.BlockGasUsed.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if used > math.MaxUint64-gas {
+		used = math.MaxUint64
+	} else {
+		used += gas
+	}
+
+	return k.BlockGasUsed.Set(ctx, used)
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	feemarkettestutil "cosmossdk.io/x/feemarket/testutil"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx             sdk.Context
+	feeMarketKeeper keeper.Keeper
+	msgServer       types.MsgServer
+	queryClient     types.QueryClient
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+
+	s.feeMarketKeeper = keeper.NewKeeper(encCfg.Codec, env, s.bankKeeper, govModuleNameStr)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.BaseFee.Set(s.ctx, math.LegacyNewDec(1)))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(testCtx.Ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(s.feeMarketKeeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (s *KeeperTestSuite) TestGetBaseFee() {
+	baseFee, err := s.feeMarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoin(sdk.DefaultBondDenom, math.OneInt()).String(), baseFee.String())
+
+	params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.Enabled = false
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+
+	baseFee, err = s.feeMarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(baseFee.IsZero())
+}
+
+func (s *KeeperTestSuite) TestBurnBaseFee() {
+	payer := sdk.AccAddress("payer")
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, []byte(payer), fee).Return(nil)
+	s.Require().NoError(s.feeMarketKeeper.BurnBaseFee(s.ctx, payer, fee))
+
+	// burning nothing doesn't reach the bank keeper
+	s.Require().NoError(s.feeMarketKeeper.BurnBaseFee(s.ctx, payer, sdk.NewCoins()))
+}
+
+func (s *KeeperTestSuite) TestConsumeBlockGas() {
+	s.Require().NoError(s.feeMarketKeeper.ConsumeBlockGas(s.ctx, 100))
+	s.Require().NoError(s.feeMarketKeeper.ConsumeBlockGas(s.ctx, 250))
+
+	used, err := s.feeMarketKeeper.BlockGasUsed.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(350), used)
+}
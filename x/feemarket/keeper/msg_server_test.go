@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+				Params:    types.DefaultParams(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    types.NewParams(true, "stake", sdkmath.LegacyNewDec(-1), 1000, 8),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    types.NewParams(true, "stake", sdkmath.LegacyNewDecWithPrec(1, 2), 1000, 8),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				params, err := s.feeMarketKeeper.Params.Get(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.request.Params.String(), params.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeBaseFee = "base_fee"
+
+	AttributeKeyBaseFee      = "base_fee"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+)
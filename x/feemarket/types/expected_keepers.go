@@ -0,0 +1,20 @@
+package types // noalias
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// BankKeeper defines the contract needed to be fulfilled for burning the base
+// fee.
+type BankKeeper interface {
+	BurnCoins(ctx context.Context, address []byte, amt sdk.Coins) error
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee math.LegacyDec) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseFee)
+}
+
+// Validate validates the provided genesis state to ensure the expected
+// invariants holds.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BaseFee.IsNil() {
+		return errors.New("base fee cannot be nil")
+	}
+	if gs.BaseFee.LT(gs.Params.MinBaseFee) {
+		return fmt.Errorf("base fee %s cannot be lower than min base fee %s", gs.BaseFee, gs.Params.MinBaseFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	params := types.DefaultParams()
+	require.Error(t, types.NewGenesisState(params, math.LegacyDec{}).Validate())
+	require.Error(t, types.NewGenesisState(params, math.LegacyNewDecWithPrec(1, 4)).Validate())
+
+	params.TargetBlockGas = 0
+	require.Error(t, types.NewGenesisState(params, params.MinBaseFee).Validate())
+}
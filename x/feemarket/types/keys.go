@@ -0,0 +1,25 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ParamsKey is the key of the feemarket module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// BaseFeeKey is the key of the base fee charged in the current block.
+	BaseFeeKey = collections.NewPrefix(1)
+	// BlockGasUsedKey is the key of the gas consumed so far in the current block.
+	BlockGasUsedKey = collections.NewPrefix(2)
+)
+
+const (
+	// ModuleName is the name of the feemarket module
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
+)
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.LegacyDec, targetBlockGas uint64, baseFeeChangeDenominator uint32) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters. The fee market
+// is disabled by default.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseFee:               math.LegacyNewDecWithPrec(25, 4),
+		TargetBlockGas:           15_000_000,
+		BaseFeeChangeDenominator: 8,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() {
+		return errors.New("min base fee cannot be nil")
+	}
+	if p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", p.MinBaseFee)
+	}
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block given the base fee and
+// the gas consumed by the current block, following EIP-1559: the base fee
+// moves towards the target block gas by at most 1/BaseFeeChangeDenominator
+// per block and never goes below MinBaseFee.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.TargetBlockGas))
+	used := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed))
+
+	delta := baseFee.Mul(used.Sub(target)).Quo(target).QuoInt64(int64(p.BaseFeeChangeDenominator))
+	next := baseFee.Add(delta)
+	if next.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+
+	return next
+}
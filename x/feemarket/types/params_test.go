@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TargetBlockGas = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, params.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(true, "stake", math.LegacyNewDecWithPrec(1, 2), 1000, 8)
+	baseFee := math.LegacyNewDec(1)
+
+	testCases := []struct {
+		name     string
+		baseFee  math.LegacyDec
+		gasUsed  uint64
+		expected math.LegacyDec
+	}{
+		{
+			name:     "gas used at target",
+			baseFee:  baseFee,
+			gasUsed:  1000,
+			expected: baseFee,
+		},
+		{
+			name:     "full block raises base fee by 1/8",
+			baseFee:  baseFee,
+			gasUsed:  2000,
+			expected: math.LegacyNewDecWithPrec(1125, 3),
+		},
+		{
+			name:     "empty block lowers base fee by 1/8",
+			baseFee:  baseFee,
+			gasUsed:  0,
+			expected: math.LegacyNewDecWithPrec(875, 3),
+		},
+		{
+			name:     "base fee is bounded by min base fee",
+			baseFee:  math.LegacyNewDecWithPrec(1, 2),
+			gasUsed:  0,
+			expected: math.LegacyNewDecWithPrec(1, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected.String(), params.NextBaseFee(tc.baseFee, tc.gasUsed).String())
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"errors"
+
 	corestore "cosmossdk.io/core/store"
 	storetypes "cosmossdk.io/store/types"
 
@@ -13,11 +15,17 @@ func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *corestore.StoreUpgra
 	return func(ms storetypes.CommitMultiStore) error {
 		if upgradeHeight == ms.LastCommitID().Version+1 {
 			// Check if the current commit version and upgrade height matches
-			if len(storeUpgrades.Deleted) > 0 || len(storeUpgrades.Added) > 0 {
+			if len(storeUpgrades.Moved) > 0 {
+				return errors.New("moving store prefixes is only supported by store/v2")
+			}
+			if len(storeUpgrades.Deleted) > 0 || len(storeUpgrades.Added) > 0 || len(storeUpgrades.Renamed) > 0 {
 				stup := &storetypes.StoreUpgrades{
 					Added:   storeUpgrades.Added,
 					Deleted: storeUpgrades.Deleted,
 				}
+				for _, rename := range storeUpgrades.Renamed {
+					stup.Renamed = append(stup.Renamed, storetypes.StoreRename{OldKey: rename.OldKey, NewKey: rename.NewKey})
+				}
 				return ms.LoadLatestVersionAndUpgrade(stup)
 			}
 		}